@@ -80,7 +80,7 @@ func (n *Notification) RunOnActionType(at models.ActionType) bool {
 
 // Prepate an action notification, but don't send yet.
 func (n *Notification) PrepareAction(at models.ActionType, t *Template, c SystemConfProvider, states []*models.IncidentState, user, message string, rcp RuleConfProvider) *PreparedNotifications {
-	pn := &PreparedNotifications{Name: n.Name, Print: n.Print}
+	pn := &PreparedNotifications{Name: n.Name, Print: n.Print, SendLimits: n.SendLimits}
 	// get template keys to use for actions. Merge with default sets
 	tks := n.ActionTemplateKeys[at].Combine(n.ActionTemplateKeys[models.ActionNone])
 	buf := &bytes.Buffer{}
@@ -140,5 +140,13 @@ func (n *Notification) PrepareAction(at models.ActionType, t *Template, c System
 	}
 
 	n.prepareFromTemplateKeys(pn, *tks, render, actionDefaults, details)
+	var ids []int64
+	for _, st := range states {
+		ids = append(ids, st.Id)
+	}
+	for _, t := range pn.Transports {
+		t.Action = at
+		t.Incidents = ids
+	}
 	return pn
 }
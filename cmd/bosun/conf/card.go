@@ -0,0 +1,124 @@
+package conf
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"bosun.org/models"
+)
+
+// Card is a notification for chat services that show messages as cards. The
+// teams and googlechat transports render the same card in the format of their
+// service.
+type Card struct {
+	Title    string
+	Subtitle string
+	// Text is the rendered body when the notification sets a body template.
+	// The default body is HTML meant for email, so it is not shown.
+	Text    string
+	Status  models.Status
+	Facts   []CardFact
+	Buttons []CardButton
+}
+
+// CardFact is a labeled value of a card, like the tags of the alert key.
+type CardFact struct {
+	Title string
+	Value string
+}
+
+// CardButton is a button of a card that opens URL.
+type CardButton struct {
+	Text string
+	URL  string
+}
+
+const (
+	// cardMaxAlertKeys limits the alert keys listed on the card of a
+	// notification for many incidents, such as grouped actions.
+	cardMaxAlertKeys = 10
+	// cardMaxButtons limits the incident links of a card.
+	cardMaxButtons = 5
+)
+
+// NewCard returns the card of a prepared notification.
+func NewCard(p *PreparedTransport, c SystemConfProvider) *Card {
+	card := &Card{
+		Title:  p.Subject,
+		Status: p.Status,
+	}
+	switch kind := p.Kind(); kind {
+	case "alert":
+		card.Subtitle = p.Status.String()
+	case "action":
+		card.Subtitle = p.Details.At
+	case "unknown", "multiunknown":
+		card.Subtitle = kind
+		card.Status = models.StUnknown
+	case "nodata":
+		card.Subtitle = kind
+		card.Status = models.StNoData
+	default:
+		card.Subtitle = kind
+	}
+	if p.Details.TemplateKey != "" {
+		card.Text = p.Body
+	}
+	if len(p.Details.Ak) == 1 {
+		ak := models.AlertKey(p.Details.Ak[0])
+		card.Facts = append(card.Facts, CardFact{"Alert", ak.Name()})
+		if tags := ak.Group().Tags(); tags != "" {
+			card.Facts = append(card.Facts, CardFact{"Tags", tags})
+		}
+	} else if len(p.Details.Ak) > 1 {
+		aks := p.Details.Ak
+		if len(aks) > cardMaxAlertKeys {
+			aks = append(aks[:cardMaxAlertKeys:cardMaxAlertKeys], fmt.Sprintf("and %d more", len(p.Details.Ak)-cardMaxAlertKeys))
+		}
+		card.Facts = append(card.Facts, CardFact{"Alert keys", strings.Join(aks, "\n")})
+	}
+	for i, id := range p.Incidents {
+		if i == cardMaxButtons {
+			break
+		}
+		card.Buttons = append(card.Buttons, CardButton{
+			Text: fmt.Sprintf("View incident #%d", id),
+			URL:  c.MakeLink("/incident", &url.Values{"id": []string{fmt.Sprint(id)}}),
+		})
+	}
+	if len(card.Buttons) == 0 {
+		card.Buttons = append(card.Buttons, CardButton{"Open Bosun", c.MakeLink("/", nil)})
+	}
+	return card
+}
+
+// Color is the hex color of the status of the card.
+func (card *Card) Color() string {
+	switch card.Status {
+	case models.StNormal:
+		return "#2EB886"
+	case models.StWarning:
+		return "#DAA038"
+	case models.StCritical:
+		return "#A30200"
+	case models.StUnknown:
+		return "#439FE0"
+	case models.StNoData:
+		return "#9E9E9E"
+	}
+	return ""
+}
+
+// validateWebhook checks the destination of transports that post to a
+// webhook URL.
+func validateWebhook(dst string) error {
+	u, err := url.Parse(dst)
+	if err != nil {
+		return err
+	}
+	if (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		return fmt.Errorf("webhook must be an http or https URL")
+	}
+	return nil
+}
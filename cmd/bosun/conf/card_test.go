@@ -0,0 +1,81 @@
+package conf
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"bosun.org/models"
+)
+
+func TestCardTransports(t *testing.T) {
+	var (
+		query string
+		msg   map[string]interface{}
+	)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.RawQuery
+		msg = nil
+		if err := json.NewDecoder(r.Body).Decode(&msg); err != nil {
+			t.Fatal(err)
+		}
+	}))
+	defer ts.Close()
+
+	c := &SystemConf{Hostname: "bosun.example.com", Scheme: "https"}
+	p := &PreparedTransport{
+		Subject:   "cpu high on web01",
+		Body:      "*body*",
+		Status:    models.StCritical,
+		Incidents: []int64{7},
+		Details:   &NotificationDetails{Ak: []string{"cpu{host=web01}"}, NotifyType: alert, TemplateKey: "teamsBody"},
+	}
+	card := NewCard(p, c)
+	if card.Title != p.Subject || card.Subtitle != "critical" || card.Text != "*body*" {
+		t.Fatalf("unexpected card %+v", card)
+	}
+	if len(card.Facts) != 2 || card.Facts[0] != (CardFact{"Alert", "cpu"}) || card.Facts[1] != (CardFact{"Tags", "host=web01"}) {
+		t.Fatalf("unexpected facts %+v", card.Facts)
+	}
+	if len(card.Buttons) != 1 || card.Buttons[0].URL != "https://bosun.example.com/incident?id=7" {
+		t.Fatalf("unexpected buttons %+v", card.Buttons)
+	}
+
+	p.Destination = ts.URL
+	if err := GetTransport("teams").Send(p, c); err != nil {
+		t.Fatal(err)
+	}
+	b, _ := json.Marshal(msg)
+	for _, s := range []string{`"application/vnd.microsoft.card.adaptive"`, `"type":"AdaptiveCard"`, `"color":"attention"`, `"type":"FactSet"`, `"url":"https://bosun.example.com/incident?id=7"`} {
+		if !strings.Contains(string(b), s) {
+			t.Errorf("teams message does not contain %s: %s", s, b)
+		}
+	}
+
+	p.Destination = ts.URL + "/v1/spaces/AAA/messages?key=k"
+	if err := GetTransport("googlechat").Send(p, c); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(query, "key=k") || !strings.Contains(query, "threadKey=bosun-incident-7") {
+		t.Errorf("unexpected google chat query %s", query)
+	}
+	b, _ = json.Marshal(msg)
+	for _, s := range []string{`"cardsV2"`, `"title":"cpu high on web01"`, `"topLabel":"Tags"`, `"openLink":{"url":"https://bosun.example.com/incident?id=7"}`} {
+		if !strings.Contains(string(b), s) {
+			t.Errorf("google chat message does not contain %s: %s", s, b)
+		}
+	}
+
+	// Cards without incidents link to bosun, and do not show the default body.
+	p = &PreparedTransport{
+		Subject: "unknown",
+		Body:    "<p>html</p>",
+		Details: &NotificationDetails{Ak: []string{"a{host=x}", "a{host=y}"}, NotifyType: unknown},
+	}
+	card = NewCard(p, c)
+	if card.Text != "" || card.Status != models.StUnknown || card.Facts[0].Value != "a{host=x}\na{host=y}" || card.Buttons[0].Text != "Open Bosun" {
+		t.Fatalf("unexpected card %+v", card)
+	}
+}
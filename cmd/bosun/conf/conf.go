@@ -21,6 +21,7 @@ import (
 	"bosun.org/opentsdb"
 	"bosun.org/slog"
 	"github.com/influxdata/influxdb/client/v2"
+	"github.com/ryanuber/go-glob"
 )
 
 // SystemConfProvider providers all the information about the system configuration.
@@ -37,6 +38,10 @@ type SystemConfProvider interface {
 	GetSMTPHost() string
 	GetSMTPUsername() string // SMTP username
 	GetSMTPPassword() string // SMTP password
+	GetSMTPConf() SMTPConf
+	GetSlackConf() SlackConf
+	GetTwilioConf() TwilioConf
+	GetOutboxConf() OutboxConf
 	GetPing() bool
 	GetPingDuration() time.Duration
 	GetEmailFrom() string
@@ -53,6 +58,8 @@ type SystemConfProvider interface {
 	GetCheckFrequency() time.Duration
 	GetDefaultRunEvery() int
 	GetAlertCheckDistribution() string
+	GetAlertTimeout() time.Duration
+	GetMaxAlertKeys() int
 	GetUnknownThreshold() int
 	GetMinGroupSize() int
 
@@ -84,6 +91,7 @@ type SystemConfProvider interface {
 	GetAzureMonitorContext() expr.AzureMonitorClients
 	GetCloudWatchContext() cloudwatch.Context
 	GetPromContext() expr.PromClients
+	GetBackendGuard() *expr.BackendGuard
 	AnnotateEnabled() bool
 
 	MakeLink(string, *url.Values) string
@@ -125,6 +133,15 @@ type RuleConfProvider interface {
 
 	GetLookup(string) *Lookup
 
+	GetCorrelations() map[string]*Correlation
+	GetCorrelation(string) *Correlation
+
+	GetRotations() map[string]*Rotation
+	GetRotation(string) *Rotation
+	GetEscalations() map[string]*Escalation
+	GetEscalation(string) *Escalation
+	GetRoute() *Route
+
 	AlertSquelched(*Alert) func(opentsdb.TagSet) bool
 	Squelched(*Alert, opentsdb.TagSet) bool
 	Expand(string, map[string]string, bool) string
@@ -265,7 +282,27 @@ type Notification struct {
 	Name  string
 	Email []*mail.Address
 
+	// EmailFrom, EmailReplyTo and EmailCC override the From of SMTPConf
+	// and set the Reply-To and Cc of the emails of the notification.
+	EmailFrom    *mail.Address   `json:",omitempty"`
+	EmailReplyTo []*mail.Address `json:",omitempty"`
+	EmailCC      []*mail.Address `json:",omitempty"`
+
 	Post, Get *url.URL
+	// Headers are set on the http requests of the notification, and
+	// HeaderTemplates set the headers they map to the rendered template
+	// keys they name.
+	Headers         map[string]string `json:",omitempty"`
+	HeaderTemplates map[string]string `json:",omitempty"`
+	// HTTPAuth authenticates the http requests of the notification.
+	HTTPAuth *HTTPAuth `json:",omitempty"`
+
+	// Transports maps the names of the transports of the notification to
+	// their destinations. See Transport.
+	Transports map[string]string `json:"-"`
+	// ShortTemplate is the template key of the short text of transports
+	// like sms and voice. They use the subject if it is not set.
+	ShortTemplate string `json:",omitempty"`
 
 	// template keys to use for plain notifications
 	NotificationTemplateKeys
@@ -275,6 +312,7 @@ type Notification struct {
 
 	UnknownTemplateKeys      NotificationTemplateKeys
 	UnknownMultiTemplateKeys NotificationTemplateKeys
+	NoDataTemplateKeys       NotificationTemplateKeys
 
 	Print        bool
 	Next         *Notification
@@ -286,6 +324,16 @@ type Notification struct {
 	UnknownMinGroupSize *int // nil means use global defaults. 0 means no-grouping at all.
 	UnknownThreshold    *int // nil means use global defaults. 0 means no limit
 
+	// Digest batches the alert, unknown and action notifications into one
+	// message sent every Digest, rendered with DigestTemplate or the default
+	// digest templates. 0 sends notifications as they happen.
+	Digest             time.Duration `json:",omitempty"`
+	DigestTemplate     *Template     `json:"-"`
+	DigestTemplateName string        `json:",omitempty"`
+
+	// SendLimits limit the sends to each destination of the notification.
+	SendLimits
+
 	NextName        string `json:"-"`
 	RawEmail        string `json:"-"`
 	RawPost, RawGet string `json:"-"`
@@ -419,6 +467,42 @@ type Macro struct {
 	Locator `json:"-"`
 }
 
+// Correlation is a rule that groups open incidents into a parent problem.
+// Incidents are grouped when they come from matching alerts, share the
+// values of the correlation's tag keys, and started within Window of each
+// other. When Depends is set incidents are only grouped with the incidents
+// they depend on through the depends expressions of their alerts. When Root
+// is set only groups that contain an incident of the root alert become
+// problems, and the root incident is treated as the cause.
+type Correlation struct {
+	Text         string
+	Name         string
+	Alerts       []string // alert name globs, empty matches all alerts
+	Tags         []string
+	Window       time.Duration
+	MinIncidents int
+	Root         string
+	Depends      bool
+	Locator      `json:"-"`
+}
+
+// Matches returns true if incidents of the named alert take part in the
+// correlation.
+func (c *Correlation) Matches(alert string) bool {
+	if alert == c.Root {
+		return true
+	}
+	if len(c.Alerts) == 0 {
+		return true
+	}
+	for _, a := range c.Alerts {
+		if glob.Glob(a, alert) {
+			return true
+		}
+	}
+	return false
+}
+
 // Alert stores all information about alerts. All other major
 // sections of rule configuration are referenced by alerts including
 // Templates, Macros, and Notifications. Alerts hold the expressions
@@ -431,28 +515,147 @@ type Alert struct {
 	Vars
 	*Template        `json:"-"`
 	Name             string
-	Crit             *expr.Expr `json:",omitempty"`
-	Warn             *expr.Expr `json:",omitempty"`
-	Depends          *expr.Expr `json:",omitempty"`
-	Squelch          Squelches  `json:"-"`
+	Crit             *expr.Expr        `json:",omitempty"`
+	Warn             *expr.Expr        `json:",omitempty"`
+	Severities       []*SeverityLevel  `json:",omitempty"` // custom severity levels, lowest first; used instead of crit and warn
+	Depends          *expr.Expr        `json:",omitempty"`
+	Dependencies     []AlertDependency `json:",omitempty"`
+	Squelch          Squelches         `json:"-"`
 	CritNotification *Notifications
 	WarnNotification *Notifications
+	CritEscalation   *Escalation `json:"-"`
+	WarnEscalation   *Escalation `json:"-"`
 	Unknown          time.Duration
 	MaxLogFrequency  time.Duration
 	IgnoreUnknown    bool
 	UnknownsNormal   bool
-	UnjoinedOK       bool `json:",omitempty"`
+	NoDataState      models.Status `json:",omitempty"` // status of alert keys without data; StNone means StUnknown
+	UnjoinedOK       bool          `json:",omitempty"`
 	Log              bool
 	RunEvery         int
+	Timeout          time.Duration `json:",omitempty"`
+	MaxAlertKeys     int           `json:",omitempty"` // 0 means the system default
+	AckFor           time.Duration `json:",omitempty"` // default expiry of acks; 0 means acks do not expire
 	ReturnType       models.FuncType
 
-	TemplateName string   `json:"-"`
-	RawSquelch   []string `json:"-"`
+	TemplateName       string   `json:"-"`
+	RawSquelch         []string `json:"-"`
+	CritEscalationName string   `json:",omitempty"`
+	WarnEscalationName string   `json:",omitempty"`
+
+	// Routed is true when the alert has no notifications of its own, so
+	// its incidents are sent through the routing tree.
+	Routed bool `json:",omitempty"`
 
 	Locator           `json:"-"`
 	AlertTemplateKeys map[string]*template.Template `json:"-"`
 }
 
+// AlertDependency is an edge in the alert dependency graph: the alert it is
+// attached to references Alert with the alert or dependsOnAlert functions.
+type AlertDependency struct {
+	Alert string
+	// Kind is "depends" when the reference is in the depends expression and
+	// "expr" when it is in the crit, warn or severity expressions.
+	Kind string
+	// TagsMatch is the tag filter given to dependsOnAlert.
+	TagsMatch string `json:",omitempty"`
+}
+
+// Covers reports whether an alert key of d.Alert with the tags cause is one
+// an alert key with the tags dependent depends on. With a tag filter they must
+// share the values of its tag keys, which cause must match, and without one
+// dependent must have all the tags of cause, as with the alert function.
+func (d AlertDependency) Covers(cause, dependent opentsdb.TagSet) bool {
+	if strings.TrimSpace(d.TagsMatch) == "" {
+		return dependent.Subset(cause)
+	}
+	match, err := opentsdb.ParseTags(d.TagsMatch)
+	if err != nil {
+		return false
+	}
+	for k, pattern := range match {
+		v, ok := cause[k]
+		if !ok || v != dependent[k] {
+			return false
+		}
+		if pattern == "*" {
+			continue
+		}
+		found := false
+		for _, p := range strings.Split(pattern, "|") {
+			found = found || p == v
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// SeverityLevel is a severity level of an alert that declares its own levels
+// with the severities key.
+type SeverityLevel struct {
+	models.Severity
+	Expr         *expr.Expr `json:",omitempty"`
+	Notification *Notifications
+}
+
+// Severity returns the severity level of a with the given name, or nil if a
+// has no such level.
+func (a *Alert) Severity(name string) *SeverityLevel {
+	for _, l := range a.Severities {
+		if l.Name == name {
+			return l
+		}
+	}
+	return nil
+}
+
+// Exprs returns the expressions that determine the severity of a, the most
+// severe first.
+func (a *Alert) Exprs() []*expr.Expr {
+	if len(a.Severities) == 0 {
+		return []*expr.Expr{a.Crit, a.Warn}
+	}
+	exprs := make([]*expr.Expr, len(a.Severities))
+	for i, l := range a.Severities {
+		exprs[len(exprs)-1-i] = l.Expr
+	}
+	return exprs
+}
+
+// StatusNotifications returns the notifications and the escalation of an
+// incident of a with status st at severity level sev. Unknown and nodata
+// incidents are notified like critical ones, which for custom levels is the
+// highest level.
+func (a *Alert) StatusNotifications(st models.Status, sev *models.Severity) (*Notifications, *Escalation) {
+	if len(a.Severities) > 0 {
+		var l *SeverityLevel
+		if sev != nil {
+			l = a.Severity(sev.Name)
+		}
+		if l == nil {
+			switch st {
+			case models.StCritical, models.StUnknown, models.StNoData:
+				l = a.Severities[len(a.Severities)-1]
+			case models.StWarning:
+				l = a.Severities[0]
+			default:
+				return nil, nil
+			}
+		}
+		return l.Notification, nil
+	}
+	switch st {
+	case models.StCritical, models.StUnknown, models.StNoData:
+		return a.CritNotification, a.CritEscalation
+	case models.StWarning:
+		return a.WarnNotification, a.WarnEscalation
+	}
+	return nil, nil
+}
+
 // A Locator stores the information about the location of the rule in the underlying
 // rule store
 type Locator interface{}
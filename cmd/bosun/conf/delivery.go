@@ -0,0 +1,48 @@
+package conf
+
+import (
+	"time"
+
+	"bosun.org/models"
+)
+
+// Delivery is an attempt or a suppressed send of a notification, with the
+// alert keys and incidents it was for. Its destination is redacted, since it
+// is stored with the incidents.
+type Delivery struct {
+	models.DeliveryAttempt
+	Ak        []string
+	Incidents []int64
+}
+
+func (p *PreparedNotifications) delivery(transport, dst, templateKey string, ak []string, incidents []int64) *Delivery {
+	return &Delivery{
+		DeliveryAttempt: models.DeliveryAttempt{
+			Time:         time.Now().UTC(),
+			Notification: p.Name,
+			Transport:    transport,
+			Destination:  RedactDestination(transport, dst),
+			TemplateKey:  templateKey,
+		},
+		Ak:        ak,
+		Incidents: incidents,
+	}
+}
+
+// attempt sends with send, which returns the HTTP status of the response if
+// it has one, and records the attempt in d.
+func (p *PreparedNotifications) attempt(d *Delivery, send func() (int, error)) error {
+	start := time.Now()
+	code, err := send()
+	d.Time = start.UTC()
+	d.Latency = time.Since(start)
+	d.StatusCode = code
+	if err != nil {
+		d.Error = err.Error()
+		if se, ok := err.(*StatusError); ok && code == 0 {
+			d.StatusCode = se.Code
+		}
+	}
+	p.Deliveries = append(p.Deliveries, d)
+	return err
+}
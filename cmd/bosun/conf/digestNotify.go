@@ -0,0 +1,117 @@
+package conf
+
+import (
+	"bytes"
+	"fmt"
+	"net/url"
+	"time"
+
+	"bosun.org/cmd/bosun/conf/template"
+	"bosun.org/models"
+	"bosun.org/slog"
+)
+
+// DigestContext is the context of digest templates.
+type DigestContext struct {
+	// Name is the name of the notification.
+	Name string
+	// Start and End are the period the digest covers.
+	Start, End time.Time
+	// Incidents are the incidents with events in the period, by id.
+	Incidents []*DigestIncident
+	makeLink  func(string, *url.Values) string
+}
+
+// DigestIncident is an incident in a digest.
+type DigestIncident struct {
+	*models.IncidentState
+	// Events are the kinds of notifications the incident had in the
+	// period: alert, unknown or action.
+	Events []string
+}
+
+// States returns the incident states of the digest.
+func (d *DigestContext) States() []*models.IncidentState {
+	states := make([]*models.IncidentState, len(d.Incidents))
+	for i, di := range d.Incidents {
+		states[i] = di.IncidentState
+	}
+	return states
+}
+
+func (d *DigestContext) IncidentLink(i int64) string {
+	return d.makeLink("/incident", &url.Values{
+		"id": []string{fmt.Sprint(i)},
+	})
+}
+
+var digestDefaults defaultTemplates
+
+func init() {
+	subject := `{{.Name}}: {{len .Incidents}} incident{{if ne (len .Incidents) 1}}s{{end}} since {{.Start.Format "15:04 MST"}}`
+	body := `<p>{{len .Incidents}} incident{{if ne (len .Incidents) 1}}s{{end}} from {{.Start.Format "2006-01-02 15:04:05 MST"}} to {{.End.Format "15:04:05 MST"}}:
+<ul>
+	{{range .Incidents}}
+		<li>
+			<a href="{{$.IncidentLink .Id}}">#{{.Id}}</a>
+			{{.CurrentStatus}}: {{if .Subject}}{{.Subject}}{{else}}{{.AlertKey}}{{end}}
+			({{range $i, $e := .Events}}{{if $i}}, {{end}}{{$e}}{{end}})
+		</li>
+	{{end}}
+</ul>`
+	digestDefaults.subject = template.Must(template.New("subject").Parse(subject))
+	digestDefaults.body = template.Must(template.New("body").Parse(body))
+}
+
+// NotifyDigest sends a digest of the incidents to the notification.
+func (n *Notification) NotifyDigest(c SystemConfProvider, start, end time.Time, incidents []*DigestIncident) {
+	go n.PrepareDigest(c, start, end, incidents).Send(c)
+}
+
+// PrepareDigest renders a digest of the incidents with the digest template
+// of the notification, but does not send it.
+func (n *Notification) PrepareDigest(c SystemConfProvider, start, end time.Time, incidents []*DigestIncident) *PreparedNotifications {
+	ctx := &DigestContext{
+		Name:      n.Name,
+		Start:     start,
+		End:       end,
+		Incidents: incidents,
+		makeLink:  c.MakeLink,
+	}
+	pn := &PreparedNotifications{Name: n.Name, Print: n.Print, SendLimits: n.SendLimits}
+	var tks NotificationTemplateKeys
+	if n.DigestTemplate != nil {
+		tks.BodyTemplate = "body"
+		tks.EmailSubjectTemplate = "subject"
+	}
+	buf := &bytes.Buffer{}
+	render := func(key string, defaultTmpl *template.Template) (string, error) {
+		tpl := defaultTmpl
+		if key != "" {
+			tpl = n.DigestTemplate.Get(key)
+		} else {
+			key = "default"
+		}
+		buf.Reset()
+		err := tpl.Execute(buf, ctx)
+		if err != nil {
+			e := fmt.Sprintf("executing digest template '%s': %s", key, err)
+			pn.Errors = append(pn.Errors, e)
+			slog.Errorf(e)
+			return "", err
+		}
+		return buf.String(), nil
+	}
+	var aks []string
+	for _, i := range incidents {
+		aks = append(aks, string(i.AlertKey))
+	}
+	details := &NotificationDetails{
+		NotifyName:  n.Name,
+		TemplateKey: tks.BodyTemplate,
+		Ak:          aks,
+		NotifyType:  digest,
+	}
+	n.prepareFromTemplateKeys(pn, tks, render, digestDefaults, details)
+	return pn
+}
@@ -0,0 +1,165 @@
+package conf
+
+import (
+	"sort"
+	"time"
+)
+
+// Rotation is an on-call rotation. Its members, which are notifications,
+// take turns being on call for one Shift each, the first member starting at
+// Start. Overrides put a notification on call instead of the scheduled member
+// for a period of time.
+type Rotation struct {
+	Text      string
+	Name      string
+	Members   []*Notification `json:"-"`
+	Shift     time.Duration
+	Start     time.Time
+	Overrides []RotationOverride
+
+	MemberNames []string
+	Locator     `json:"-"`
+}
+
+// RotationOverride puts Member on call from From until To.
+type RotationOverride struct {
+	Member   *Notification `json:"-"`
+	Name     string
+	From, To time.Time
+}
+
+// OnCall returns the notification on call at t, and whether it is on call
+// because of an override.
+func (r *Rotation) OnCall(t time.Time) (n *Notification, override bool) {
+	for _, o := range r.Overrides {
+		if !t.Before(o.From) && t.Before(o.To) {
+			return o.Member, true
+		}
+	}
+	i := r.shift(t) % len(r.Members)
+	if i < 0 {
+		i += len(r.Members)
+	}
+	return r.Members[i], false
+}
+
+// NextHandoff returns the time after t at which the scheduled shift changes.
+// Overrides are not taken into account.
+func (r *Rotation) NextHandoff(t time.Time) time.Time {
+	return r.Start.Add(time.Duration(r.shift(t)+1) * r.Shift)
+}
+
+// shift returns the number of the shift that t is in, counting from the
+// shift that begins at Start.
+func (r *Rotation) shift(t time.Time) int {
+	d := t.Sub(r.Start)
+	n := int(d / r.Shift)
+	if d < 0 && d%r.Shift != 0 {
+		n--
+	}
+	return n
+}
+
+// Escalation is an escalation policy. When an incident needs to be acked the
+// notifications of the first level are sent, and if it is not acknowledged
+// each further level is notified after its delay.
+type Escalation struct {
+	Text   string
+	Name   string
+	Levels []*EscalationLevel
+
+	Locator `json:"-"`
+}
+
+// EscalationLevel is a level of an escalation policy. Its notifications are
+// the notifications it names and the members on call in the rotations it
+// names.
+type EscalationLevel struct {
+	// Delay is the time to wait after the previous level was notified.
+	Delay         time.Duration
+	Notifications map[string]*Notification `json:"-"`
+	Rotations     map[string]*Rotation     `json:"-"`
+	Names         []string
+}
+
+// Resolve returns the notifications of the level at time t, with the
+// rotations replaced by their members on call.
+func (l *EscalationLevel) Resolve(t time.Time) map[string]*Notification {
+	nots := make(map[string]*Notification)
+	for name, n := range l.Notifications {
+		nots[name] = n
+	}
+	for _, r := range l.Rotations {
+		n, _ := r.OnCall(t)
+		nots[n.Name] = n
+	}
+	return nots
+}
+
+// GetAllChained returns all notifications that any level of the escalation
+// can send, including the members of the rotations and their chains.
+func (e *Escalation) GetAllChained() map[string]*Notification {
+	ns := &Notifications{Notifications: make(map[string]*Notification)}
+	for _, l := range e.Levels {
+		for name, n := range l.Notifications {
+			ns.Notifications[name] = n
+		}
+		for _, r := range l.Rotations {
+			for _, n := range r.Members {
+				ns.Notifications[n.Name] = n
+			}
+			for _, o := range r.Overrides {
+				ns.Notifications[o.Member.Name] = o.Member
+			}
+		}
+	}
+	return ns.GetAllChained()
+}
+
+// OnCall describes who is on call for a level of an escalation.
+type OnCall struct {
+	Escalation string
+	Level      int
+	Delay      time.Duration
+	// Notifications are the notifications the level sends at the time.
+	Notifications []string
+	Rotations     []RotationOnCall `json:",omitempty"`
+}
+
+// RotationOnCall is the member on call for a rotation at a time.
+type RotationOnCall struct {
+	Rotation    string
+	OnCall      string
+	Override    bool
+	NextHandoff time.Time
+}
+
+// OnCall returns who is on call for each level of the escalation at t.
+func (e *Escalation) OnCall(t time.Time) []OnCall {
+	var levels []OnCall
+	for i, l := range e.Levels {
+		oc := OnCall{
+			Escalation: e.Name,
+			Level:      i + 1,
+			Delay:      l.Delay,
+		}
+		for name := range l.Resolve(t) {
+			oc.Notifications = append(oc.Notifications, name)
+		}
+		sort.Strings(oc.Notifications)
+		for name, r := range l.Rotations {
+			n, override := r.OnCall(t)
+			oc.Rotations = append(oc.Rotations, RotationOnCall{
+				Rotation:    name,
+				OnCall:      n.Name,
+				Override:    override,
+				NextHandoff: r.NextHandoff(t),
+			})
+		}
+		sort.Slice(oc.Rotations, func(i, j int) bool {
+			return oc.Rotations[i].Rotation < oc.Rotations[j].Rotation
+		})
+		levels = append(levels, oc)
+	}
+	return levels
+}
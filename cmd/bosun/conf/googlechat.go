@@ -0,0 +1,97 @@
+package conf
+
+import (
+	"fmt"
+	"html"
+	"net/url"
+	"strings"
+)
+
+func init() {
+	RegisterTransport("googlechat", googleChat{})
+}
+
+// googleChat is the transport of notifications with a googlechat key, whose
+// value is the URL of a Google Chat space webhook. Notifications are posted
+// as Cards v2. Notifications of a single incident use the incident as the
+// thread key, so they are threaded in the space.
+type googleChat struct{}
+
+func (googleChat) Validate(dst string) error {
+	return validateWebhook(dst)
+}
+
+func (googleChat) Redact(dst string) string {
+	return redactURL(dst)
+}
+
+func (googleChat) Send(p *PreparedTransport, c SystemConfProvider) error {
+	dst := p.Destination
+	if len(p.Incidents) == 1 {
+		u, err := url.Parse(dst)
+		if err != nil {
+			return err
+		}
+		q := u.Query()
+		q.Set("threadKey", fmt.Sprintf("bosun-incident-%d", p.Incidents[0]))
+		q.Set("messageReplyOption", "REPLY_MESSAGE_FALLBACK_TO_NEW_THREAD")
+		u.RawQuery = q.Encode()
+		dst = u.String()
+	}
+	return postJSON(dst, googleChatMessage(NewCard(p, c)))
+}
+
+// googleChatMessage renders a card as a message with a Cards v2 card.
+func googleChatMessage(card *Card) map[string]interface{} {
+	header := map[string]interface{}{"title": card.Title}
+	if card.Subtitle != "" {
+		header["subtitle"] = card.Subtitle
+	}
+	var widgets []interface{}
+	// Header subtitles can not be colored, so the status is also shown in
+	// its color as the first widget.
+	if color := card.Color(); color != "" {
+		widgets = append(widgets, map[string]interface{}{
+			"decoratedText": map[string]interface{}{
+				"topLabel": "Status",
+				"text":     fmt.Sprintf(`<font color="%s">%s</font>`, color, card.Status),
+			},
+		})
+	}
+	if card.Text != "" {
+		widgets = append(widgets, map[string]interface{}{
+			"textParagraph": map[string]string{"text": card.Text},
+		})
+	}
+	for _, f := range card.Facts {
+		widgets = append(widgets, map[string]interface{}{
+			"decoratedText": map[string]interface{}{
+				"topLabel": f.Title,
+				"text":     strings.Replace(html.EscapeString(f.Value), "\n", "<br>", -1),
+				"wrapText": true,
+			},
+		})
+	}
+	buttons := make([]interface{}, len(card.Buttons))
+	for i, b := range card.Buttons {
+		buttons[i] = map[string]interface{}{
+			"text":    b.Text,
+			"onClick": map[string]interface{}{"openLink": map[string]string{"url": b.URL}},
+		}
+	}
+	widgets = append(widgets, map[string]interface{}{
+		"buttonList": map[string]interface{}{"buttons": buttons},
+	})
+	return map[string]interface{}{
+		"text": card.Title,
+		"cardsV2": []interface{}{
+			map[string]interface{}{
+				"cardId": "bosun",
+				"card": map[string]interface{}{
+					"header":   header,
+					"sections": []interface{}{map[string]interface{}{"widgets": widgets}},
+				},
+			},
+		},
+	}
+}
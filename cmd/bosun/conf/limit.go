@@ -0,0 +1,170 @@
+package conf
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"bosun.org/collect"
+	"bosun.org/metadata"
+	"bosun.org/opentsdb"
+	"bosun.org/slog"
+)
+
+func init() {
+	metadata.AddMetricMeta(
+		"bosun.notifications.suppressed", metadata.Counter, metadata.PerSecond,
+		"The number of sends of notifications dropped by their rate limit (reason=rate_limit) or merged into an identical send (reason=duplicate).")
+}
+
+// SendLimits limit how often a notification sends to each of its
+// destinations: an email address list, a URL or the destination of a
+// transport.
+type SendLimits struct {
+	// MaxPerMinute is the rate of sends to a destination, with bursts of up
+	// to Burst sends. 0 means no limit.
+	MaxPerMinute int `json:",omitempty"`
+	Burst        int `json:",omitempty"`
+	// DedupWindow merges the sends of identical content to a destination
+	// within the window into the first one. 0 sends all of them.
+	DedupWindow time.Duration `json:",omitempty"`
+}
+
+// The reasons of suppressed sends.
+const (
+	SuppressedRateLimit = "rate_limit"
+	SuppressedDuplicate = "duplicate"
+)
+
+// SuppressedSend is a send of a notification that was dropped by the rate
+// limit or merged into an identical send. Its destination is redacted, since
+// it is noted on the timeline of the incidents.
+type SuppressedSend struct {
+	Transport   string
+	Destination string
+	Reason      string
+	Ak          []string
+	Incidents   []int64 `json:",omitempty"`
+}
+
+// sendLimiter keeps the state of the send limits of all notifications.
+type sendLimiter struct {
+	sync.Mutex
+	buckets   map[string]*tokenBucket
+	sent      map[[sha256.Size]byte]time.Time // content hash to the end of its dedup window
+	lastPrune time.Time
+}
+
+var limiter = &sendLimiter{
+	buckets: make(map[string]*tokenBucket),
+	sent:    make(map[[sha256.Size]byte]time.Time),
+}
+
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// take takes a token of the bucket of a destination, and returns false if
+// there is none left.
+func (l *sendLimiter) take(key string, limits SendLimits, now time.Time) bool {
+	burst := limits.Burst
+	if burst <= 0 {
+		burst = limits.MaxPerMinute
+	}
+	b := l.buckets[key]
+	if b == nil {
+		b = &tokenBucket{tokens: float64(burst), last: now}
+		l.buckets[key] = b
+	}
+	b.tokens += now.Sub(b.last).Minutes() * float64(limits.MaxPerMinute)
+	if b.tokens > float64(burst) {
+		b.tokens = float64(burst)
+	}
+	b.last = now
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// contentHash hashes what a send sends to a destination.
+func contentHash(name, dst string, v interface{}) [sha256.Size]byte {
+	b, _ := json.Marshal(v)
+	return sha256.Sum256([]byte(name + "\x00" + dst + "\x00" + string(b)))
+}
+
+// allow returns the reason a send of v to dst by p is suppressed, or "" if it
+// can be sent. A send that is allowed starts the dedup window of its content
+// right away, so identical sends made at the same time are merged into it;
+// release ends the window if the send fails.
+func (p *PreparedNotifications) allow(dst string, v interface{}) string {
+	if p.MaxPerMinute <= 0 && p.DedupWindow <= 0 {
+		return ""
+	}
+	now := time.Now()
+	limiter.Lock()
+	defer limiter.Unlock()
+	h := contentHash(p.Name, dst, v)
+	if p.DedupWindow > 0 {
+		if until, ok := limiter.sent[h]; ok && now.Before(until) {
+			return SuppressedDuplicate
+		}
+	}
+	if p.MaxPerMinute > 0 && !limiter.take(p.Name+"\x00"+dst, p.SendLimits, now) {
+		return SuppressedRateLimit
+	}
+	if p.DedupWindow > 0 {
+		limiter.sent[h] = now.Add(p.DedupWindow)
+		if now.Sub(limiter.lastPrune) > time.Minute {
+			for h, until := range limiter.sent {
+				if now.After(until) {
+					delete(limiter.sent, h)
+				}
+			}
+			limiter.lastPrune = now
+		}
+	}
+	return ""
+}
+
+// release ends the dedup window of a failed send of v to dst by p, so it can
+// be retried.
+func (p *PreparedNotifications) release(dst string, v interface{}) {
+	if p.DedupWindow <= 0 {
+		return
+	}
+	limiter.Lock()
+	defer limiter.Unlock()
+	delete(limiter.sent, contentHash(p.Name, dst, v))
+}
+
+// suppress records a suppressed send.
+func (p *PreparedNotifications) suppress(d *Delivery, reason string) {
+	slog.Infof("notification %s: %s send to %s for alert keys %v suppressed: %s", p.Name, d.Transport, d.Destination, d.Ak, reason)
+	collect.Add("notifications.suppressed", opentsdb.TagSet{"notification": p.Name, "reason": reason}, 1)
+	d.Suppressed = reason
+	p.Deliveries = append(p.Deliveries, d)
+	p.Suppressed = append(p.Suppressed, &SuppressedSend{
+		Transport:   d.Transport,
+		Destination: d.Destination,
+		Reason:      reason,
+		Ak:          d.Ak,
+		Incidents:   d.Incidents,
+	})
+}
+
+// String describes a suppressed send for the timeline of an incident.
+func (s *SuppressedSend) String() string {
+	switch s.Reason {
+	case SuppressedRateLimit:
+		return fmt.Sprintf("%s notification to %s dropped by its rate limit", s.Transport, s.Destination)
+	case SuppressedDuplicate:
+		return fmt.Sprintf("%s notification to %s merged into an identical notification", s.Transport, s.Destination)
+	}
+	return fmt.Sprintf("%s notification to %s suppressed: %s", s.Transport, s.Destination, strings.Replace(s.Reason, "_", " ", -1))
+}
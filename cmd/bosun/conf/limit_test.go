@@ -0,0 +1,158 @@
+package conf
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"bosun.org/util"
+)
+
+func TestSendLimits(t *testing.T) {
+	util.InitHostManager("", false) // for the collect metrics
+
+	var posts int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&posts, 1)
+	}))
+	defer ts.Close()
+
+	n := &Notification{Name: "limited", SendLimits: SendLimits{MaxPerMinute: 1, Burst: 2, DedupWindow: time.Hour}}
+	send := func(body string) *PreparedNotifications {
+		details := &NotificationDetails{Ak: []string{"a{host=x}"}, NotifyName: n.Name}
+		pn := &PreparedNotifications{Name: n.Name, SendLimits: n.SendLimits}
+		pn.HTTP = append(pn.HTTP, n.PrepHttp("POST", ts.URL, body, details))
+		if errs := pn.Send(&SystemConf{}); len(errs) != 0 {
+			t.Fatal(errs)
+		}
+		return pn
+	}
+
+	if pn := send("1"); len(pn.Suppressed) != 0 {
+		t.Fatalf("unexpected suppressed sends %+v", pn.Suppressed)
+	}
+	// An identical send is merged into the first one.
+	pn := send("1")
+	if len(pn.Suppressed) != 1 || pn.Suppressed[0].Reason != SuppressedDuplicate || pn.Suppressed[0].Destination != "POST "+ts.URL {
+		t.Fatalf("expected a duplicate, got %+v", pn.Suppressed)
+	}
+	// The burst allows a second send, and the third one is dropped.
+	if pn := send("2"); len(pn.Suppressed) != 0 {
+		t.Fatalf("unexpected suppressed sends %+v", pn.Suppressed)
+	}
+	pn = send("3")
+	if len(pn.Suppressed) != 1 || pn.Suppressed[0].Reason != SuppressedRateLimit || pn.Suppressed[0].Ak[0] != "a{host=x}" {
+		t.Fatalf("expected a dropped send, got %+v", pn.Suppressed)
+	}
+	if len(pn.Deliveries) != 1 || pn.Deliveries[0].Suppressed != SuppressedRateLimit {
+		t.Fatalf("expected the dropped send in the deliveries, got %+v", pn.Deliveries)
+	}
+	if posts != 2 {
+		t.Fatalf("expected 2 posts, got %d", posts)
+	}
+
+	// Split keeps the limits.
+	if p := pn.Split()[0]; p.SendLimits != n.SendLimits {
+		t.Fatalf("split lost the limits: %+v", p.SendLimits)
+	}
+}
+
+func TestTokenBucket(t *testing.T) {
+	l := &sendLimiter{buckets: make(map[string]*tokenBucket)}
+	limits := SendLimits{MaxPerMinute: 60}
+	now := time.Now()
+	for i := 0; i < 60; i++ {
+		if !l.take("k", limits, now) {
+			t.Fatalf("send %d dropped within the burst", i)
+		}
+	}
+	if l.take("k", limits, now) {
+		t.Fatal("expected the bucket to be empty")
+	}
+	if !l.take("k", limits, now.Add(time.Second)) {
+		t.Fatal("expected a token after a second")
+	}
+	if l.take("k", limits, now.Add(time.Second)) {
+		t.Fatal("expected the bucket to be empty")
+	}
+}
+
+func TestSendLimitsSimultaneous(t *testing.T) {
+	util.InitHostManager("", false) // for the collect metrics
+
+	var posts int32
+	release := make(chan struct{})
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&posts, 1)
+		<-release
+	}))
+	defer ts.Close()
+
+	n := &Notification{Name: "simultaneous", SendLimits: SendLimits{DedupWindow: time.Hour}}
+	details := &NotificationDetails{Ak: []string{"a{host=x}"}, NotifyName: n.Name}
+	suppressed := make(chan int, 3)
+	for i := 0; i < 3; i++ {
+		go func() {
+			pn := &PreparedNotifications{Name: n.Name, SendLimits: n.SendLimits}
+			pn.HTTP = append(pn.HTTP, n.PrepHttp("POST", ts.URL+"/hook/secret", "1", details))
+			pn.Send(&SystemConf{})
+			for _, ss := range pn.Suppressed {
+				if strings.Contains(ss.String(), "secret") {
+					t.Errorf("the suppressed send shows the webhook path: %s", ss)
+				}
+			}
+			suppressed <- len(pn.Suppressed)
+		}()
+	}
+	// The duplicates are merged while the first send is still running.
+	merged := 0
+	for i := 0; i < 2; i++ {
+		select {
+		case s := <-suppressed:
+			merged += s
+		case <-time.After(5 * time.Second):
+			t.Fatal("expected the duplicates to be merged before the first send finished")
+		}
+	}
+	close(release)
+	merged += <-suppressed
+	if merged != 2 || posts != 1 {
+		t.Fatalf("expected 1 post and 2 merged sends, got %d posts and %d merged", posts, merged)
+	}
+
+	// A failed send does not hold back its retry.
+	fail := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer fail.Close()
+	for i := 0; i < 2; i++ {
+		pn := &PreparedNotifications{Name: n.Name, SendLimits: n.SendLimits}
+		pn.HTTP = append(pn.HTTP, n.PrepHttp("POST", fail.URL, "1", details))
+		if errs := pn.Send(&SystemConf{}); len(errs) != 1 || len(pn.Suppressed) != 0 {
+			t.Fatalf("attempt %d: expected a failed send, got %v and suppressed %+v", i, errs, pn.Suppressed)
+		}
+	}
+}
+
+func TestRedactDestination(t *testing.T) {
+	tests := []struct {
+		transport, dst, redacted string
+	}{
+		{"email", "a@example.com,b@example.com", "a@example.com,b@example.com"},
+		{"http", "POST https://hooks.example.com/services/T0/B0/secret?token=x", "POST https://hooks.example.com/..."},
+		{"http", "GET http://example.com", "GET http://example.com"},
+		{"pagerduty", "0123456789abcdef0123456789abcdef", "****cdef"},
+		{"pagerduty", "short", "****"},
+		{"teams", "https://example.webhook.office.com/webhookb2/secret", "https://example.webhook.office.com/..."},
+		{"googlechat", "https://chat.googleapis.com/v1/spaces/AAA/messages?key=k&token=t", "https://chat.googleapis.com/..."},
+		{"sms", "+15551234567", "+15551234567"},
+	}
+	for _, test := range tests {
+		if r := RedactDestination(test.transport, test.dst); r != test.redacted {
+			t.Errorf("%s %s: expected %q, got %q", test.transport, test.dst, test.redacted, r)
+		}
+	}
+}
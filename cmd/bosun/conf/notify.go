@@ -2,14 +2,10 @@ package conf
 
 import (
 	"bytes"
-	"crypto/tls"
-	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
-	"net/mail"
-	"net/smtp"
 	"strings"
 
 	"bosun.org/collect"
@@ -42,16 +38,35 @@ func init() {
 }
 
 type PreparedNotifications struct {
-	Email  *PreparedEmail
-	HTTP   []*PreparedHttp
-	Print  bool
-	Name   string
-	Errors []string
+	Email      *PreparedEmail
+	HTTP       []*PreparedHttp
+	Transports []*PreparedTransport `json:",omitempty"`
+	Print      bool
+	Name       string
+	Errors     []string
+
+	SendLimits
+	// Suppressed are the sends dropped by the rate limit or merged into an
+	// identical send by Send.
+	Suppressed []*SuppressedSend `json:",omitempty"`
+	// Deliveries are the attempts and suppressed sends of the last Send.
+	Deliveries []*Delivery `json:"-"`
 }
 
 func (p *PreparedNotifications) Send(c SystemConfProvider) (errs []error) {
+	p.Suppressed = nil
+	p.Deliveries = nil
 	if p.Email != nil {
-		if err := p.Email.Send(c); err != nil {
+		dst := strings.Join(p.Email.To, ",")
+		ak := p.Email.Aks
+		if p.Email.AK != "" {
+			ak = []string{p.Email.AK}
+		}
+		d := p.delivery("email", dst, p.Email.TemplateKey, ak, nil)
+		if reason := p.allow(dst, p.Email); reason != "" {
+			p.suppress(d, reason)
+		} else if err := p.attempt(d, func() (int, error) { return 0, p.Email.Send(c) }); err != nil {
+			p.release(dst, p.Email)
 			slog.Errorf(
 				sendLogErrorFmt,
 				fmt.Sprintf("subject: %s", p.Email.Subject),
@@ -80,13 +95,18 @@ func (p *PreparedNotifications) Send(c SystemConfProvider) (errs []error) {
 		} else {
 			logPrefix = "type: alert"
 		}
-		if _, err := h.Send(); err != nil {
+		dst := h.Method + " " + h.URL
+		d := p.delivery("http", dst, h.Details.TemplateKey, h.Details.Ak, nil)
+		if reason := p.allow(dst, h); reason != "" {
+			p.suppress(d, reason)
+		} else if err := p.attempt(d, h.Send); err != nil {
+			p.release(dst, h)
 			slog.Errorf(
 				sendLogErrorFmt,
 				logPrefix,
 				h.Details.NotifyName,
 				"http_"+h.Method,
-				h.URL,
+				redactURL(h.URL),
 				h.Body,
 				err.Error(),
 			)
@@ -97,23 +117,72 @@ func (p *PreparedNotifications) Send(c SystemConfProvider) (errs []error) {
 				logPrefix,
 				h.Details.NotifyName,
 				"http_"+h.Method,
-				h.URL,
+				redactURL(h.URL),
 				h.Body,
 			)
 		}
 	}
+	for _, t := range p.Transports {
+		logPrefix := "type: " + t.Kind()
+		if t.Details.At != "" {
+			logPrefix = fmt.Sprintf("action_type: %s", t.Details.At)
+		}
+		d := p.delivery(t.Transport, t.Destination, t.Details.TemplateKey, t.Details.Ak, t.Incidents)
+		if reason := p.allow(t.Transport+":"+t.Destination, t); reason != "" {
+			p.suppress(d, reason)
+		} else if err := p.attempt(d, func() (int, error) { return 0, t.Send(c) }); err != nil {
+			p.release(t.Transport+":"+t.Destination, t)
+			slog.Errorf(
+				sendLogErrorFmt,
+				logPrefix,
+				t.Details.NotifyName,
+				t.Transport,
+				d.Destination,
+				t.Subject,
+				err.Error(),
+			)
+			errs = append(errs, err)
+		} else if p.Print {
+			slog.Infof(
+				sendLogSuccessFmt,
+				logPrefix,
+				t.Details.NotifyName,
+				t.Transport,
+				d.Destination,
+				t.Subject,
+			)
+		}
+	}
 
 	return
 }
 
+// Split returns a PreparedNotifications for the email, each http request and
+// each transport of p, so they can be sent and retried independently.
+func (p *PreparedNotifications) Split() []*PreparedNotifications {
+	var ps []*PreparedNotifications
+	if p.Email != nil {
+		ps = append(ps, &PreparedNotifications{Email: p.Email, Print: p.Print, Name: p.Name, SendLimits: p.SendLimits})
+	}
+	for _, h := range p.HTTP {
+		ps = append(ps, &PreparedNotifications{HTTP: []*PreparedHttp{h}, Print: p.Print, Name: p.Name, SendLimits: p.SendLimits})
+	}
+	for _, t := range p.Transports {
+		ps = append(ps, &PreparedNotifications{Transports: []*PreparedTransport{t}, Print: p.Print, Name: p.Name, SendLimits: p.SendLimits})
+	}
+	return ps
+}
+
 // PrepareAlert does all of the work of selecting what content to send to which sources. It does not actually send any notifications,
 // but the returned object can be used to send them.
-func (n *Notification) PrepareAlert(rt *models.RenderedTemplates, ak string, attachments ...*models.Attachment) *PreparedNotifications {
-	pn := &PreparedNotifications{Name: n.Name, Print: n.Print}
+func (n *Notification) PrepareAlert(rt *models.RenderedTemplates, st *models.IncidentState, attachments ...*models.Attachment) *PreparedNotifications {
+	ak := string(st.AlertKey)
+	pn := &PreparedNotifications{Name: n.Name, Print: n.Print, SendLimits: n.SendLimits}
 	if len(n.Email) > 0 {
 		subject := rt.GetDefault(n.EmailSubjectTemplate, "emailSubject")
 		body := rt.GetDefault(n.BodyTemplate, "emailBody")
 		pn.Email = n.PrepEmail(subject, body, ak, attachments)
+		pn.Email.TemplateKey = n.BodyTemplate
 	}
 	if n.Post != nil || n.PostTemplate != "" {
 		url := ""
@@ -146,12 +215,34 @@ func (n *Notification) PrepareAlert(rt *models.RenderedTemplates, ak string, att
 		}
 		pn.HTTP = append(pn.HTTP, n.PrepHttp("GET", url, "", details))
 	}
+	for _, h := range pn.HTTP {
+		for name, key := range n.HeaderTemplates {
+			h.Headers[name] = rt.Get(key)
+		}
+	}
+	if len(n.Transports) > 0 {
+		subject := rt.GetDefault(n.EmailSubjectTemplate, "subject")
+		body := rt.GetDefault(n.BodyTemplate, "body")
+		details := &NotificationDetails{
+			Ak:          []string{ak},
+			NotifyName:  n.Name,
+			TemplateKey: n.BodyTemplate,
+			NotifyType:  alert,
+		}
+		n.prepTransports(pn, subject, body, st.CurrentStatus, details)
+		for _, t := range pn.Transports {
+			t.Incidents = []int64{st.Id}
+			if n.ShortTemplate != "" {
+				t.Short = rt.Get(n.ShortTemplate)
+			}
+		}
+	}
 	return pn
 }
 
 // NotifyAlert triggers Email/HTTP/Print actions for the Notification object. Called when an alert is first triggered, or on escalations.
-func (n *Notification) NotifyAlert(rt *models.RenderedTemplates, c SystemConfProvider, ak string, attachments ...*models.Attachment) {
-	go n.PrepareAlert(rt, ak, attachments...).Send(c)
+func (n *Notification) NotifyAlert(rt *models.RenderedTemplates, c SystemConfProvider, st *models.IncidentState, attachments ...*models.Attachment) {
+	go n.PrepareAlert(rt, st, attachments...).Send(c)
 }
 
 type PreparedHttp struct {
@@ -160,12 +251,26 @@ type PreparedHttp struct {
 	Headers map[string]string `json:",omitempty"`
 	Body    string
 	Details *NotificationDetails
+
+	Auth *HTTPAuth `json:",omitempty"`
+
+	// static are the headers of the notification. They may hold secrets, so
+	// like the secrets of Auth they are not stored with the prepared
+	// notification, and are set again with SetHeaders before it is resent.
+	static map[string]string
+}
+
+// SetHeaders sets the headers of n on the request of p.
+func (n *Notification) SetHeaders(p *PreparedHttp) {
+	p.static = n.Headers
 }
 
 const (
 	alert = iota + 1
 	unknown
 	multiunknown
+	nodata
+	digest
 )
 
 type NotificationDetails struct {
@@ -176,6 +281,23 @@ type NotificationDetails struct {
 	NotifyType  int      // notifications type e.g alert, unknown etc
 }
 
+// Kind is the kind of notification, such as "alert" or "unknown".
+func (d *NotificationDetails) Kind() string {
+	switch d.NotifyType {
+	case alert:
+		return "alert"
+	case unknown:
+		return "unknown"
+	case multiunknown:
+		return "multiunknown"
+	case nodata:
+		return "nodata"
+	case digest:
+		return "digest"
+	}
+	return "action"
+}
+
 func (p *PreparedHttp) Send() (int, error) {
 	var body io.Reader
 	if p.Body != "" {
@@ -185,62 +307,46 @@ func (p *PreparedHttp) Send() (int, error) {
 	if err != nil {
 		return 0, err
 	}
+	for k, v := range p.static {
+		req.Header.Set(k, v)
+	}
 	for k, v := range p.Headers {
 		req.Header.Set(k, v)
 	}
-	resp, err := http.DefaultClient.Do(req)
+	if err := p.Auth.apply(req, p.Body); err != nil {
+		return 0, err
+	}
+	client, err := p.Auth.client()
+	if err != nil {
+		return 0, err
+	}
+	resp, err := client.Do(req)
 	if resp != nil && resp.Body != nil {
 		// Drain up to 512 bytes and close the body to let the Transport reuse the connection
 		io.CopyN(ioutil.Discard, resp.Body, 512)
 		resp.Body.Close()
 	}
 	if err != nil {
-		return 0, err
+		return 0, redactError(err)
 	}
 	if resp.StatusCode >= 300 {
 		collect.Add("post.sent_failed", nil, 1)
-		switch p.Details.NotifyType {
-		case alert:
-			return resp.StatusCode, fmt.Errorf(
-				httpSendErrorFmt,
-				p.Details.NotifyName,
-				"alert",
-				p.Details.TemplateKey,
-				strings.Join(p.Details.Ak, ","),
-				p.Method,
-				resp.StatusCode,
-			)
-		case unknown:
-			return resp.StatusCode, fmt.Errorf(
-				httpSendErrorFmt,
-				p.Details.NotifyName,
-				"unknown",
-				p.Details.TemplateKey,
-				strings.Join(p.Details.Ak, ","),
-				p.Method,
-				resp.StatusCode,
-			)
-		case multiunknown:
-			return resp.StatusCode, fmt.Errorf(
-				httpSendErrorFmt,
-				p.Details.NotifyName,
-				"multi-unknown",
-				p.Details.TemplateKey,
-				strings.Join(p.Details.Ak, ","),
-				p.Method,
-				resp.StatusCode,
-			)
-		default:
-			return resp.StatusCode, fmt.Errorf(
-				httpSendErrorFmt,
-				p.Details.NotifyName,
-				fmt.Sprintf("action '%s'", p.Details.At),
-				p.Details.TemplateKey,
-				strings.Join(p.Details.Ak, ","),
-				p.Method,
-				resp.StatusCode,
-			)
+		kind := p.Details.Kind()
+		switch kind {
+		case "multiunknown":
+			kind = "multi-unknown"
+		case "action":
+			kind = fmt.Sprintf("action '%s'", p.Details.At)
 		}
+		return resp.StatusCode, fmt.Errorf(
+			httpSendErrorFmt,
+			p.Details.NotifyName,
+			kind,
+			p.Details.TemplateKey,
+			strings.Join(p.Details.Ak, ","),
+			p.Method,
+			resp.StatusCode,
+		)
 	}
 	collect.Add("post.sent", nil, 1)
 	return resp.StatusCode, nil
@@ -252,6 +358,8 @@ func (n *Notification) PrepHttp(method string, url string, body string, alertDet
 		URL:     url,
 		Headers: map[string]string{},
 		Details: alertDetails,
+		Auth:    n.HTTPAuth,
+		static:  n.Headers,
 	}
 	if method == http.MethodPost {
 		prep.Body = body
@@ -276,6 +384,15 @@ type PreparedEmail struct {
 	Body        string
 	AK          string
 	Attachments []*models.Attachment
+
+	TemplateKey string `json:",omitempty"`
+	// Aks are the alert keys of an email about several of them.
+	Aks []string `json:",omitempty"`
+
+	// From overrides the From of the system configuration.
+	From    string   `json:",omitempty"`
+	ReplyTo []string `json:",omitempty"`
+	CC      []string `json:",omitempty"`
 }
 
 func (n *Notification) PrepEmail(subject, body string, ak string, attachments []*models.Attachment) *PreparedEmail {
@@ -288,6 +405,15 @@ func (n *Notification) PrepEmail(subject, body string, ak string, attachments []
 	for _, a := range n.Email {
 		pe.To = append(pe.To, a.Address)
 	}
+	if n.EmailFrom != nil {
+		pe.From = n.EmailFrom.String()
+	}
+	for _, a := range n.EmailReplyTo {
+		pe.ReplyTo = append(pe.ReplyTo, a.String())
+	}
+	for _, a := range n.EmailCC {
+		pe.CC = append(pe.CC, a.Address)
+	}
 	return pe
 }
 
@@ -299,14 +425,21 @@ func (p *PreparedEmail) Send(c SystemConfProvider) error {
 
 	e := email.NewEmail()
 	e.From = c.GetEmailFrom()
+	if p.From != "" {
+		e.From = p.From
+	}
 	e.To = append(e.To, p.To...)
+	e.Cc = append(e.Cc, p.CC...)
+	if len(p.ReplyTo) > 0 {
+		e.Headers.Set("Reply-To", strings.Join(p.ReplyTo, ", "))
+	}
 	e.Subject = p.Subject
 	e.HTML = []byte(p.Body)
 	for _, a := range p.Attachments {
 		e.Attach(bytes.NewBuffer(a.Data), a.Filename, a.ContentType)
 	}
 	e.Headers.Add("X-Bosun-Server", util.GetHostManager().GetHostName())
-	if err := sendEmail(e, c.GetSMTPHost(), c.GetSMTPUsername(), c.GetSMTPPassword()); err != nil {
+	if err := sendEmail(e, c.GetSMTPConf()); err != nil {
 		collect.Add("email.sent_failed", nil, 1)
 		slog.Errorf("failed to send alert %v to %v %v\n", p.AK, e.To, err)
 		return err
@@ -315,74 +448,3 @@ func (p *PreparedEmail) Send(c SystemConfProvider) error {
 	slog.Infof("relayed email %v to %v sucessfully. Subject: %d bytes. Body: %d bytes.", p.AK, e.To, len(e.Subject), len(e.HTML))
 	return nil
 }
-
-// Send an email using the given host and SMTP auth (optional), returns any
-// error thrown by smtp.SendMail. This function merges the To, Cc, and Bcc
-// fields and calls the smtp.SendMail function using the Email.Bytes() output as
-// the message.
-func sendEmail(e *email.Email, addr, username, password string) error {
-	// Merge the To, Cc, and Bcc fields
-	to := make([]string, 0, len(e.To)+len(e.Cc)+len(e.Bcc))
-	to = append(append(append(to, e.To...), e.Cc...), e.Bcc...)
-	// Check to make sure there is at least one recipient and one "From" address
-	if e.From == "" || len(to) == 0 {
-		return errors.New("Must specify at least one From address and one To address")
-	}
-	from, err := mail.ParseAddress(e.From)
-	if err != nil {
-		return err
-	}
-	raw, err := e.Bytes()
-	if err != nil {
-		return err
-	}
-	return smtpSend(addr, username, password, from.Address, to, raw)
-}
-
-// SendMail connects to the server at addr, switches to TLS if
-// possible, authenticates with the optional mechanism a if possible,
-// and then sends an email from address from, to addresses to, with
-// message msg.
-func smtpSend(addr, username, password string, from string, to []string, msg []byte) error {
-	c, err := smtp.Dial(addr)
-	if err != nil {
-		return err
-	}
-	defer c.Close()
-	if err = c.Hello("localhost"); err != nil {
-		return err
-	}
-	if ok, _ := c.Extension("STARTTLS"); ok {
-		if err = c.StartTLS(&tls.Config{InsecureSkipVerify: true}); err != nil {
-			return err
-		}
-		if len(username) > 0 || len(password) > 0 {
-			hostWithoutPort := strings.Split(addr, ":")[0]
-			auth := smtp.PlainAuth("", username, password, hostWithoutPort)
-			if err = c.Auth(auth); err != nil {
-				return err
-			}
-		}
-	}
-	if err = c.Mail(from); err != nil {
-		return err
-	}
-	for _, addr := range to {
-		if err = c.Rcpt(addr); err != nil {
-			return err
-		}
-	}
-	w, err := c.Data()
-	if err != nil {
-		return err
-	}
-	_, err = w.Write(msg)
-	if err != nil {
-		return err
-	}
-	err = w.Close()
-	if err != nil {
-		return err
-	}
-	return c.Quit()
-}
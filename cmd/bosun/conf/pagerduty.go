@@ -0,0 +1,182 @@
+package conf
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"regexp"
+	"time"
+	"unicode/utf8"
+
+	"bosun.org/models"
+)
+
+func init() {
+	RegisterTransport("pagerduty", pagerDuty{})
+}
+
+// pagerDutyEventsURL is the endpoint of the PagerDuty Events API v2.
+var pagerDutyEventsURL = "https://events.pagerduty.com/v2/enqueue"
+
+// pagerDuty is the transport of notifications with a pagerduty key, whose
+// value is the routing key of a PagerDuty service integration. Alert, unknown
+// and nodata notifications trigger an alert in PagerDuty for each alert key,
+// and acknowledge, close and forget actions acknowledge or resolve it. The
+// alert key is the dedup_key of the events, so all events of an alert key go
+// to the same PagerDuty alert. PagerDuty alerts can not be unacknowledged, so
+// an expired acknowledgement resolves the alert and triggers it again.
+type pagerDuty struct{}
+
+var pagerDutyRoutingKeyRE = regexp.MustCompile(`^[A-Za-z0-9]{32}$`)
+
+func (pagerDuty) Validate(dst string) error {
+	if !pagerDutyRoutingKeyRE.MatchString(dst) {
+		return fmt.Errorf("invalid PagerDuty routing key, must be 32 letters or digits")
+	}
+	return nil
+}
+
+func (pagerDuty) Redact(dst string) string {
+	return maskSecret(dst)
+}
+
+// pagerDutyEvent is an event of the PagerDuty Events API v2.
+type pagerDutyEvent struct {
+	RoutingKey  string            `json:"routing_key"`
+	EventAction string            `json:"event_action"`
+	DedupKey    string            `json:"dedup_key"`
+	Payload     *pagerDutyPayload `json:"payload,omitempty"`
+	Client      string            `json:"client,omitempty"`
+	ClientURL   string            `json:"client_url,omitempty"`
+}
+
+type pagerDutyPayload struct {
+	Summary       string            `json:"summary"`
+	Source        string            `json:"source"`
+	Severity      string            `json:"severity"`
+	Timestamp     string            `json:"timestamp,omitempty"`
+	Component     string            `json:"component,omitempty"`
+	Class         string            `json:"class,omitempty"`
+	CustomDetails map[string]string `json:"custom_details,omitempty"`
+}
+
+// pagerDutyRetrigger is the event action of an expired acknowledgement. It
+// is sent as a resolve event followed by a trigger event, since a trigger
+// event for an acknowledged alert leaves it acknowledged and does not page.
+const pagerDutyRetrigger = "retrigger"
+
+// pagerDutyEventAction returns the event action of a notification, or "" if
+// the notification is not sent to PagerDuty.
+func pagerDutyEventAction(p *PreparedTransport) string {
+	switch p.Kind() {
+	case "alert", "unknown", "nodata":
+		return "trigger"
+	case "action":
+		switch p.Action {
+		case models.ActionAcknowledge:
+			return "acknowledge"
+		case models.ActionClose, models.ActionForceClose, models.ActionForget, models.ActionPurge:
+			return "resolve"
+		case models.ActionAckExpired:
+			return pagerDutyRetrigger
+		}
+	}
+	// Multiple unknowns and digests are not about a single alert key, and the
+	// other actions do not change the state of a PagerDuty alert.
+	return ""
+}
+
+// pagerDutySeverity maps the status of an incident to a PagerDuty severity.
+func pagerDutySeverity(p *PreparedTransport) string {
+	switch {
+	case p.Status == models.StCritical:
+		return "critical"
+	case p.Status == models.StWarning:
+		return "warning"
+	case p.Kind() == "unknown", p.Kind() == "nodata", p.Action == models.ActionAckExpired:
+		return "error"
+	}
+	return "info"
+}
+
+// pagerDutyDedupKey returns the dedup_key of an alert key. PagerDuty limits
+// keys to 255 characters, so longer alert keys are replaced by the alert name
+// and a hash of the alert key.
+func pagerDutyDedupKey(ak string) string {
+	if len(ak) <= 255 {
+		return ak
+	}
+	name := models.AlertKey(ak).Name()
+	if len(name) > 190 {
+		name = name[:190]
+	}
+	return fmt.Sprintf("%s{%x}", name, sha256.Sum256([]byte(ak)))
+}
+
+// pagerDutyMaxSummary is the maximum length in bytes of the summary of an
+// event.
+const pagerDutyMaxSummary = 1024
+
+// truncateUTF8 returns the longest prefix of s of at most n bytes that does
+// not end in the middle of a character.
+func truncateUTF8(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
+
+func (pagerDuty) Send(p *PreparedTransport, c SystemConfProvider) error {
+	action := pagerDutyEventAction(p)
+	if action == "" {
+		return nil
+	}
+	summary := truncateUTF8(p.Subject, pagerDutyMaxSummary)
+	for _, ak := range p.Details.Ak {
+		actions := []string{action}
+		if action == pagerDutyRetrigger {
+			actions = []string{"resolve", "trigger"}
+		}
+		for _, action := range actions {
+			ev := &pagerDutyEvent{
+				RoutingKey:  p.Destination,
+				EventAction: action,
+				DedupKey:    pagerDutyDedupKey(ak),
+				Client:      "Bosun",
+				ClientURL:   c.MakeLink("/", nil),
+			}
+			if action == "trigger" {
+				key := models.AlertKey(ak)
+				source := key.Group()["host"]
+				if source == "" {
+					source = key.Name()
+				}
+				ev.Payload = &pagerDutyPayload{
+					Summary:   summary,
+					Source:    source,
+					Severity:  pagerDutySeverity(p),
+					Timestamp: time.Now().UTC().Format(time.RFC3339),
+					Component: key.Name(),
+					Class:     p.Kind(),
+					CustomDetails: map[string]string{
+						"alert_key":    ak,
+						"notification": p.Details.NotifyName,
+					},
+				}
+			}
+			if err := sendPagerDutyEvent(ev); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func sendPagerDutyEvent(ev *pagerDutyEvent) error {
+	if err := postJSON(pagerDutyEventsURL, ev); err != nil {
+		return fmt.Errorf("%s event for %s: %v", ev.EventAction, ev.DedupKey, err)
+	}
+	return nil
+}
@@ -0,0 +1,163 @@
+package conf
+
+import (
+	"bytes"
+	"encoding/json"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"bosun.org/models"
+	"bosun.org/slog"
+	"bosun.org/util"
+)
+
+func TestPagerDuty(t *testing.T) {
+	util.InitHostManager("", false) // for the collect metrics
+	var events []*pagerDutyEvent
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ev := new(pagerDutyEvent)
+		if err := json.NewDecoder(r.Body).Decode(ev); err != nil {
+			t.Fatal(err)
+		}
+		events = append(events, ev)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer ts.Close()
+	defer func(u string) { pagerDutyEventsURL = u }(pagerDutyEventsURL)
+	pagerDutyEventsURL = ts.URL
+
+	key := strings.Repeat("a", 32)
+	n := &Notification{Name: "pd", Transports: map[string]string{"pagerduty": key}}
+	c := &SystemConf{Hostname: "bosun.example.com", Scheme: "https"}
+	st := &models.IncidentState{AlertKey: "cpu{host=web01}", CurrentStatus: models.StCritical}
+	rt := &models.RenderedTemplates{Subject: "cpu high on web01", Body: "body"}
+	pn := n.PrepareAlert(rt, st)
+	if errs := pn.Send(c); len(errs) != 0 {
+		t.Fatal(errs)
+	}
+	if len(pn.Deliveries) != 1 || strings.Contains(pn.Deliveries[0].Destination, key) {
+		t.Fatalf("expected a delivery without the routing key, got %+v", pn.Deliveries)
+	}
+	if len(events) != 1 {
+		t.Fatalf("expected 1 event, got %d", len(events))
+	}
+	ev := events[0]
+	if ev.RoutingKey != key || ev.EventAction != "trigger" || ev.DedupKey != "cpu{host=web01}" {
+		t.Fatalf("unexpected event %+v", ev)
+	}
+	if p := ev.Payload; p == nil || p.Summary != rt.Subject || p.Source != "web01" || p.Severity != "critical" {
+		t.Fatalf("unexpected payload %+v", ev.Payload)
+	}
+
+	for at, action := range map[models.ActionType]string{
+		models.ActionAcknowledge: "acknowledge",
+		models.ActionClose:       "resolve",
+		models.ActionForget:      "resolve",
+		models.ActionNote:        "",
+	} {
+		events = nil
+		p := &PreparedTransport{
+			Transport:   "pagerduty",
+			Destination: key,
+			Action:      at,
+			Details:     &NotificationDetails{Ak: []string{"cpu{host=web01}", "cpu{host=web02}"}, At: at.String()},
+		}
+		if err := p.Send(c); err != nil {
+			t.Fatal(err)
+		}
+		if action == "" {
+			if len(events) != 0 {
+				t.Fatalf("expected no events for %v, got %d", at, len(events))
+			}
+			continue
+		}
+		if len(events) != 2 {
+			t.Fatalf("expected an event for each alert key for %v, got %d", at, len(events))
+		}
+		if events[1].EventAction != action || events[1].DedupKey != "cpu{host=web02}" || events[1].Payload != nil {
+			t.Fatalf("unexpected event for %v: %+v", at, events[1])
+		}
+	}
+
+	// An expired acknowledgement resolves and triggers the alert again, so
+	// PagerDuty pages again.
+	events = nil
+	p := &PreparedTransport{
+		Transport:   "pagerduty",
+		Destination: key,
+		Subject:     "acknowledgement expired",
+		Action:      models.ActionAckExpired,
+		Details:     &NotificationDetails{Ak: []string{"cpu{host=web01}"}, At: models.ActionAckExpired.String()},
+	}
+	if err := p.Send(c); err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 2 || events[0].EventAction != "resolve" || events[1].EventAction != "trigger" || events[1].DedupKey != "cpu{host=web01}" {
+		t.Fatalf("expected a resolve and a trigger event, got %+v", events)
+	}
+	if p := events[1].Payload; p == nil || p.Summary != "acknowledgement expired" || p.Severity != "error" {
+		t.Fatalf("unexpected payload %+v", events[1].Payload)
+	}
+
+	// Long summaries are cut on a character boundary.
+	events = nil
+	rt.Subject = strings.Repeat("a", pagerDutyMaxSummary-1) + "é"
+	if errs := n.PrepareAlert(rt, st).Send(c); len(errs) != 0 {
+		t.Fatal(errs)
+	}
+	if s := events[0].Payload.Summary; s != strings.Repeat("a", pagerDutyMaxSummary-1) {
+		t.Errorf("expected the summary to be cut before the last character, got %d bytes ending in %q", len(s), s[len(s)-2:])
+	}
+
+	long := models.AlertKey("cpu{host=" + strings.Repeat("x", 300) + "}")
+	if k := pagerDutyDedupKey(string(long)); len(k) > 255 || k != pagerDutyDedupKey(string(long)) || !strings.HasPrefix(k, "cpu{") {
+		t.Fatalf("bad dedup key for long alert key: %v", k)
+	}
+}
+
+// TestSendLogRedacted tests that the logs of sends do not have the routing
+// keys and webhook URLs of their destinations.
+func TestSendLogRedacted(t *testing.T) {
+	util.InitHostManager("", false) // for the collect metrics
+	var logs bytes.Buffer
+	slog.Set(&slog.StdLog{Log: log.New(&logs, "", 0)})
+	defer slog.Set(&slog.StdLog{Log: log.New(os.Stderr, "", log.LstdFlags)})
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer ts.Close()
+	defer func(u string) { pagerDutyEventsURL = u }(pagerDutyEventsURL)
+	pagerDutyEventsURL = ts.URL
+	// Nothing listens on the address of a closed server.
+	closed := httptest.NewServer(http.NotFoundHandler())
+	closed.Close()
+
+	key := strings.Repeat("k", 32)
+	n := &Notification{
+		Name:  "redacted",
+		Print: true,
+		Transports: map[string]string{
+			"pagerduty": key,
+			"teams":     closed.URL + "/webhookb2/secret-teams",
+		},
+	}
+	n.Post, _ = n.Post.Parse(closed.URL + "/hook/secret-post")
+	st := &models.IncidentState{AlertKey: "cpu{host=web01}", CurrentStatus: models.StCritical}
+	rt := &models.RenderedTemplates{Subject: "subject", Body: "body"}
+	if errs := n.PrepareAlert(rt, st).Send(&SystemConf{}); len(errs) != 2 {
+		t.Fatalf("expected the post and teams to fail, got %v", errs)
+	}
+	if logs.Len() == 0 {
+		t.Fatal("expected logs of the sends")
+	}
+	for _, secret := range []string{key, "secret-teams", "secret-post"} {
+		if strings.Contains(logs.String(), secret) {
+			t.Errorf("the logs have %s: %s", secret, logs.String())
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package conf
+
+import (
+	"time"
+
+	"bosun.org/cmd/bosun/search"
+	"bosun.org/models"
+	"bosun.org/opentsdb"
+)
+
+// Route is a node of the routing tree of the rule configuration. The incidents
+// of alerts without notifications of their own are routed from the root of
+// the tree: an incident goes down to the first child route that matches it,
+// or to every matching child up to the first one without Continue, and is sent
+// to the receivers of the deepest routes it reached. Receivers, GroupBy and
+// GroupWait are inherited from the parent route when a route does not set
+// them.
+type Route struct {
+	Text string
+	Name string
+
+	// Alert is a glob of the names of the alerts the route matches, Tags
+	// globs of their tags, and Severity the statuses, like critical or
+	// unknown, or the names of custom severity levels. Empty matchers match
+	// everything.
+	Alert    string          `json:",omitempty"`
+	Tags     opentsdb.TagSet `json:",omitempty"`
+	Severity []string        `json:",omitempty"`
+
+	Receivers     map[string]*Notification `json:"-"`
+	ReceiverNames []string
+	Continue      bool `json:",omitempty"`
+	// GroupBy are the tags by which the incidents sent to a receiver within
+	// GroupWait are grouped into one notification.
+	GroupBy   []string      `json:",omitempty"`
+	GroupWait time.Duration `json:",omitempty"`
+
+	Routes []*Route `json:",omitempty"`
+
+	Locator `json:"-"`
+}
+
+// Match returns the routes whose receivers an incident of alert with tags,
+// status and severity level sev is sent to, or nil if r does not match it.
+func (r *Route) Match(alert string, tags opentsdb.TagSet, status models.Status, sev *models.Severity) []*Route {
+	if !r.matches(alert, tags, status, sev) {
+		return nil
+	}
+	var routes []*Route
+	for _, child := range r.Routes {
+		m := child.Match(alert, tags, status, sev)
+		routes = append(routes, m...)
+		if len(m) > 0 && !child.Continue {
+			break
+		}
+	}
+	if len(routes) == 0 {
+		routes = []*Route{r}
+	}
+	return routes
+}
+
+func (r *Route) matches(alert string, tags opentsdb.TagSet, status models.Status, sev *models.Severity) bool {
+	if r.Alert != "" && !globMatch(r.Alert, alert) {
+		return false
+	}
+	for k, v := range r.Tags {
+		if !globMatch(v, tags[k]) {
+			return false
+		}
+	}
+	if len(r.Severity) == 0 {
+		return true
+	}
+	for _, s := range r.Severity {
+		if s == status.String() || sev != nil && s == sev.Name {
+			return true
+		}
+	}
+	return false
+}
+
+func globMatch(glob, v string) bool {
+	m, err := search.Match(glob, []string{v})
+	return err == nil && len(m) > 0
+}
+
+// MayMatch returns the routes that can match incidents of alert, whatever
+// their tags and severity.
+func (r *Route) MayMatch(alert string) []*Route {
+	if r.Alert != "" && !globMatch(r.Alert, alert) {
+		return nil
+	}
+	routes := []*Route{r}
+	for _, child := range r.Routes {
+		routes = append(routes, child.MayMatch(alert)...)
+	}
+	return routes
+}
+
+// Find returns the route of r or its descendants with name, or nil.
+func (r *Route) Find(name string) *Route {
+	if r.Name == name {
+		return r
+	}
+	for _, child := range r.Routes {
+		if found := child.Find(name); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+// RoutedNotifications returns the notifications of the receivers of routes.
+func RoutedNotifications(routes []*Route) *Notifications {
+	ns := &Notifications{Notifications: make(map[string]*Notification)}
+	for _, r := range routes {
+		for name, n := range r.Receivers {
+			ns.Notifications[name] = n
+		}
+	}
+	return ns
+}
@@ -0,0 +1,179 @@
+package rule
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"bosun.org/cmd/bosun/conf"
+	"bosun.org/cmd/bosun/expr"
+	eparse "bosun.org/cmd/bosun/expr/parse"
+	"bosun.org/opentsdb"
+)
+
+// parseTagsMatch parses the tag filter of dependsOnAlert. It uses the
+// OpenTSDB query syntax: each value is either * or a | separated list.
+func parseTagsMatch(tagsMatch string) (opentsdb.TagSet, error) {
+	if strings.TrimSpace(tagsMatch) == "" {
+		return opentsdb.TagSet{}, nil
+	}
+	return opentsdb.ParseTags(tagsMatch)
+}
+
+func tagsMatchValue(pattern, value string) bool {
+	if pattern == "*" {
+		return true
+	}
+	for _, v := range strings.Split(pattern, "|") {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// dependsOnAlert returns a result with value 1 for every group, projected
+// onto the tag keys of tagsMatch, in which the named alert currently has
+// an open incident that is not normal or is unevaluated. Because
+// unevaluated alert keys are included, an alert that is unevaluated due to
+// its own dependencies makes the alerts that depend on it unevaluated too.
+func (c *Conf) dependsOnAlert(s *expr.State, name, tagsMatch string) (*expr.Results, error) {
+	results := new(expr.Results)
+	if s.History == nil {
+		return results, nil
+	}
+	match, err := parseTagsMatch(tagsMatch)
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[string]bool)
+Loop:
+	for _, ak := range s.History.GetAbnormalAlertKeys(name) {
+		group := ak.Group()
+		g := make(opentsdb.TagSet)
+		for k, pattern := range match {
+			v, ok := group[k]
+			if !ok || !tagsMatchValue(pattern, v) {
+				continue Loop
+			}
+			g[k] = v
+		}
+		key := g.String()
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		results.Results = append(results.Results, &expr.Result{
+			Value: expr.Number(1),
+			Group: g,
+		})
+	}
+	sort.Slice(results.Results, func(i, j int) bool {
+		return results.Results[i].Group.String() < results.Results[j].Group.String()
+	})
+	return results, nil
+}
+
+// alertDependencies lists the alerts referenced by the alert and
+// dependsOnAlert functions in the expressions of a.
+func alertDependencies(a *conf.Alert) []conf.AlertDependency {
+	var deps []conf.AlertDependency
+	seen := make(map[conf.AlertDependency]bool)
+	walk := func(e *expr.Expr, kind string) {
+		if e == nil {
+			return
+		}
+		eparse.Walk(e.Root, func(n eparse.Node) {
+			f, ok := n.(*eparse.FuncNode)
+			if !ok || (f.Name != "alert" && f.Name != "dependsOnAlert") || len(f.Args) < 2 {
+				return
+			}
+			name, ok := f.Args[0].(*eparse.StringNode)
+			if !ok {
+				return
+			}
+			d := conf.AlertDependency{Alert: name.Text, Kind: kind}
+			if f.Name == "dependsOnAlert" {
+				if tags, ok := f.Args[1].(*eparse.StringNode); ok {
+					d.TagsMatch = tags.Text
+				}
+			}
+			if !seen[d] {
+				seen[d] = true
+				deps = append(deps, d)
+			}
+		})
+	}
+	walk(a.Depends, "depends")
+	walk(a.Crit, "expr")
+	walk(a.Warn, "expr")
+	for _, l := range a.Severities {
+		walk(l.Expr, "expr")
+	}
+	return deps
+}
+
+// checkAlertDependencies verifies that every alert referenced by
+// dependsOnAlert exists and that alerts do not depend on themselves,
+// directly or through other alerts.
+func (c *Conf) checkAlertDependencies() {
+	const (
+		visiting = iota + 1
+		done
+	)
+	state := make(map[string]int)
+	var path []string
+	var visit func(name string) []string
+	visit = func(name string) []string {
+		switch state[name] {
+		case visiting:
+			for i, n := range path {
+				if n == name {
+					return append(append([]string{}, path[i:]...), name)
+				}
+			}
+		case done:
+			return nil
+		}
+		state[name] = visiting
+		path = append(path, name)
+		if a := c.Alerts[name]; a != nil {
+			for _, d := range a.Dependencies {
+				if cycle := visit(d.Alert); cycle != nil {
+					return cycle
+				}
+			}
+		}
+		path = path[:len(path)-1]
+		state[name] = done
+		return nil
+	}
+	for _, dSec := range c.deferredSections["alert"] {
+		c.at(dSec.SectionNode)
+		a := c.Alerts[dSec.SectionNode.Name.Text]
+		if a == nil {
+			continue
+		}
+		for _, d := range a.Dependencies {
+			if c.Alerts[d.Alert] == nil {
+				c.errorf("dependsOnAlert: unknown alert %s", d.Alert)
+			}
+		}
+		if cycle := visit(a.Name); cycle != nil {
+			c.errorf("dependency cycle: %s", strings.Join(cycle, " -> "))
+		}
+	}
+}
+
+func tagDependsOnAlert(args []eparse.Node) (eparse.Tags, error) {
+	tagsMatch := args[1].(*eparse.StringNode).Text
+	match, err := parseTagsMatch(tagsMatch)
+	if err != nil {
+		return nil, fmt.Errorf("dependsOnAlert: %v", err)
+	}
+	t := make(eparse.Tags)
+	for k := range match {
+		t[k] = struct{}{}
+	}
+	return t, nil
+}
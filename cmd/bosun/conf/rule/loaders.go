@@ -12,6 +12,7 @@ import (
 	"bosun.org/cmd/bosun/conf"
 	"bosun.org/cmd/bosun/conf/rule/parse"
 	"bosun.org/cmd/bosun/conf/template"
+	"bosun.org/cmd/bosun/expr"
 	eparse "bosun.org/cmd/bosun/expr/parse"
 	"bosun.org/models"
 	"bosun.org/opentsdb"
@@ -170,6 +171,9 @@ func (c *Conf) loadAlert(s *parse.SectionNode) {
 			ns.Notifications[k] = v
 		}
 	}
+	var severities []string
+	sevExprs := make(map[string]*expr.Expr)
+	sevNots := make(map[string]*conf.Notifications)
 	pairs := c.getPairs(s, a.Vars, sNormal)
 	for _, p := range pairs {
 		c.at(p.node)
@@ -197,6 +201,16 @@ func (c *Conf) loadAlert(s *parse.SectionNode) {
 			procNotification(v, a.CritNotification)
 		case "warnNotification":
 			procNotification(v, a.WarnNotification)
+		case "critEscalation":
+			a.CritEscalationName = v
+			if a.CritEscalation = c.Escalations[v]; a.CritEscalation == nil {
+				c.errorf("unknown escalation %s", v)
+			}
+		case "warnEscalation":
+			a.WarnEscalationName = v
+			if a.WarnEscalation = c.Escalations[v]; a.WarnEscalation == nil {
+				c.errorf("unknown escalation %s", v)
+			}
 		case "unknown":
 			od, err := opentsdb.ParseDuration(v)
 			if err != nil {
@@ -217,12 +231,54 @@ func (c *Conf) loadAlert(s *parse.SectionNode) {
 				c.errorf("max log frequency must be at least 1s")
 			}
 			a.MaxLogFrequency = d
+		case "timeout":
+			od, err := opentsdb.ParseDuration(v)
+			if err != nil {
+				c.error(err)
+			}
+			d := time.Duration(od)
+			if d < time.Second {
+				c.errorf("timeout must be at least 1s")
+			}
+			a.Timeout = d
+		case "ackFor":
+			od, err := opentsdb.ParseDuration(v)
+			if err != nil {
+				c.error(err)
+			}
+			d := time.Duration(od)
+			if d < time.Minute {
+				c.errorf("ackFor must be at least 1m")
+			}
+			a.AckFor = d
+		case "maxAlertKeys":
+			i, err := strconv.Atoi(v)
+			if err != nil {
+				c.error(err)
+			}
+			if i < 1 {
+				c.errorf("maxAlertKeys must be at least 1")
+			}
+			a.MaxAlertKeys = i
 		case "unjoinedOk":
 			a.UnjoinedOK = true
 		case "ignoreUnknown":
 			a.IgnoreUnknown = true
 		case "unknownIsNormal":
 			a.UnknownsNormal = true
+		case "noDataState":
+			switch v {
+			case "normal":
+				a.NoDataState = models.StNormal
+			case "warning":
+				a.NoDataState = models.StWarning
+			case "critical":
+				a.NoDataState = models.StCritical
+			case "nodata":
+				a.NoDataState = models.StNoData
+			default:
+				c.errorf("invalid noDataState %s, must be one of normal, warning, critical or nodata", v)
+			}
 		case "log":
 			a.Log = true
 		case "runEvery":
@@ -231,19 +287,57 @@ func (c *Conf) loadAlert(s *parse.SectionNode) {
 			if err != nil {
 				c.error(err)
 			}
+		case "severities":
+			severities = strings.Split(v, ",")
+			for i, name := range severities {
+				severities[i] = strings.TrimSpace(name)
+			}
 		default:
-			c.errorf("unknown key %s", p.key)
+			switch {
+			case strings.HasPrefix(p.key, "severity."):
+				sevExprs[strings.TrimPrefix(p.key, "severity.")] = c.NewExpr(v)
+			case strings.HasPrefix(p.key, "severityNotification."):
+				ns := new(conf.Notifications)
+				procNotification(v, ns)
+				sevNots[strings.TrimPrefix(p.key, "severityNotification.")] = ns
+			default:
+				c.errorf("unknown key %s", p.key)
+			}
 		}
 	}
+	if a.NoDataState != models.StNone && a.UnknownsNormal {
+		c.errorf("noDataState and unknownIsNormal can not be used together")
+	}
+	if a.Log && (a.CritEscalation != nil || a.WarnEscalation != nil) {
+		c.errorf("cannot use log with an escalation")
+	}
+	if a.AckFor != 0 && a.Log {
+		c.errorf("ackFor can not be used on alerts with `log = true`")
+	}
 	if a.MaxLogFrequency != 0 && !a.Log {
 		c.errorf("maxLogFrequency can only be used on alerts with `log = true`.")
 	}
 	c.at(s)
-	if a.Crit == nil && a.Warn == nil {
+	c.loadSeverities(&a, severities, sevExprs, sevNots)
+	if a.Crit == nil && a.Warn == nil && len(a.Severities) == 0 {
 		c.errorf("neither crit or warn specified")
 	}
 	var tags eparse.Tags
 	var ret models.FuncType
+	for i, l := range a.Severities {
+		ltags, err := l.Expr.Root.Tags()
+		if err != nil {
+			c.error(err)
+		}
+		lret := l.Expr.Root.Return()
+		if i == 0 {
+			tags, ret = ltags, lret
+		} else if ret != lret {
+			c.errorf("severity expressions must return same type (%s: %v != %s: %v)", a.Severities[0].Name, ret, l.Name, lret)
+		} else if !tags.Equal(ltags) {
+			c.errorf("severity tags must be equal (%s: %v != %s: %v)", a.Severities[0].Name, tags, l.Name, ltags)
+		}
+	}
 	if a.Crit != nil {
 		ctags, err := a.Crit.Root.Tags()
 		if err != nil {
@@ -276,6 +370,7 @@ func (c *Conf) loadAlert(s *parse.SectionNode) {
 			c.errorf("Depends and crit/warn must share at least one tag.")
 		}
 	}
+	a.Dependencies = alertDependencies(&a)
 	allNots := c.getAllPossibleNotifications(&a)
 	if a.Log {
 		for _, n := range allNots {
@@ -287,6 +382,17 @@ func (c *Conf) loadAlert(s *parse.SectionNode) {
 			c.errorf("log specified but no notification")
 		}
 	}
+	if len(allNots) == 0 && c.Route != nil && a.Template != nil && !a.Log {
+		// incidents of alerts without notifications go through the routing
+		// tree, so the receivers of the routes it may take need their
+		// template keys too
+		a.Routed = true
+		for _, r := range c.Route.MayMatch(a.Name) {
+			for name, n := range r.Receivers {
+				allNots[name] = n
+			}
+		}
+	}
 	if len(allNots) > 0 && a.Template == nil {
 		c.errorf("notifications specified but no template")
 	}
@@ -317,9 +423,14 @@ func (c *Conf) loadAlert(s *parse.SectionNode) {
 				checkSingleKey(tks.GetTemplate, ctx+" get url", alertTime)
 				checkSingleKey(tks.PostTemplate, ctx+" post url", alertTime)
 			}
+			checkSingleKey(not.ShortTemplate, "short text", true)
+			for h, key := range not.HeaderTemplates {
+				checkSingleKey(key, "header "+h, true)
+			}
 			checkTplKeys(&not.NotificationTemplateKeys, "alert", true)
 			checkTplKeys(&not.UnknownTemplateKeys, "unknown", false)
 			checkTplKeys(&not.UnknownMultiTemplateKeys, "unknownMulti", false)
+			checkTplKeys(&not.NoDataTemplateKeys, "noData", false)
 			for at, ntk := range not.ActionTemplateKeys {
 				key := at.String()
 				if at == models.ActionNone {
@@ -336,6 +447,61 @@ func (c *Conf) loadAlert(s *parse.SectionNode) {
 	c.Alerts[name] = &a
 }
 
+var severityNameRE = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_-]*$`)
+
+// loadSeverities sets the custom severity levels of a from the names given to
+// the severities key, lowest first, and the severity.<name> expressions and
+// severityNotification.<name> notifications.
+func (c *Conf) loadSeverities(a *conf.Alert, names []string, exprs map[string]*expr.Expr, nots map[string]*conf.Notifications) {
+	if names == nil {
+		if len(exprs) > 0 || len(nots) > 0 {
+			c.errorf("severity expressions or notifications specified without severities")
+		}
+		return
+	}
+	if a.Crit != nil || a.Warn != nil || a.CritNotification.Notifications != nil || a.CritNotification.Lookups != nil ||
+		a.WarnNotification.Notifications != nil || a.WarnNotification.Lookups != nil || a.CritEscalation != nil || a.WarnEscalation != nil {
+		c.errorf("severities can not be used with crit, warn or their notifications and escalations")
+	}
+	seen := make(map[string]bool)
+	for i, name := range names {
+		if !severityNameRE.MatchString(name) {
+			c.errorf("invalid severity name %q", name)
+		}
+		switch name {
+		case "none", "normal", "warning", "critical", "unknown", "nodata":
+			c.errorf("severity name %s is the name of a status", name)
+		}
+		if seen[name] {
+			c.errorf("duplicate severity %s", name)
+		}
+		seen[name] = true
+		e := exprs[name]
+		if e == nil {
+			c.errorf("no expression for severity %s", name)
+		}
+		ns := nots[name]
+		if ns == nil {
+			ns = new(conf.Notifications)
+		}
+		a.Severities = append(a.Severities, &conf.SeverityLevel{
+			Severity:     models.Severity{Name: name, Rank: i + 1},
+			Expr:         e,
+			Notification: ns,
+		})
+	}
+	for name := range exprs {
+		if !seen[name] {
+			c.errorf("expression for undeclared severity %s", name)
+		}
+	}
+	for name := range nots {
+		if !seen[name] {
+			c.errorf("notification for undeclared severity %s", name)
+		}
+	}
+}
+
 func (c *Conf) loadNotification(s *parse.SectionNode) {
 	name := s.Name.Text
 	if _, ok := c.Notifications[name]; ok {
@@ -352,6 +518,12 @@ func (c *Conf) loadNotification(s *parse.SectionNode) {
 	n.Text = s.RawText
 	n.Locator = newSectionLocator(s)
 	c.Notifications[name] = &n
+	httpAuth := func() *conf.HTTPAuth {
+		if n.HTTPAuth == nil {
+			n.HTTPAuth = &conf.HTTPAuth{}
+		}
+		return n.HTTPAuth
+	}
 	pairs := c.getPairs(s, n.Vars, sNormal)
 	for _, p := range pairs {
 		c.at(p.node)
@@ -364,6 +536,24 @@ func (c *Conf) loadNotification(s *parse.SectionNode) {
 				c.error(err)
 			}
 			n.Email = email
+		case "emailFrom":
+			from, err := mail.ParseAddress(v)
+			if err != nil {
+				c.error(err)
+			}
+			n.EmailFrom = from
+		case "emailReplyTo":
+			replyTo, err := mail.ParseAddressList(v)
+			if err != nil {
+				c.error(err)
+			}
+			n.EmailReplyTo = replyTo
+		case "emailCC":
+			cc, err := mail.ParseAddressList(v)
+			if err != nil {
+				c.error(err)
+			}
+			n.EmailCC = cc
 		case "post":
 			n.RawPost = v
 			post, err := url.Parse(n.RawPost)
@@ -404,6 +594,8 @@ func (c *Conf) loadNotification(s *parse.SectionNode) {
 			n.PostTemplate = v
 		case "emailSubjectTemplate":
 			n.EmailSubjectTemplate = v
+		case "shortTemplate":
+			n.ShortTemplate = v
 		case "runOnActions":
 			// todo: validate all/true, none/false, or comma seperated action shortNames
 			n.RunOnActions = v
@@ -427,12 +619,99 @@ func (c *Conf) loadNotification(s *parse.SectionNode) {
 				c.error(err)
 			}
 			n.UnknownThreshold = &i
+		case "digest":
+			d, err := opentsdb.ParseDuration(v)
+			if err != nil {
+				c.error(err)
+			}
+			n.Digest = time.Duration(d)
+			if n.Digest < time.Minute {
+				c.errorf("digest must be at least 1m")
+			}
+		case "digestTemplate":
+			n.DigestTemplateName = v
+			t, ok := c.Templates[v]
+			if !ok {
+				c.errorf("unknown template %s", v)
+			}
+			if t.Body == nil || t.Subject == nil {
+				c.errorf("digest template %s must have body and subject specified", v)
+			}
+			n.DigestTemplate = t
+		case "maxPerMinute":
+			i, err := strconv.Atoi(v)
+			if err != nil {
+				c.error(err)
+			}
+			if i <= 0 {
+				c.errorf("maxPerMinute must be greater than 0")
+			}
+			n.MaxPerMinute = i
+		case "burst":
+			i, err := strconv.Atoi(v)
+			if err != nil {
+				c.error(err)
+			}
+			if i <= 0 {
+				c.errorf("burst must be greater than 0")
+			}
+			n.Burst = i
+		case "dedupWindow":
+			d, err := opentsdb.ParseDuration(v)
+			if err != nil {
+				c.error(err)
+			}
+			n.DedupWindow = time.Duration(d)
+			if n.DedupWindow <= 0 {
+				c.errorf("dedupWindow must be greater than 0")
+			}
+		case "bearerTokenEnv":
+			httpAuth().BearerTokenEnv = v
+		case "bearerTokenFile":
+			httpAuth().BearerTokenFile = v
+		case "hmacSecretEnv":
+			httpAuth().HMACSecretEnv = v
+		case "hmacSecretFile":
+			httpAuth().HMACSecretFile = v
+		case "hmacHeader":
+			httpAuth().HMACHeader = v
+		case "clientCert":
+			httpAuth().ClientCert = v
+		case "clientKey":
+			httpAuth().ClientKey = v
+		case "caCert":
+			httpAuth().CACert = v
 		default:
+			if name := strings.TrimPrefix(k, "header."); name != k && name != "" {
+				if n.Headers == nil {
+					n.Headers = make(map[string]string)
+				}
+				n.Headers[name] = v
+				break
+			}
+			if name := strings.TrimPrefix(k, "headerTemplate."); name != k && name != "" {
+				if n.HeaderTemplates == nil {
+					n.HeaderTemplates = make(map[string]string)
+				}
+				n.HeaderTemplates[name] = v
+				break
+			}
+			if t := conf.GetTransport(k); t != nil {
+				if err := t.Validate(v); err != nil {
+					c.errorf("%s: %v", k, err)
+				}
+				if n.Transports == nil {
+					n.Transports = make(map[string]string)
+				}
+				n.Transports[k] = v
+				break
+			}
 			// all special template keys are handled in one loop
 			// the following formats are possible:
 			// action(templateKey)(ActionType})?   //action
 			// unknown(TemplateKey)                //unknown
 			// unknownMulti(TemplateKey)           //unknown
+			// noData(TemplateKey)                 //noData
 			var keys *conf.NotificationTemplateKeys
 			keyType := k
 			if strings.HasPrefix(k, "action") {
@@ -459,6 +738,9 @@ func (c *Conf) loadNotification(s *parse.SectionNode) {
 			} else if strings.HasPrefix(k, "unknown") {
 				keys = &n.UnknownTemplateKeys
 				keyType = strings.TrimPrefix(k, "unknown")
+			} else if strings.HasPrefix(k, "noData") {
+				keys = &n.NoDataTemplateKeys
+				keyType = strings.TrimPrefix(k, "noData")
 			} else {
 				c.errorf("unknown key %s", k)
 			}
@@ -483,4 +765,382 @@ func (c *Conf) loadNotification(s *parse.SectionNode) {
 	if n.Timeout > 0 && n.Next == nil {
 		c.errorf("timeout specified without next")
 	}
+	if n.DigestTemplate != nil && n.Digest == 0 {
+		c.errorf("digestTemplate specified without digest")
+	}
+	if n.Digest > 0 && n.Next != nil {
+		c.errorf("cannot use next with digest")
+	}
+	if n.Burst > 0 && n.MaxPerMinute == 0 {
+		c.errorf("burst specified without maxPerMinute")
+	}
+	if n.HTTPAuth != nil {
+		if err := n.HTTPAuth.Valid(); err != nil {
+			c.error(err)
+		}
+	}
+}
+
+func (c *Conf) loadCorrelation(s *parse.SectionNode) {
+	name := s.Name.Text
+	if _, ok := c.Correlations[name]; ok {
+		c.errorf("duplicate correlation name: %s", name)
+	}
+	cr := conf.Correlation{
+		Name:         name,
+		MinIncidents: 2,
+	}
+	cr.Text = s.RawText
+	cr.Locator = newSectionLocator(s)
+	pairs := c.getPairs(s, nil, sNormal)
+	for _, p := range pairs {
+		c.at(p.node)
+		v := p.val
+		switch p.key {
+		case "alerts":
+			for _, a := range strings.Split(v, ",") {
+				if a = strings.TrimSpace(a); a != "" {
+					cr.Alerts = append(cr.Alerts, a)
+				}
+			}
+		case "tags":
+			for _, t := range strings.Split(v, ",") {
+				if t = strings.TrimSpace(t); t != "" {
+					cr.Tags = append(cr.Tags, t)
+				}
+			}
+		case "window":
+			d, err := opentsdb.ParseDuration(v)
+			if err != nil {
+				c.error(err)
+			}
+			cr.Window = time.Duration(d)
+		case "minIncidents":
+			i, err := strconv.Atoi(v)
+			if err != nil {
+				c.error(err)
+			}
+			if i < 1 {
+				c.errorf("minIncidents must be at least 1")
+			}
+			cr.MinIncidents = i
+		case "root":
+			if _, ok := c.Alerts[v]; !ok {
+				c.errorf("unknown alert %s", v)
+			}
+			cr.Root = v
+		case "depends":
+			b, err := strconv.ParseBool(v)
+			if err != nil {
+				c.error(err)
+			}
+			cr.Depends = b
+		default:
+			c.errorf("unknown key %s", p.key)
+		}
+	}
+	c.at(s)
+	if len(cr.Tags) == 0 && cr.Window == 0 && cr.Root == "" && !cr.Depends {
+		c.errorf("correlation requires at least one of tags, window, root or depends")
+	}
+	c.Correlations[name] = &cr
+}
+
+// rotationTimeFormat is the format of the start and override times of
+// rotations.
+const rotationTimeFormat = time.RFC3339
+
+func (c *Conf) loadRotation(s *parse.SectionNode) {
+	name := s.Name.Text
+	if _, ok := c.Rotations[name]; ok {
+		c.errorf("duplicate rotation name: %s", name)
+	}
+	r := conf.Rotation{
+		Name: name,
+	}
+	r.Text = s.RawText
+	r.Locator = newSectionLocator(s)
+	notification := func(name string) *conf.Notification {
+		n := c.Notifications[name]
+		if n == nil {
+			c.errorf("unknown notification %s", name)
+		}
+		return n
+	}
+	pairs := c.getPairs(s, nil, sNormal)
+	for _, p := range pairs {
+		c.at(p.node)
+		v := p.val
+		switch p.key {
+		case "members":
+			for _, m := range strings.Split(v, ",") {
+				if m = strings.TrimSpace(m); m != "" {
+					r.Members = append(r.Members, notification(m))
+					r.MemberNames = append(r.MemberNames, m)
+				}
+			}
+		case "shift":
+			switch v {
+			case "daily":
+				r.Shift = 24 * time.Hour
+			case "weekly":
+				r.Shift = 7 * 24 * time.Hour
+			default:
+				d, err := opentsdb.ParseDuration(v)
+				if err != nil {
+					c.error(err)
+				}
+				r.Shift = time.Duration(d)
+			}
+			if r.Shift < time.Minute {
+				c.errorf("shift must be at least 1m")
+			}
+		case "start":
+			t, err := time.Parse(rotationTimeFormat, v)
+			if err != nil {
+				c.error(err)
+			}
+			r.Start = t.UTC()
+		case "overrides":
+			// member from to, member from to, ...
+			for _, o := range strings.Split(v, ",") {
+				if o = strings.TrimSpace(o); o == "" {
+					continue
+				}
+				f := strings.Fields(o)
+				if len(f) != 3 {
+					c.errorf("bad override %q, must be: notification from to", o)
+				}
+				from, err := time.Parse(rotationTimeFormat, f[1])
+				if err != nil {
+					c.error(err)
+				}
+				to, err := time.Parse(rotationTimeFormat, f[2])
+				if err != nil {
+					c.error(err)
+				}
+				if !to.After(from) {
+					c.errorf("override %q ends before it starts", o)
+				}
+				r.Overrides = append(r.Overrides, conf.RotationOverride{
+					Member: notification(f[0]),
+					Name:   f[0],
+					From:   from.UTC(),
+					To:     to.UTC(),
+				})
+			}
+		default:
+			c.errorf("unknown key %s", p.key)
+		}
+	}
+	c.at(s)
+	if len(r.Members) == 0 {
+		c.errorf("rotation requires members")
+	}
+	if r.Shift == 0 {
+		c.errorf("rotation requires a shift")
+	}
+	if r.Start.IsZero() {
+		c.errorf("rotation requires a start")
+	}
+	c.Rotations[name] = &r
+}
+
+var escalationLevelRE = regexp.MustCompile(`^level([1-9][0-9]*)(Delay)?$`)
+
+func (c *Conf) loadEscalation(s *parse.SectionNode) {
+	name := s.Name.Text
+	if _, ok := c.Escalations[name]; ok {
+		c.errorf("duplicate escalation name: %s", name)
+	}
+	e := conf.Escalation{
+		Name: name,
+	}
+	e.Text = s.RawText
+	e.Locator = newSectionLocator(s)
+	levels := make(map[int]*conf.EscalationLevel)
+	level := func(i int) *conf.EscalationLevel {
+		if levels[i] == nil {
+			levels[i] = &conf.EscalationLevel{
+				Notifications: make(map[string]*conf.Notification),
+				Rotations:     make(map[string]*conf.Rotation),
+			}
+		}
+		return levels[i]
+	}
+	pairs := c.getPairs(s, nil, sNormal)
+	for _, p := range pairs {
+		c.at(p.node)
+		v := p.val
+		m := escalationLevelRE.FindStringSubmatch(p.key)
+		if m == nil {
+			c.errorf("unknown key %s", p.key)
+		}
+		i, err := strconv.Atoi(m[1])
+		if err != nil {
+			c.error(err)
+		}
+		l := level(i)
+		if m[2] == "Delay" {
+			if i == 1 {
+				c.errorf("level1 is notified immediately and can not have a delay")
+			}
+			d, err := opentsdb.ParseDuration(v)
+			if err != nil {
+				c.error(err)
+			}
+			l.Delay = time.Duration(d)
+			continue
+		}
+		for _, n := range strings.Split(v, ",") {
+			if n = strings.TrimSpace(n); n == "" {
+				continue
+			}
+			r, not := c.Rotations[n], c.Notifications[n]
+			switch {
+			case r != nil && not != nil:
+				c.errorf("%s is both a rotation and a notification", n)
+			case r != nil:
+				l.Rotations[n] = r
+			case not != nil:
+				l.Notifications[n] = not
+			default:
+				c.errorf("unknown rotation or notification %s", n)
+			}
+			l.Names = append(l.Names, n)
+		}
+	}
+	c.at(s)
+	for i := 1; i <= len(levels); i++ {
+		l := levels[i]
+		if l == nil {
+			c.errorf("escalation levels must be numbered from 1 without gaps, level%d is missing", i)
+		}
+		if len(l.Names) == 0 {
+			c.errorf("level%d has no rotations or notifications", i)
+		}
+		if i > 1 && l.Delay <= 0 {
+			c.errorf("level%d requires a delay", i)
+		}
+		e.Levels = append(e.Levels, l)
+	}
+	if len(e.Levels) == 0 {
+		c.errorf("escalation requires at least one level")
+	}
+	c.Escalations[name] = &e
+}
+
+func (c *Conf) loadRoute(s *parse.SectionNode) {
+	if c.Route != nil {
+		c.errorf("duplicate route: routes other than the root must be nested in it")
+	}
+	r := c.loadRouteNode(s, nil, make(map[string]bool))
+	c.at(s)
+	if r.Alert != "" || len(r.Tags) > 0 || len(r.Severity) > 0 || r.Continue {
+		c.errorf("the root route matches everything and can not have alert, tags, severity or continue")
+	}
+	if len(r.Receivers) == 0 {
+		c.errorf("the root route requires a receiver")
+	}
+	r.Locator = newSectionLocator(s)
+	c.Route = r
+}
+
+// loadRouteNode loads the route of section s and its nested routes. Unset
+// receivers and grouping are inherited from parent.
+func (c *Conf) loadRouteNode(s *parse.SectionNode, parent *conf.Route, names map[string]bool) *conf.Route {
+	name := s.Name.Text
+	if names[name] {
+		c.errorf("duplicate route name: %s", name)
+	}
+	names[name] = true
+	r := &conf.Route{
+		Text: s.RawText,
+		Name: name,
+	}
+	if parent != nil {
+		r.Receivers = parent.Receivers
+		r.ReceiverNames = parent.ReceiverNames
+		r.GroupBy = parent.GroupBy
+		r.GroupWait = parent.GroupWait
+	}
+	var children []*parse.SectionNode
+	saw := make(map[string]bool)
+	groupWait := false
+	for _, n := range s.Nodes.Nodes {
+		c.at(n)
+		switch n := n.(type) {
+		case *parse.SectionNode:
+			if n.SectionType.Text != "route" {
+				c.errorf("unexpected subsection type %s", n.SectionType.Text)
+			}
+			children = append(children, n)
+		case *parse.PairNode:
+			c.seen(n.Key.Text, saw)
+			v := c.Expand(n.Val.Text, nil, false)
+			switch k := n.Key.Text; k {
+			case "alert":
+				r.Alert = v
+			case "tags":
+				tags, err := opentsdb.ParseTags(v)
+				if tags == nil && err != nil {
+					c.error(err)
+				}
+				r.Tags = tags
+			case "severity":
+				r.Severity = splitList(v)
+			case "receiver":
+				ns, err := c.parseNotifications(v)
+				if err != nil {
+					c.error(err)
+				}
+				r.Receivers = ns
+				r.ReceiverNames = splitList(v)
+			case "continue":
+				b, err := strconv.ParseBool(v)
+				if err != nil {
+					c.error(err)
+				}
+				r.Continue = b
+			case "groupBy":
+				r.GroupBy = splitList(v)
+			case "groupWait":
+				d, err := opentsdb.ParseDuration(v)
+				if err != nil {
+					c.error(err)
+				}
+				r.GroupWait = time.Duration(d)
+				if r.GroupWait <= 0 {
+					c.errorf("groupWait must be greater than 0")
+				}
+				groupWait = true
+			default:
+				c.errorf("unknown key %s", k)
+			}
+		default:
+			c.errorf("unexpected node")
+		}
+	}
+	c.at(s)
+	if groupWait && len(r.GroupBy) == 0 {
+		c.errorf("groupWait specified without groupBy")
+	}
+	if len(r.GroupBy) > 0 && r.GroupWait == 0 {
+		r.GroupWait = time.Minute
+	}
+	for _, child := range children {
+		r.Routes = append(r.Routes, c.loadRouteNode(child, r, names))
+	}
+	return r
+}
+
+// splitList splits a comma separated list, dropping empty items.
+func splitList(v string) []string {
+	var l []string
+	for _, s := range strings.Split(v, ",") {
+		if s = strings.TrimSpace(s); s != "" {
+			l = append(l, s)
+		}
+	}
+	return l
 }
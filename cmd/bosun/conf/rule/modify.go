@@ -89,8 +89,27 @@ func (c *Conf) BulkEdit(edits conf.BulkEditRequest) error {
 			if m != nil {
 				l = m.Locator.(Location)
 			}
+		case "correlation":
+			cr := newConf.GetCorrelation(edit.Name)
+			if cr != nil {
+				l = cr.Locator.(Location)
+			}
+		case "rotation":
+			r := newConf.GetRotation(edit.Name)
+			if r != nil {
+				l = r.Locator.(Location)
+			}
+		case "escalation":
+			e := newConf.GetEscalation(edit.Name)
+			if e != nil {
+				l = e.Locator.(Location)
+			}
+		case "route":
+			if r := newConf.GetRoute(); r != nil && r.Name == edit.Name {
+				l = r.Locator.(Location)
+			}
 		default:
-			return fmt.Errorf("%v is an unsuported type for bulk edit. must be alert, template, notification, lookup or macro", edit.Type)
+			return fmt.Errorf("%v is an unsuported type for bulk edit. must be alert, template, notification, lookup, macro, correlation, rotation, escalation or route", edit.Type)
 		}
 		var rawConf string
 		if edit.Delete {
@@ -33,6 +33,10 @@ type Conf struct {
 	RawText       string
 	Macros        map[string]*conf.Macro
 	Lookups       map[string]*conf.Lookup
+	Correlations  map[string]*conf.Correlation
+	Rotations     map[string]*conf.Rotation
+	Escalations   map[string]*conf.Escalation
+	Route         *conf.Route
 	Squelch       conf.Squelches `json:"-"`
 	NoSleep       bool
 
@@ -148,6 +152,9 @@ func NewConf(name string, backends conf.EnabledBackends, sysVars map[string]stri
 		customTemplates:  map[string]*template.Template{},
 		Lookups:          make(map[string]*conf.Lookup),
 		Macros:           make(map[string]*conf.Macro),
+		Correlations:     make(map[string]*conf.Correlation),
+		Rotations:        make(map[string]*conf.Rotation),
+		Escalations:      make(map[string]*conf.Escalation),
 		writeLock:        make(chan bool, 1),
 		deferredSections: make(map[string][]deferredSection),
 		backends:         backends,
@@ -182,7 +189,12 @@ func NewConf(name string, backends conf.EnabledBackends, sysVars map[string]stri
 	loadSections("macro")
 	loadSections("notification")
 	loadSections("lookup")
+	loadSections("rotation")
+	loadSections("escalation")
+	loadSections("route")
 	loadSections("alert")
+	c.checkAlertDependencies()
+	loadSections("correlation")
 
 	c.genHash()
 	return
@@ -220,6 +232,14 @@ func (c *Conf) loadSection(s *parse.SectionNode) {
 		ds.LoadFunc = c.loadMacro
 	case "lookup":
 		ds.LoadFunc = c.loadLookup
+	case "correlation":
+		ds.LoadFunc = c.loadCorrelation
+	case "rotation":
+		ds.LoadFunc = c.loadRotation
+	case "escalation":
+		ds.LoadFunc = c.loadEscalation
+	case "route":
+		ds.LoadFunc = c.loadRoute
 	default:
 		c.errorf("unknown section type: %s", s.SectionType.Text)
 	}
@@ -644,6 +664,12 @@ func (c *Conf) GetFuncs(backends conf.EnabledBackends) map[string]eparse.Func {
 			Tags:   tagAlert,
 			F:      c.alert,
 		},
+		"dependsOnAlert": {
+			Args:   []models.FuncType{models.TypeString, models.TypeString},
+			Return: models.TypeNumberSet,
+			Tags:   tagDependsOnAlert,
+			F:      c.dependsOnAlert,
+		},
 		"lookup": {
 			Args:   []models.FuncType{models.TypeString, models.TypeString},
 			Return: models.TypeNumberSet,
@@ -701,7 +727,11 @@ func (c *Conf) getAlertExpr(name, key string) (*conf.Alert, *expr.Expr, error) {
 	case "warn":
 		e = a.Warn
 	default:
-		return nil, nil, fmt.Errorf("alert: unsupported key %v", key)
+		l := a.Severity(key)
+		if l == nil {
+			return nil, nil, fmt.Errorf("alert: unsupported key %v", key)
+		}
+		e = l.Expr
 	}
 	if e == nil {
 		return nil, nil, fmt.Errorf("alert: nil expression")
@@ -787,6 +817,34 @@ func (c *Conf) GetLookup(s string) *conf.Lookup {
 	return c.Lookups[s]
 }
 
+func (c *Conf) GetCorrelations() map[string]*conf.Correlation {
+	return c.Correlations
+}
+
+func (c *Conf) GetCorrelation(s string) *conf.Correlation {
+	return c.Correlations[s]
+}
+
+func (c *Conf) GetRotations() map[string]*conf.Rotation {
+	return c.Rotations
+}
+
+func (c *Conf) GetRotation(s string) *conf.Rotation {
+	return c.Rotations[s]
+}
+
+func (c *Conf) GetEscalations() map[string]*conf.Escalation {
+	return c.Escalations
+}
+
+func (c *Conf) GetEscalation(s string) *conf.Escalation {
+	return c.Escalations[s]
+}
+
+func (c *Conf) GetRoute() *conf.Route {
+	return c.Route
+}
+
 func (c *Conf) GetSquelches() conf.Squelches {
 	return c.Squelch
 }
@@ -822,7 +880,7 @@ func (c *Conf) GetHash() string {
 	return c.Hash
 }
 
-// returns any notifications accessible from the alert vis warn/critNotification, including chains and lookups
+// returns any notifications accessible from the alert vis warn/critNotification and severity notifications, including chains and lookups
 func (c *Conf) getAllPossibleNotifications(a *conf.Alert) map[string]*conf.Notification {
 	nots := map[string]*conf.Notification{}
 	for k, v := range a.WarnNotification.GetAllChained() {
@@ -831,6 +889,19 @@ func (c *Conf) getAllPossibleNotifications(a *conf.Alert) map[string]*conf.Notif
 	for k, v := range a.CritNotification.GetAllChained() {
 		nots[k] = v
 	}
+	for _, l := range a.Severities {
+		for k, v := range l.Notification.GetAllChained() {
+			nots[k] = v
+		}
+	}
+	for _, e := range []*conf.Escalation{a.CritEscalation, a.WarnEscalation} {
+		if e == nil {
+			continue
+		}
+		for k, v := range e.GetAllChained() {
+			nots[k] = v
+		}
+	}
 	followLookup := func(l map[string]*conf.Lookup) {
 		for target, lookup := range l {
 			for _, entry := range lookup.Entries {
@@ -848,5 +919,8 @@ func (c *Conf) getAllPossibleNotifications(a *conf.Alert) map[string]*conf.Notif
 	}
 	followLookup(a.CritNotification.Lookups)
 	followLookup(a.WarnNotification.Lookups)
+	for _, l := range a.Severities {
+		followLookup(l.Notification.Lookups)
+	}
 	return nots
 }
@@ -4,9 +4,13 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	"bosun.org/cmd/bosun/conf"
+	"bosun.org/models"
+	"bosun.org/opentsdb"
 )
 
 func TestPrint(t *testing.T) {
@@ -38,6 +42,110 @@ func TestPrint(t *testing.T) {
 		t.Errorf("bad lookup: %v", w)
 	}
 	checkMacroVarAlert(t, c.Alerts["macroVarAlert"])
+	checkEscalation(t, c)
+	checkWebhook(t, c)
+	checkSMSLookup(t, c)
+	checkRoute(t, c)
+}
+
+func checkRoute(t *testing.T, c *Conf) {
+	r := c.GetRoute()
+	if r == nil || r.Find("db-crit") == nil {
+		t.Fatalf("bad routing tree: %+v", r)
+	}
+	if db := r.Find("db"); len(db.GroupBy) != 1 || db.GroupBy[0] != "env" || db.GroupWait != time.Minute {
+		t.Errorf("db did not inherit the grouping: %+v", db)
+	}
+	for _, test := range []struct {
+		alert  string
+		tags   opentsdb.TagSet
+		status models.Status
+		routes []string
+	}{
+		{"os.cpu", opentsdb.TagSet{"host": "db1"}, models.StCritical, []string{"db-crit"}},
+		{"os.cpu", opentsdb.TagSet{"host": "db1"}, models.StWarning, []string{"db"}},
+		{"os.cpu", opentsdb.TagSet{"host": "ny-db1"}, models.StWarning, []string{"ny"}},
+		{"mysql.lag", opentsdb.TagSet{"host": "db1"}, models.StCritical, []string{"root"}},
+	} {
+		var names []string
+		for _, route := range r.Match(test.alert, test.tags, test.status, nil) {
+			names = append(names, route.Name)
+		}
+		if strings.Join(names, ",") != strings.Join(test.routes, ",") {
+			t.Errorf("%s%v %v: got routes %v, expected %v", test.alert, test.tags, test.status, names, test.routes)
+		}
+	}
+	// db-crit sends to its own receivers instead of those of db.
+	routes := r.Match("os.cpu", opentsdb.TagSet{"host": "db1"}, models.StCritical, nil)
+	if ns := conf.RoutedNotifications(routes).Notifications; len(ns) != 2 || ns["nc3"] == nil || ns["nc4"] == nil {
+		t.Errorf("unexpected receivers %v", ns)
+	}
+	if !c.Alerts["routed"].Routed || c.Alerts["nc"].Routed || c.Alerts["m"].Routed {
+		t.Errorf("only alerts with a template and without notifications should be routed")
+	}
+}
+
+func checkSMSLookup(t *testing.T, c *Conf) {
+	for host, want := range map[string]string{
+		"db01":  "+15550000001, +15550000002",
+		"web01": "+15550000003",
+	} {
+		nots := c.Alerts["oncall"].CritNotification.Get(c, opentsdb.TagSet{"host": host})
+		if len(nots) != 1 {
+			t.Errorf("%s: expected 1 notification, got %v", host, nots)
+			continue
+		}
+		for _, n := range nots {
+			if n.Transports["sms"] != want {
+				t.Errorf("%s: got phones %q, expected %q", host, n.Transports["sms"], want)
+			}
+		}
+	}
+}
+
+func checkWebhook(t *testing.T, c *Conf) {
+	n := c.Notifications["webhook"]
+	if n.Headers["X-Api-Key"] != "key" || n.HeaderTemplates["X-Severity"] != "severity" {
+		t.Errorf("bad headers: %v, %v", n.Headers, n.HeaderTemplates)
+	}
+	want := conf.HTTPAuth{BearerTokenEnv: "WEBHOOK_TOKEN", HMACSecretFile: "/etc/bosun/webhook.secret", HMACHeader: "X-Signature"}
+	if n.HTTPAuth == nil || *n.HTTPAuth != want {
+		t.Errorf("bad auth: %+v", n.HTTPAuth)
+	}
+}
+
+func checkEscalation(t *testing.T, c *Conf) {
+	e := c.Alerts["escalated"].CritEscalation
+	if e == nil || e.Name != "db" || len(e.Levels) != 2 {
+		t.Fatalf("bad escalation: %+v", e)
+	}
+	if e.Levels[1].Delay != 15*time.Minute {
+		t.Errorf("bad level2 delay: %v", e.Levels[1].Delay)
+	}
+	start := time.Date(2017, 1, 2, 9, 0, 0, 0, time.UTC)
+	for _, test := range []struct {
+		t        time.Time
+		onCall   string
+		override bool
+	}{
+		{start, "nc1", false},
+		{start.Add(-time.Second), "nc3", false},
+		{start.Add(7 * 24 * time.Hour), "nc2", false},
+		{start.Add(8 * 24 * time.Hour), "nc4", true},
+		{start.Add(21 * 24 * time.Hour), "nc1", false},
+	} {
+		n, override := c.Rotations["dba"].OnCall(test.t)
+		if n.Name != test.onCall || override != test.override {
+			t.Errorf("%v: got %s (override %v), expected %s (override %v)", test.t, n.Name, override, test.onCall, test.override)
+		}
+	}
+	if h := c.Rotations["dba"].NextHandoff(start.Add(-time.Second)); !h.Equal(start) {
+		t.Errorf("bad next handoff: %v", h)
+	}
+	nots := e.Levels[0].Resolve(start)
+	if len(nots) != 1 || nots["nc1"] == nil {
+		t.Errorf("bad level1 notifications: %v", nots)
+	}
 }
 
 func checkMacroVarAlert(t *testing.T, a *conf.Alert) {
@@ -72,6 +180,16 @@ func TestInvalid(t *testing.T) {
 		"depends-no-overlap": `conf: depends-no-overlap:1:0: at <alert broken {\n	dep...>: Depends and crit/warn must share at least one tag.`,
 		"log-no-notification": `conf: log-no-notification:1:0: at <alert a {\n	crit = 1...>: log specified but no notification`,
 		"crit-notification-no-template": `conf: crit-notification-no-template:5:0: at <alert a {\n	crit = 1...>: notifications specified but no template`,
+		"correlation-unknown-root":      `conf: correlation-unknown-root:6:1: at <root = b>: unknown alert b`,
+		"dependency-cycle":              `conf: dependency-cycle:1:0: at <alert a {\n	depends ...>: dependency cycle: a -> b -> a`,
+		"escalation-level-gap":          `conf: escalation-level-gap:5:0: at <escalation e {\n	lev...>: escalation levels must be numbered from 1 without gaps, level2 is missing`,
+		"rotation-no-start":             `conf: rotation-no-start:5:0: at <rotation r {\n	membe...>: rotation requires a start`,
+		"notification-digest-next":      `conf: notification-digest-next:5:0: at <notification b {\n	d...>: cannot use next with digest`,
+		"notification-burst-no-rate":    `conf: notification-burst-no-rate:1:0: at <notification a {\n	p...>: burst specified without maxPerMinute`,
+		"notification-cert-no-key":      `conf: notification-cert-no-key:1:0: at <notification a {\n	p...>: clientCert and clientKey must be specified together`,
+		"notification-sms-phone":        `conf: notification-sms-phone:2:1: at <sms = 555-0100>: sms: invalid phone number 555-0100, must be in E.164 format like +15551234567`,
+		"route-root-match":              `conf: route-root-match:5:0: at <route root {\n	recei...>: the root route matches everything and can not have alert, tags, severity or continue`,
+		"severities-unmatching-tags":    `conf: severities-unmatching-tags:1:0: at <alert broken {\n	sev...>: severity tags must be equal (P2: a,c != P1: a)`,
 	}
 	for fname, reason := range names {
 		path := filepath.Join("invalid", fname)
@@ -0,0 +1,280 @@
+package conf
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jordan-wright/email"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+// smtpRootCAs are the certificate authorities that verify SMTP servers. Nil
+// uses the roots of the system.
+var smtpRootCAs *x509.CertPool
+
+// smtpTimeout bounds connecting to the SMTP server and each read and write
+// of the connection, so a server that stops answering fails the send instead
+// of holding it forever.
+var smtpTimeout = 30 * time.Second
+
+// Send an email through the SMTP server of sc. This function merges the To,
+// Cc, and Bcc fields and sends the Email.Bytes() output as the message.
+func sendEmail(e *email.Email, sc SMTPConf) error {
+	// Merge the To, Cc, and Bcc fields
+	to := make([]string, 0, len(e.To)+len(e.Cc)+len(e.Bcc))
+	to = append(append(append(to, e.To...), e.Cc...), e.Bcc...)
+	// Check to make sure there is at least one recipient and one "From" address
+	if e.From == "" || len(to) == 0 {
+		return errors.New("Must specify at least one From address and one To address")
+	}
+	from, err := mail.ParseAddress(e.From)
+	if err != nil {
+		return err
+	}
+	raw, err := e.Bytes()
+	if err != nil {
+		return err
+	}
+	return smtpSend(sc, from.Address, to, raw)
+}
+
+// smtpSend sends an email from address from, to addresses to, with message
+// msg, through a connection of the pool or a new one. A connection is put
+// back in the pool after a successful send.
+func smtpSend(sc SMTPConf, from string, to []string, msg []byte) error {
+	key := sc.Host + "\x00" + sc.Username
+	c := smtpConns.get(key, sc.IdleTimeout.Duration)
+	if c == nil {
+		var err error
+		if c, err = smtpDial(sc); err != nil {
+			return err
+		}
+	}
+	if err := smtpDeliver(c, from, to, msg); err != nil {
+		c.Close()
+		return err
+	}
+	if sc.MaxIdleConns <= 0 || !smtpConns.put(key, c, sc.MaxIdleConns) {
+		return c.Quit()
+	}
+	return nil
+}
+
+// smtpDial connects to the server of sc, secures the connection with
+// implicit TLS or STARTTLS, and authenticates if there are credentials.
+func smtpDial(sc SMTPConf) (*smtp.Client, error) {
+	host := sc.Host
+	if h, _, err := net.SplitHostPort(sc.Host); err == nil {
+		host = h
+	}
+	tlsConf := &tls.Config{ServerName: host, InsecureSkipVerify: !sc.VerifyTLS, RootCAs: smtpRootCAs}
+	dialer := &net.Dialer{Timeout: smtpTimeout}
+	conn, err := dialer.Dial("tcp", sc.Host)
+	if err != nil {
+		return nil, err
+	}
+	conn = deadlineConn{conn}
+	if sc.ImplicitTLS {
+		tc := tls.Client(conn, tlsConf)
+		if err := tc.Handshake(); err != nil {
+			conn.Close()
+			return nil, err
+		}
+		conn = tc
+	}
+	c, err := smtp.NewClient(conn, host)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	if err := smtpSecure(c, sc, tlsConf); err != nil {
+		c.Close()
+		return nil, err
+	}
+	return c, nil
+}
+
+// deadlineConn is a connection whose reads and writes fail after
+// smtpTimeout. It is under the TLS of implicit TLS, so that smtp.Client still
+// sees a TLS connection.
+type deadlineConn struct {
+	net.Conn
+}
+
+func (c deadlineConn) Read(b []byte) (int, error) {
+	c.SetDeadline(time.Now().Add(smtpTimeout))
+	return c.Conn.Read(b)
+}
+
+func (c deadlineConn) Write(b []byte) (int, error) {
+	c.SetDeadline(time.Now().Add(smtpTimeout))
+	return c.Conn.Write(b)
+}
+
+func smtpSecure(c *smtp.Client, sc SMTPConf, tlsConf *tls.Config) error {
+	if err := c.Hello("localhost"); err != nil {
+		return err
+	}
+	secure := sc.ImplicitTLS
+	if !secure {
+		if ok, _ := c.Extension("STARTTLS"); ok {
+			if err := c.StartTLS(tlsConf); err != nil {
+				return err
+			}
+			secure = true
+		} else if sc.StartTLS == "required" {
+			return fmt.Errorf("smtp: %s does not support STARTTLS", sc.Host)
+		}
+	}
+	// Credentials are only sent over TLS.
+	if !secure {
+		return nil
+	}
+	switch {
+	case sc.Auth == "xoauth2":
+		token, err := smtpOAuth2Token(sc.OAuth2)
+		if err != nil {
+			return fmt.Errorf("smtp: getting an oauth2 token: %v", err)
+		}
+		return c.Auth(&xoauth2Auth{username: sc.Username, token: token})
+	case len(sc.Username) > 0 || len(sc.Password) > 0:
+		return c.Auth(smtp.PlainAuth("", sc.Username, sc.Password, tlsConf.ServerName))
+	}
+	return nil
+}
+
+func smtpDeliver(c *smtp.Client, from string, to []string, msg []byte) error {
+	if err := c.Mail(from); err != nil {
+		return err
+	}
+	for _, addr := range to {
+		if err := c.Rcpt(addr); err != nil {
+			return err
+		}
+	}
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err = w.Write(msg); err != nil {
+		return err
+	}
+	return w.Close()
+}
+
+// smtpPool keeps the connections to SMTP servers idle between emails, so
+// bursts of notifications do not connect and authenticate for each email.
+type smtpPool struct {
+	sync.Mutex
+	idle map[string][]*idleSMTP
+}
+
+type idleSMTP struct {
+	c     *smtp.Client
+	since time.Time
+}
+
+var smtpConns = &smtpPool{idle: make(map[string][]*idleSMTP)}
+
+// get returns an idle connection with key that is still usable, or nil.
+func (p *smtpPool) get(key string, timeout time.Duration) *smtp.Client {
+	for {
+		p.Lock()
+		conns := p.idle[key]
+		if len(conns) == 0 {
+			p.Unlock()
+			return nil
+		}
+		ic := conns[len(conns)-1]
+		p.idle[key] = conns[:len(conns)-1]
+		p.Unlock()
+		// The server may have closed the connection since.
+		if time.Since(ic.since) < timeout && ic.c.Reset() == nil {
+			return ic.c
+		}
+		ic.c.Close()
+	}
+}
+
+// put keeps c idle, unless there are max idle connections with key already.
+func (p *smtpPool) put(key string, c *smtp.Client, max int) bool {
+	p.Lock()
+	defer p.Unlock()
+	if len(p.idle[key]) >= max {
+		return false
+	}
+	p.idle[key] = append(p.idle[key], &idleSMTP{c: c, since: time.Now()})
+	return true
+}
+
+// xoauth2Auth is the XOAUTH2 mechanism of Gmail and Office 365.
+type xoauth2Auth struct {
+	username, token string
+}
+
+func (a *xoauth2Auth) Start(server *smtp.ServerInfo) (string, []byte, error) {
+	if !server.TLS {
+		return "", nil, errors.New("unencrypted connection")
+	}
+	return "XOAUTH2", []byte("user=" + a.username + "\x01auth=Bearer " + a.token + "\x01\x01"), nil
+}
+
+func (a *xoauth2Auth) Next(fromServer []byte, more bool) ([]byte, error) {
+	if more {
+		// The server sent the details of a failure. An empty response gets
+		// the error.
+		return []byte{}, nil
+	}
+	return nil, nil
+}
+
+var (
+	smtpTokensLock sync.Mutex
+	smtpTokens     = make(map[string]oauth2.TokenSource)
+)
+
+// smtpOAuth2Token returns an access token for XOAUTH2. Tokens are cached
+// until they expire.
+func smtpOAuth2Token(oc SMTPOAuth2Conf) (string, error) {
+	key := strings.Join([]string{oc.TokenURL, oc.ClientID, oc.RefreshToken}, "\x00")
+	smtpTokensLock.Lock()
+	ts, ok := smtpTokens[key]
+	if !ok {
+		ctx := context.Background()
+		if oc.RefreshToken != "" {
+			cfg := &oauth2.Config{
+				ClientID:     oc.ClientID,
+				ClientSecret: oc.ClientSecret,
+				Endpoint:     oauth2.Endpoint{TokenURL: oc.TokenURL},
+				Scopes:       oc.Scopes,
+			}
+			ts = cfg.TokenSource(ctx, &oauth2.Token{RefreshToken: oc.RefreshToken})
+		} else {
+			cfg := &clientcredentials.Config{
+				ClientID:     oc.ClientID,
+				ClientSecret: oc.ClientSecret,
+				TokenURL:     oc.TokenURL,
+				Scopes:       oc.Scopes,
+			}
+			ts = cfg.TokenSource(ctx)
+		}
+		ts = oauth2.ReuseTokenSource(nil, ts)
+		smtpTokens[key] = ts
+	}
+	smtpTokensLock.Unlock()
+	t, err := ts.Token()
+	if err != nil {
+		return "", err
+	}
+	return t.AccessToken, nil
+}
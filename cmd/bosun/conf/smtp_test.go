@@ -0,0 +1,253 @@
+package conf
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"bosun.org/util"
+)
+
+// fakeSMTP is an SMTP server that accepts everything and records what it
+// got.
+type fakeSMTP struct {
+	net.Listener
+	tlsConf  *tls.Config
+	startTLS bool
+	// roots verify the certificate of the server.
+	roots *x509.CertPool
+
+	sync.Mutex
+	conns int
+	auths []string
+	rcpts []string
+	msgs  []string
+}
+
+func newFakeSMTP(t *testing.T, implicitTLS, startTLS bool) *fakeSMTP {
+	// Borrow the certificate of httptest.
+	ts := httptest.NewTLSServer(http.NotFoundHandler())
+	ts.Close()
+	f := &fakeSMTP{tlsConf: &tls.Config{Certificates: ts.TLS.Certificates}, startTLS: startTLS, roots: x509.NewCertPool()}
+	f.roots.AddCert(ts.Certificate())
+	var err error
+	if implicitTLS {
+		f.Listener, err = tls.Listen("tcp", "127.0.0.1:0", f.tlsConf)
+	} else {
+		f.Listener, err = net.Listen("tcp", "127.0.0.1:0")
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	go func() {
+		for {
+			conn, err := f.Accept()
+			if err != nil {
+				return
+			}
+			f.Lock()
+			f.conns++
+			f.Unlock()
+			go f.serve(conn, implicitTLS)
+		}
+	}()
+	return f
+}
+
+func (f *fakeSMTP) serve(conn net.Conn, secure bool) {
+	defer conn.Close()
+	tp := textproto.NewConn(conn)
+	tp.PrintfLine("220 fake ESMTP")
+	for {
+		line, err := tp.ReadLine()
+		if err != nil {
+			return
+		}
+		f.Lock()
+		switch strings.ToUpper(strings.Fields(line)[0]) {
+		case "EHLO":
+			tp.PrintfLine("250-fake")
+			if f.startTLS && !secure {
+				tp.PrintfLine("250-STARTTLS")
+			}
+			tp.PrintfLine("250 AUTH PLAIN XOAUTH2")
+		case "STARTTLS":
+			tp.PrintfLine("220 go ahead")
+			conn = tls.Server(conn, f.tlsConf)
+			tp = textproto.NewConn(conn)
+			secure = true
+		case "AUTH":
+			f.auths = append(f.auths, line)
+			tp.PrintfLine("235 ok")
+		case "RCPT":
+			f.rcpts = append(f.rcpts, line)
+			tp.PrintfLine("250 ok")
+		case "DATA":
+			tp.PrintfLine("354 go ahead")
+			f.Unlock()
+			b, _ := tp.ReadDotBytes()
+			f.Lock()
+			f.msgs = append(f.msgs, string(b))
+			tp.PrintfLine("250 ok")
+		case "QUIT":
+			tp.PrintfLine("221 bye")
+			f.Unlock()
+			return
+		default:
+			tp.PrintfLine("250 ok")
+		}
+		f.Unlock()
+	}
+}
+
+func TestSMTP(t *testing.T) {
+	util.InitHostManager("", false) // for the collect metrics
+
+	f := newFakeSMTP(t, false, true)
+	defer f.Close()
+	sc := &SystemConf{SMTPConf: SMTPConf{EmailFrom: "bosun@example.com", Host: f.Addr().String(), Username: "u", Password: "p"}}
+	n := &Notification{
+		Email:        []*mail.Address{{Address: "oncall@example.com"}},
+		EmailFrom:    &mail.Address{Name: "Ops", Address: "ops@example.com"},
+		EmailReplyTo: []*mail.Address{{Address: "team@example.com"}},
+		EmailCC:      []*mail.Address{{Address: "lead@example.com"}},
+	}
+	for i := 0; i < 2; i++ {
+		if err := n.PrepEmail("subject", "body", "a{host=x}", nil).Send(sc); err != nil {
+			t.Fatal(err)
+		}
+	}
+	f.Lock()
+	defer f.Unlock()
+	// Both emails are sent through one connection, authenticated once.
+	if f.conns != 1 || len(f.msgs) != 2 {
+		t.Fatalf("expected 2 emails through 1 connection, got %d through %d", len(f.msgs), f.conns)
+	}
+	if len(f.auths) != 1 || !strings.HasPrefix(f.auths[0], "AUTH PLAIN ") {
+		t.Errorf("unexpected auths %q", f.auths)
+	}
+	if len(f.rcpts) != 4 || !strings.Contains(f.rcpts[1], "lead@example.com") {
+		t.Errorf("unexpected recipients %q", f.rcpts)
+	}
+	for _, h := range []string{`From: "Ops" <ops@example.com>`, "Reply-To: <team@example.com>", "Cc: lead@example.com"} {
+		if !strings.Contains(f.msgs[0], h) {
+			t.Errorf("missing header %s in %s", h, f.msgs[0])
+		}
+	}
+}
+
+func TestSMTPStartTLS(t *testing.T) {
+	if err := (SMTPConf{StartTLS: "always"}).Valid(); err == nil {
+		t.Fatal("expected an invalid StartTLS")
+	}
+	f := newFakeSMTP(t, false, false)
+	defer f.Close()
+	sc := SMTPConf{Host: f.Addr().String(), Username: "u", Password: "p", StartTLS: "required", MaxIdleConns: -1}
+	if err := smtpSend(sc, "bosun@example.com", []string{"oncall@example.com"}, []byte("msg")); err == nil {
+		t.Fatal("expected an error without STARTTLS")
+	}
+	// Credentials are not sent without TLS.
+	sc.StartTLS = "optional"
+	if err := smtpSend(sc, "bosun@example.com", []string{"oncall@example.com"}, []byte("msg")); err != nil {
+		t.Fatal(err)
+	}
+	f.Lock()
+	defer f.Unlock()
+	if len(f.msgs) != 1 || len(f.auths) != 0 {
+		t.Fatalf("expected an unauthenticated email, got %d emails and auths %q", len(f.msgs), f.auths)
+	}
+}
+
+func TestSMTPXOAuth2(t *testing.T) {
+	var grants []string
+	tokens := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		grants = append(grants, r.FormValue("grant_type"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token": "tok", "token_type": "Bearer", "expires_in": 3600}`))
+	}))
+	defer tokens.Close()
+	f := newFakeSMTP(t, true, false)
+	defer f.Close()
+	defer func(roots *x509.CertPool) { smtpRootCAs = roots }(smtpRootCAs)
+	smtpRootCAs = f.roots
+
+	sc := (&SystemConf{SMTPConf: SMTPConf{
+		Host:        f.Addr().String(),
+		Username:    "bosun@example.com",
+		ImplicitTLS: true,
+		Auth:        "xoauth2",
+		OAuth2:      SMTPOAuth2Conf{TokenURL: tokens.URL, ClientID: "id", RefreshToken: "refresh"},
+		IdleTimeout: Duration{Duration: time.Nanosecond},
+	}}).GetSMTPConf()
+	// Tokens are not sent over TLS that is not verified.
+	if err := sc.Valid(); err == nil || !strings.Contains(err.Error(), "VerifyTLS") {
+		t.Fatalf("expected xoauth2 to require VerifyTLS, got %v", err)
+	}
+	sc.VerifyTLS = true
+	if err := sc.Valid(); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		if err := smtpSend(sc, "bosun@example.com", []string{"oncall@example.com"}, []byte("msg")); err != nil {
+			t.Fatal(err)
+		}
+	}
+	f.Lock()
+	defer f.Unlock()
+	// The idle connection expired, so the second email connected again, but
+	// the token was reused.
+	if f.conns != 2 || len(f.auths) != 2 {
+		t.Fatalf("expected 2 connections and auths, got %d and %q", f.conns, f.auths)
+	}
+	want := "AUTH XOAUTH2 " + base64.StdEncoding.EncodeToString([]byte("user=bosun@example.com\x01auth=Bearer tok\x01\x01"))
+	if f.auths[0] != want {
+		t.Errorf("got %q, expected %q", f.auths[0], want)
+	}
+	if len(grants) != 1 || grants[0] != "refresh_token" {
+		t.Errorf("unexpected token requests %q", grants)
+	}
+}
+
+func TestSMTPTimeout(t *testing.T) {
+	defer func(d time.Duration) { smtpTimeout = d }(smtpTimeout)
+	smtpTimeout = 100 * time.Millisecond
+
+	// The server accepts the connection and never answers.
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+		}
+	}()
+	sc := SMTPConf{Host: l.Addr().String(), MaxIdleConns: -1}
+	errc := make(chan error, 1)
+	go func() {
+		errc <- smtpSend(sc, "bosun@example.com", []string{"oncall@example.com"}, []byte("msg"))
+	}()
+	select {
+	case err := <-errc:
+		if err == nil {
+			t.Fatal("expected a timeout")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("the send waits for the server forever")
+	}
+}
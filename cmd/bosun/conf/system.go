@@ -7,6 +7,7 @@ import (
 	"net/http/httputil"
 	"net/url"
 	"strings"
+	"sync"
 	"time"
 
 	"bosun.org/cloudwatch"
@@ -50,11 +51,21 @@ type SystemConf struct {
 	CheckFrequency         Duration // Time between alert checks: 5m
 	DefaultRunEvery        int      // Default number of check intervals to run each alert: 1
 	AlertCheckDistribution string   // Method to distribute alet checks. No distribution if equals ""
+	AlertTimeout           Duration // Maximum time an alert check may take, unless the alert sets its own timeout. No limit if 0
+	MaxAlertKeys           int      // Maximum number of alert keys an alert expression may return, unless the alert sets its own limit. No limit if 0
+
+	BackendConf BackendConf
 
 	DBConf DBConf
 
 	SMTPConf SMTPConf
 
+	SlackConf SlackConf
+
+	TwilioConf TwilioConf
+
+	OutboxConf OutboxConf
+
 	RuleVars map[string]string
 
 	ExampleExpression string
@@ -77,6 +88,21 @@ type SystemConf struct {
 	CommandHookPath string
 	RuleFilePath    string
 	md              toml.MetaData
+
+	guardOnce sync.Once
+	guard     *expr.BackendGuard
+}
+
+// BackendConf limits the load alert checks put on the query backends. MaxConcurrent
+// limits the number of concurrent queries per backend type (opentsdb, graphite, influx,
+// elastic, prom, azure, cloudwatch). After BreakerFailures consecutive connection failures
+// to a backend host, queries to it fail immediately for BreakerCooldown. Queries
+// wait at most MaxWait for a free slot.
+type BackendConf struct {
+	MaxConcurrent   map[string]int
+	MaxWait         Duration // default 1m
+	BreakerFailures int      // 0 disables the circuit breakers
+	BreakerCooldown Duration // default 1m
 }
 
 // EnabledBackends stores which query backends supported by bosun are enabled
@@ -251,6 +277,98 @@ type SMTPConf struct {
 	Host      string
 	Username  string
 	Password  string `json:"-"`
+
+	// StartTLS is "optional" (the default), to upgrade connections with
+	// STARTTLS when the server supports it, or "required", to fail without
+	// it. ImplicitTLS connects with TLS from the start instead, and is the
+	// default when the port of Host is 465.
+	StartTLS    string
+	ImplicitTLS bool
+	// VerifyTLS verifies the certificate of the server.
+	VerifyTLS bool
+
+	// Auth is "plain" (the default) or "xoauth2", to authenticate as
+	// Username with an OAuth2 access token. xoauth2 requires VerifyTLS, so
+	// tokens are only sent to the real server.
+	Auth   string
+	OAuth2 SMTPOAuth2Conf
+
+	// MaxIdleConns is the number of connections kept open to send the
+	// next emails through, for up to IdleTimeout. 0 means 2, and -1 opens a
+	// connection per email.
+	MaxIdleConns int
+	IdleTimeout  Duration // default 30s
+}
+
+// SMTPOAuth2Conf is how access tokens for XOAUTH2 are obtained: with the
+// RefreshToken, or with the client credentials of the app if there is none.
+type SMTPOAuth2Conf struct {
+	TokenURL     string
+	ClientID     string
+	ClientSecret string `json:"-"`
+	RefreshToken string `json:"-"`
+	Scopes       []string
+}
+
+// Valid returns an error if the configuration of the SMTP server is invalid.
+func (s SMTPConf) Valid() error {
+	switch s.StartTLS {
+	case "", "optional", "required":
+	default:
+		return fmt.Errorf("invalid StartTLS %q, must be optional or required", s.StartTLS)
+	}
+	switch s.Auth {
+	case "", "plain":
+	case "xoauth2":
+		if s.Username == "" {
+			return fmt.Errorf("xoauth2 requires a Username")
+		}
+		if s.OAuth2.TokenURL == "" || s.OAuth2.ClientID == "" {
+			return fmt.Errorf("xoauth2 requires OAuth2.TokenURL and OAuth2.ClientID")
+		}
+		if !s.VerifyTLS {
+			return fmt.Errorf("xoauth2 requires VerifyTLS")
+		}
+	default:
+		return fmt.Errorf("invalid Auth %q, must be plain or xoauth2", s.Auth)
+	}
+	return nil
+}
+
+// OutboxConf sets how notifications that fail to send are retried. Failed
+// notifications are retried with exponential backoff from MinBackoff up to
+// MaxBackoff, and become dead letters after MaxAttempts attempts.
+type OutboxConf struct {
+	MaxAttempts int      // default 8
+	MinBackoff  Duration // default 10s
+	MaxBackoff  Duration // default 10m
+}
+
+// SlackConf contains the credentials of the Slack app that bosun uses to post
+// slack notifications and to accept the buttons of their messages.
+type SlackConf struct {
+	// Token is the bot token of the app, like xoxb-...
+	Token string `json:"-"`
+	// SigningSecret verifies the interactive callbacks of the app.
+	SigningSecret string `json:"-"`
+	// APIURL is the base URL of the Web API, default https://slack.com/api.
+	APIURL string
+}
+
+// TwilioConf contains the account of the Twilio compatible API that bosun
+// sends sms and voice notifications with.
+type TwilioConf struct {
+	AccountSID string
+	AuthToken  string `json:"-"`
+	// From is the phone number the messages and calls come from.
+	From string
+	// BaseURL is the base URL of the API, default https://api.twilio.com.
+	BaseURL string
+	// MaxSMSLength and MaxVoiceLength are the maximum number of characters
+	// of a message and of the text spoken by a call, default 160 and 500.
+	// Longer texts are cut.
+	MaxSMSLength   int
+	MaxVoiceLength int
 }
 
 //AuthConf is configuration for bosun's authentication
@@ -347,6 +465,13 @@ func newSystemConf() *SystemConf {
 		},
 		SearchSince:      Duration{time.Duration(opentsdb.Day) * 3},
 		UnknownThreshold: 5,
+		BackendConf: BackendConf{
+			MaxWait:         Duration{Duration: time.Minute},
+			BreakerCooldown: Duration{Duration: time.Minute},
+		},
+		SlackConf: SlackConf{
+			APIURL: "https://slack.com/api",
+		},
 	}
 }
 
@@ -392,6 +517,10 @@ func loadSystemConfig(conf string, isFileName bool) (*SystemConf, error) {
 		return sc, fmt.Errorf("Can't use both ES SimpleClient and ES ClientOptions please remove or disable one in AnnotateConf: %#v", sc.AnnotateConf)
 	}
 
+	if err := sc.SMTPConf.Valid(); err != nil {
+		return sc, fmt.Errorf("error in SMTPConf: %v", err)
+	}
+
 	// Check Azure Monitor Configurations
 	for prefix, conf := range sc.AzureMonitorConf {
 		if err := conf.Valid(); err != nil {
@@ -454,6 +583,68 @@ func (sc *SystemConf) GetEmailFrom() string {
 	return sc.SMTPConf.EmailFrom
 }
 
+// GetOutboxConf returns how failed notifications are retried, with the
+// defaults of unset values
+func (sc *SystemConf) GetOutboxConf() OutboxConf {
+	oc := sc.OutboxConf
+	if oc.MaxAttempts <= 0 {
+		oc.MaxAttempts = 8
+	}
+	if oc.MinBackoff.Duration <= 0 {
+		oc.MinBackoff.Duration = 10 * time.Second
+	}
+	if oc.MaxBackoff.Duration <= 0 {
+		oc.MaxBackoff.Duration = 10 * time.Minute
+	}
+	if oc.MaxBackoff.Duration < oc.MinBackoff.Duration {
+		oc.MaxBackoff.Duration = oc.MinBackoff.Duration
+	}
+	return oc
+}
+
+// GetSMTPConf returns the configuration of the SMTP server with the defaults
+// applied
+func (sc *SystemConf) GetSMTPConf() SMTPConf {
+	s := sc.SMTPConf
+	if s.StartTLS == "" {
+		s.StartTLS = "optional"
+	}
+	if strings.HasSuffix(s.Host, ":465") {
+		s.ImplicitTLS = true
+	}
+	if s.Auth == "" {
+		s.Auth = "plain"
+	}
+	if s.MaxIdleConns == 0 {
+		s.MaxIdleConns = 2
+	}
+	if s.IdleTimeout.Duration <= 0 {
+		s.IdleTimeout.Duration = 30 * time.Second
+	}
+	return s
+}
+
+// GetSlackConf returns the Slack app configuration used by slack notifications
+func (sc *SystemConf) GetSlackConf() SlackConf {
+	return sc.SlackConf
+}
+
+// GetTwilioConf returns the Twilio configuration used by sms and voice
+// notifications, with the defaults applied.
+func (sc *SystemConf) GetTwilioConf() TwilioConf {
+	t := sc.TwilioConf
+	if t.BaseURL == "" {
+		t.BaseURL = "https://api.twilio.com"
+	}
+	if t.MaxSMSLength <= 0 {
+		t.MaxSMSLength = 160
+	}
+	if t.MaxVoiceLength <= 0 {
+		t.MaxVoiceLength = 500
+	}
+	return t
+}
+
 // GetPing returns if Bosun's pinging is enabled. When Ping is enabled, bosun will ping all hosts
 // that is has indexed and record metrics about those pings.
 func (sc *SystemConf) GetPing() bool {
@@ -549,6 +740,17 @@ func (sc *SystemConf) GetAlertCheckDistribution() string {
 	return sc.AlertCheckDistribution
 }
 
+// GetAlertTimeout returns the default maximum duration of an alert check
+func (sc *SystemConf) GetAlertTimeout() time.Duration {
+	return sc.AlertTimeout.Duration
+}
+
+// GetMaxAlertKeys returns the default maximum number of alert keys an alert
+// expression may return
+func (sc *SystemConf) GetMaxAlertKeys() int {
+	return sc.MaxAlertKeys
+}
+
 // GetUnknownThreshold returns the threshold in which multiple unknown alerts in a check iteration
 // should be grouped into a single notification
 func (sc *SystemConf) GetUnknownThreshold() int {
@@ -697,6 +899,21 @@ func (sc *SystemConf) GetPromContext() expr.PromClients {
 	return clients
 }
 
+// GetBackendGuard returns the guard that limits concurrent queries and holds the
+// circuit breakers of the query backends. It is shared by all alert checks so the
+// breakers keep their state between runs.
+func (sc *SystemConf) GetBackendGuard() *expr.BackendGuard {
+	sc.guardOnce.Do(func() {
+		sc.guard = &expr.BackendGuard{
+			MaxConcurrent: sc.BackendConf.MaxConcurrent,
+			MaxWait:       sc.BackendConf.MaxWait.Duration,
+			Failures:      sc.BackendConf.BreakerFailures,
+			Cooldown:      sc.BackendConf.BreakerCooldown.Duration,
+		}
+	})
+	return sc.guard
+}
+
 // GetElasticContext returns an Elastic context which contains all the information
 // needed to run Elastic queries.
 func (sc *SystemConf) GetElasticContext() expr.ElasticHosts {
@@ -0,0 +1,105 @@
+package conf
+
+import (
+	"bosun.org/models"
+)
+
+func init() {
+	RegisterTransport("teams", teams{})
+}
+
+// teams is the transport of notifications with a teams key, whose value is
+// the URL of a Microsoft Teams incoming webhook or workflow. Notifications
+// are posted as Adaptive Cards.
+type teams struct{}
+
+func (teams) Validate(dst string) error {
+	return validateWebhook(dst)
+}
+
+func (teams) Redact(dst string) string {
+	return redactURL(dst)
+}
+
+func (teams) Send(p *PreparedTransport, c SystemConfProvider) error {
+	return postJSON(p.Destination, teamsMessage(NewCard(p, c)))
+}
+
+// adaptiveCardColor maps the status of a card to a color of Adaptive Card
+// text.
+func adaptiveCardColor(st models.Status) string {
+	switch st {
+	case models.StNormal:
+		return "good"
+	case models.StWarning:
+		return "warning"
+	case models.StCritical:
+		return "attention"
+	case models.StUnknown, models.StNoData:
+		return "accent"
+	}
+	return "default"
+}
+
+// teamsMessage renders a card as a message with an Adaptive Card attachment.
+func teamsMessage(card *Card) map[string]interface{} {
+	body := []interface{}{
+		map[string]interface{}{
+			"type":   "TextBlock",
+			"text":   card.Title,
+			"size":   "medium",
+			"weight": "bolder",
+			"color":  adaptiveCardColor(card.Status),
+			"wrap":   true,
+		},
+	}
+	if card.Subtitle != "" {
+		body = append(body, map[string]interface{}{
+			"type":     "TextBlock",
+			"text":     card.Subtitle,
+			"isSubtle": true,
+			"spacing":  "none",
+			"wrap":     true,
+		})
+	}
+	if card.Text != "" {
+		body = append(body, map[string]interface{}{
+			"type": "TextBlock",
+			"text": card.Text,
+			"wrap": true,
+		})
+	}
+	if len(card.Facts) > 0 {
+		facts := make([]interface{}, len(card.Facts))
+		for i, f := range card.Facts {
+			facts[i] = map[string]string{"title": f.Title, "value": f.Value}
+		}
+		body = append(body, map[string]interface{}{
+			"type":  "FactSet",
+			"facts": facts,
+		})
+	}
+	actions := make([]interface{}, len(card.Buttons))
+	for i, b := range card.Buttons {
+		actions[i] = map[string]string{
+			"type":  "Action.OpenUrl",
+			"title": b.Text,
+			"url":   b.URL,
+		}
+	}
+	return map[string]interface{}{
+		"type": "message",
+		"attachments": []interface{}{
+			map[string]interface{}{
+				"contentType": "application/vnd.microsoft.card.adaptive",
+				"content": map[string]interface{}{
+					"$schema": "http://adaptivecards.io/schemas/adaptive-card.json",
+					"type":    "AdaptiveCard",
+					"version": "1.4",
+					"body":    body,
+					"actions": actions,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,238 @@
+package conf
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"bosun.org/collect"
+	"bosun.org/metadata"
+	"bosun.org/models"
+	"bosun.org/opentsdb"
+)
+
+func init() {
+	metadata.AddMetricMeta(
+		"bosun.transport.sent", metadata.Counter, metadata.PerSecond,
+		"The number of notifications sent by Bosun with a notification transport.")
+	metadata.AddMetricMeta(
+		"bosun.transport.sent_failed", metadata.Counter, metadata.PerSecond,
+		"The number of notifications that Bosun failed to send with a notification transport.")
+}
+
+// A Transport sends notifications to a service that needs more than the
+// email, get and post keys of a notification. Transports are registered by
+// name with RegisterTransport. A notification uses a transport when it has a
+// key with the name of the transport, whose value is the destination the
+// transport sends to, such as the routing key of a PagerDuty service.
+type Transport interface {
+	// Validate checks the destination of a notification when the rule
+	// configuration is loaded.
+	Validate(dst string) error
+	// Send sends a notification prepared for the transport.
+	Send(p *PreparedTransport, c SystemConfProvider) error
+}
+
+// A Redacter is a Transport whose destinations are secrets, such as routing
+// keys or webhook URLs that embed their key.
+type Redacter interface {
+	// Redact returns what may be stored and shown of dst.
+	Redact(dst string) string
+}
+
+// A Splitter is a Transport whose destinations list several recipients.
+type Splitter interface {
+	// SplitDestination returns a destination for each recipient of dst, so
+	// that they are sent and retried separately.
+	SplitDestination(dst string) []string
+}
+
+// An ActionSkipper is a Transport that may not send action notifications.
+type ActionSkipper interface {
+	// SkipActions reports whether action notifications are not sent.
+	SkipActions() bool
+}
+
+var transports = make(map[string]Transport)
+
+// RegisterTransport makes a transport available to notifications under name.
+// It panics if a transport is registered twice under the same name.
+func RegisterTransport(name string, t Transport) {
+	if _, ok := transports[name]; ok {
+		panic(fmt.Sprintf("conf: transport %s registered twice", name))
+	}
+	transports[name] = t
+}
+
+// GetTransport returns the transport registered under name, or nil.
+func GetTransport(name string) Transport {
+	return transports[name]
+}
+
+// RedactDestination returns the destination of a send with transport
+// ("email", "http" or the name of a transport) without its secrets, so it can
+// be stored with the incidents and shown to everyone who can see them. The
+// URLs of http sends are cut to their scheme and host.
+func RedactDestination(transport, dst string) string {
+	switch transport {
+	case "email":
+		return dst
+	case "http":
+		if i := strings.Index(dst, " "); i != -1 {
+			return dst[:i+1] + redactURL(dst[i+1:])
+		}
+		return redactURL(dst)
+	}
+	if r, ok := GetTransport(transport).(Redacter); ok {
+		return r.Redact(dst)
+	}
+	return dst
+}
+
+// redactURL returns the scheme and host of u, followed by "/..." if u has
+// more, such as a path with a key.
+func redactURL(u string) string {
+	p, err := url.Parse(u)
+	if err != nil || p.Host == "" {
+		return maskSecret(u)
+	}
+	r := p.Scheme + "://" + p.Host
+	if (p.Path != "" && p.Path != "/") || p.RawQuery != "" || p.Fragment != "" {
+		r += "/..."
+	}
+	return r
+}
+
+// redactError removes the URL of a failed request from err, since it may have
+// a key in it, like the URL of a webhook.
+func redactError(err error) error {
+	if ue, ok := err.(*url.Error); ok {
+		return &url.Error{Op: ue.Op, URL: redactURL(ue.URL), Err: ue.Err}
+	}
+	return err
+}
+
+// maskSecret returns s with all but its last four characters masked, or
+// only a mask if s is too short to show any of it.
+func maskSecret(s string) string {
+	if len(s) < 12 {
+		return "****"
+	}
+	return "****" + s[len(s)-4:]
+}
+
+// PreparedTransport is a notification rendered for a transport, ready to be
+// sent.
+type PreparedTransport struct {
+	Transport   string
+	Destination string
+	Subject     string
+	Body        string
+	// Short is the rendered ShortTemplate of the notification.
+	Short string `json:",omitempty"`
+	// Status is the status of the incident of an alert notification.
+	Status models.Status `json:",omitempty"`
+	// Action is the action of an action notification.
+	Action models.ActionType `json:",omitempty"`
+	// Incidents are the ids of the incidents of an alert or action
+	// notification.
+	Incidents []int64 `json:",omitempty"`
+	Details   *NotificationDetails
+}
+
+// Kind is the kind of notification, such as "alert" or "unknown".
+func (p *PreparedTransport) Kind() string {
+	return p.Details.Kind()
+}
+
+// Send sends p with its transport.
+func (p *PreparedTransport) Send(c SystemConfProvider) error {
+	t := GetTransport(p.Transport)
+	if t == nil {
+		return fmt.Errorf("unknown notification transport %s", p.Transport)
+	}
+	ts := opentsdb.TagSet{"transport": p.Transport}
+	if err := t.Send(p, c); err != nil {
+		collect.Add("transport.sent_failed", ts, 1)
+		return fmt.Errorf("%s notification %s for alert keys %v: %v", p.Transport, p.Details.NotifyName, p.Details.Ak, err)
+	}
+	collect.Add("transport.sent", ts, 1)
+	return nil
+}
+
+// prepTransports adds a notification for each transport of n to pn, or for
+// each recipient of a Splitter. Transports that skip actions are left out of
+// action notifications, which have no NotifyType.
+func (n *Notification) prepTransports(pn *PreparedNotifications, subject, body string, status models.Status, details *NotificationDetails) {
+	names := make([]string, 0, len(n.Transports))
+	for name := range n.Transports {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		t := GetTransport(name)
+		if as, ok := t.(ActionSkipper); ok && as.SkipActions() && details.NotifyType == 0 {
+			continue
+		}
+		dsts := []string{n.Transports[name]}
+		if sp, ok := t.(Splitter); ok {
+			dsts = sp.SplitDestination(n.Transports[name])
+		}
+		for _, dst := range dsts {
+			pn.Transports = append(pn.Transports, &PreparedTransport{
+				Transport:   name,
+				Destination: dst,
+				Subject:     subject,
+				Body:        body,
+				Status:      status,
+				Details:     details,
+			})
+		}
+	}
+}
+
+// StatusError is the error of a request answered with an unsuccessful
+// status.
+type StatusError struct {
+	Code int
+	Msg  string
+}
+
+func (e *StatusError) Error() string {
+	return e.Msg
+}
+
+// NotifyTimeout bounds each request of a notification, so a server that
+// accepts a connection and never answers fails the send instead of holding
+// it forever.
+const NotifyTimeout = 30 * time.Second
+
+// NotifyClient is the http client of notifications.
+var NotifyClient = &http.Client{Timeout: NotifyTimeout}
+
+// postJSON posts v as JSON to url, for transports of services with JSON APIs.
+func postJSON(url string, v interface{}) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	resp, err := NotifyClient.Post(url, "application/json", bytes.NewReader(b))
+	if err != nil {
+		return redactError(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		msg, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 512))
+		return &StatusError{Code: resp.StatusCode, Msg: fmt.Sprintf("%s: %s", resp.Status, bytes.TrimSpace(msg))}
+	}
+	// Drain the body to let the Transport reuse the connection
+	io.Copy(ioutil.Discard, io.LimitReader(resp.Body, 512))
+	return nil
+}
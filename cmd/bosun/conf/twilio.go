@@ -0,0 +1,133 @@
+package conf
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+func init() {
+	RegisterTransport("sms", twilio{})
+	RegisterTransport("voice", twilio{voice: true})
+}
+
+// twilio is the transport of notifications with an sms or voice key, whose
+// value is a comma separated list of phone numbers. Notifications are sent as
+// text messages or as calls that speak the text, through the Twilio API or a
+// compatible one configured in TwilioConf. The text is the rendered
+// shortTemplate of the notification, or its subject, cut to the maximum
+// length of TwilioConf.
+type twilio struct {
+	voice bool
+}
+
+// phoneNumberRE matches phone numbers in E.164 format.
+var phoneNumberRE = regexp.MustCompile(`^\+[1-9][0-9]{6,14}$`)
+
+// phoneNumbers splits a comma separated list of phone numbers.
+func phoneNumbers(dst string) []string {
+	var phones []string
+	for _, p := range strings.Split(dst, ",") {
+		if p = strings.TrimSpace(p); p != "" {
+			phones = append(phones, p)
+		}
+	}
+	return phones
+}
+
+func (twilio) Validate(dst string) error {
+	phones := phoneNumbers(dst)
+	if len(phones) == 0 {
+		return fmt.Errorf("no phone numbers")
+	}
+	for _, p := range phones {
+		if !phoneNumberRE.MatchString(p) {
+			return fmt.Errorf("invalid phone number %s, must be in E.164 format like +15551234567", p)
+		}
+	}
+	return nil
+}
+
+// SplitDestination sends to each phone number separately, so a retry does
+// not text or call the numbers that were already reached.
+func (twilio) SplitDestination(dst string) []string {
+	return phoneNumbers(dst)
+}
+
+// SkipActions leaves voice out of action notifications, since a call for
+// each ack or close would wake people up for nothing.
+func (t twilio) SkipActions() bool {
+	return t.voice
+}
+
+func (t twilio) Send(p *PreparedTransport, c SystemConfProvider) error {
+	tc := c.GetTwilioConf()
+	if tc.AccountSID == "" || tc.From == "" {
+		return fmt.Errorf("TwilioConf.AccountSID and TwilioConf.From must be set")
+	}
+	text := p.Short
+	if text == "" {
+		text = p.Subject
+	}
+	resource, form := "Messages", url.Values{"Body": {truncateText(text, tc.MaxSMSLength)}}
+	if t.voice {
+		var say bytes.Buffer
+		xml.EscapeText(&say, []byte(truncateText(text, tc.MaxVoiceLength)))
+		resource, form = "Calls", url.Values{"Twiml": {`<Response><Say loop="2">` + say.String() + `</Say></Response>`}}
+	}
+	form.Set("From", tc.From)
+	var errs []string
+	for _, to := range phoneNumbers(p.Destination) {
+		form.Set("To", to)
+		if err := twilioPost(tc, resource, form); err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", to, err))
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("%s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// truncateText cuts text to max characters, ending it with "..." if it is
+// cut.
+func truncateText(text string, max int) string {
+	r := []rune(text)
+	if len(r) <= max {
+		return text
+	}
+	if max <= 3 {
+		return string(r[:max])
+	}
+	return string(r[:max-3]) + "..."
+}
+
+// twilioPost creates a resource of the account of tc, like a message or a
+// call.
+func twilioPost(tc TwilioConf, resource string, form url.Values) error {
+	u := fmt.Sprintf("%s/2010-04-01/Accounts/%s/%s.json", strings.TrimSuffix(tc.BaseURL, "/"), url.PathEscape(tc.AccountSID), resource)
+	req, err := http.NewRequest(http.MethodPost, u, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(tc.AccountSID, tc.AuthToken)
+	resp, err := NotifyClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		msg, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 512))
+		return &StatusError{Code: resp.StatusCode, Msg: fmt.Sprintf("%s: %s", resp.Status, bytes.TrimSpace(msg))}
+	}
+	// Drain the body to let the Transport reuse the connection
+	io.Copy(ioutil.Discard, resp.Body)
+	return nil
+}
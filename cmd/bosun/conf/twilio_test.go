@@ -0,0 +1,108 @@
+package conf
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"bosun.org/models"
+	"bosun.org/util"
+)
+
+func TestTwilio(t *testing.T) {
+	util.InitHostManager("", false) // for the collect metrics
+
+	type request struct {
+		path string
+		form url.Values
+	}
+	var requests []request
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if u, p, _ := r.BasicAuth(); u != "AC1" || p != "token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		r.ParseForm()
+		if r.PostForm.Get("To") == "+15550000009" {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"message": "invalid number"}`))
+			return
+		}
+		requests = append(requests, request{r.URL.Path, r.PostForm})
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer ts.Close()
+
+	c := &SystemConf{TwilioConf: TwilioConf{AccountSID: "AC1", AuthToken: "token", From: "+15550000000", BaseURL: ts.URL, MaxSMSLength: 20}}
+	n := &Notification{
+		Name:          "oncall",
+		Transports:    map[string]string{"sms": "+15550000001, +15550000002", "voice": "+15550000001"},
+		ShortTemplate: "short",
+	}
+	st := &models.IncidentState{AlertKey: "cpu{host=web01}", CurrentStatus: models.StCritical}
+	rt := &models.RenderedTemplates{Subject: "subject", Custom: map[string]string{"short": "CRIT cpu high on web01 & web02"}}
+	if errs := n.PrepareAlert(rt, st).Send(c); len(errs) != 0 {
+		t.Fatal(errs)
+	}
+	if len(requests) != 3 {
+		t.Fatalf("expected 3 requests, got %+v", requests)
+	}
+	for i, to := range []string{"+15550000001", "+15550000002"} {
+		r := requests[i]
+		if r.path != "/2010-04-01/Accounts/AC1/Messages.json" || r.form.Get("To") != to || r.form.Get("From") != "+15550000000" {
+			t.Errorf("unexpected message %+v", r)
+		}
+		if body := r.form.Get("Body"); body != "CRIT cpu high on ..." {
+			t.Errorf("expected the short text cut to 20 characters, got %q", body)
+		}
+	}
+	if r := requests[2]; r.path != "/2010-04-01/Accounts/AC1/Calls.json" || !strings.Contains(r.form.Get("Twiml"), "on web01 &amp; web02</Say>") {
+		t.Errorf("unexpected call %+v", r)
+	}
+
+	// Actions are texted, but not called.
+	requests = nil
+	pn := &PreparedNotifications{Name: n.Name}
+	n.prepTransports(pn, "acked", "", models.StNone, &NotificationDetails{At: models.ActionAcknowledge.String()})
+	for _, p := range pn.Transports {
+		if p.Transport != "sms" {
+			t.Fatalf("expected only sms for the action, got %+v", p)
+		}
+		p.Action = models.ActionAcknowledge
+	}
+	if errs := pn.Send(c); len(errs) != 0 {
+		t.Fatal(errs)
+	}
+	if len(requests) != 2 || requests[0].form.Get("Body") != "acked" {
+		t.Errorf("expected a message to each number for the action, got %+v", requests)
+	}
+
+	// Each number is sent separately, so a failed number does not stop the
+	// others and only its send fails.
+	requests = nil
+	n.Transports = map[string]string{"sms": "+15550000009,+15550000001"}
+	pn = n.PrepareAlert(rt, st)
+	if len(pn.Transports) != 2 || len(pn.Split()) != 2 {
+		t.Fatalf("expected a notification for each number, got %+v", pn.Transports)
+	}
+	errs := pn.Send(c)
+	if len(errs) != 1 || !strings.Contains(errs[0].Error(), "invalid number") {
+		t.Errorf("expected the error of the API, got %v", errs)
+	}
+	if len(requests) != 1 {
+		t.Errorf("expected the second number to be texted, got %+v", requests)
+	}
+	for _, d := range pn.Deliveries {
+		if (d.Destination == "+15550000009") != (d.Error != "") {
+			t.Errorf("unexpected delivery %+v", d)
+		}
+	}
+
+	for _, dst := range []string{"", "5551234567", "+15551234567,+1"} {
+		if err := (twilio{}).Validate(dst); err == nil {
+			t.Errorf("expected %q to be invalid", dst)
+		}
+	}
+}
@@ -53,7 +53,37 @@ func init() {
 	unknownDefaults.body = template.Must(template.New("body").Parse(body))
 }
 
+var noDataDefaults defaultTemplates
+
+func init() {
+	subject := `{{.Name}}: {{.Group | len}} alerts with no data`
+	body := `
+	<p>Time: {{.Time}}
+	<p>Name: {{.Name}}
+	<p>Alerts that stopped returning data:
+	{{range .Group}}
+		<br>{{.}}
+	{{end}}
+`
+	noDataDefaults.subject = template.Must(template.New("subject").Parse(subject))
+	noDataDefaults.body = template.Must(template.New("body").Parse(body))
+}
+
 func (n *Notification) PrepareUnknown(t *Template, c SystemConfProvider, name string, aks []models.AlertKey, states *models.IncidentState) *PreparedNotifications {
+	return n.prepareUnknown(t, c, name, aks, states, n.UnknownTemplateKeys, unknownDefaults, unknown)
+}
+
+// PrepareNoData is like PrepareUnknown, for a group of alert keys with the
+// nodata status. It uses the noData template keys of the notification.
+func (n *Notification) PrepareNoData(t *Template, c SystemConfProvider, name string, aks []models.AlertKey, states *models.IncidentState) *PreparedNotifications {
+	return n.prepareUnknown(t, c, name, aks, states, n.NoDataTemplateKeys, noDataDefaults, nodata)
+}
+
+func (n *Notification) prepareUnknown(t *Template, c SystemConfProvider, name string, aks []models.AlertKey, states *models.IncidentState, tks NotificationTemplateKeys, defaults defaultTemplates, notifyType int) *PreparedNotifications {
+	kind := "unknown"
+	if notifyType == nodata {
+		kind = "nodata"
+	}
 	ctx := &unknownContext{
 		Time:     time.Now().UTC(),
 		Name:     name,
@@ -61,7 +91,7 @@ func (n *Notification) PrepareUnknown(t *Template, c SystemConfProvider, name st
 		States:   states,
 		makeLink: c.MakeLink,
 	}
-	pn := &PreparedNotifications{}
+	pn := &PreparedNotifications{Name: n.Name, SendLimits: n.SendLimits}
 	buf := &bytes.Buffer{}
 	render := func(key string, defaultTmpl *template.Template) (string, error) {
 		tpl := defaultTmpl
@@ -70,10 +100,14 @@ func (n *Notification) PrepareUnknown(t *Template, c SystemConfProvider, name st
 		} else {
 			key = "default"
 		}
+		if tpl == nil {
+			// the template does not define the key, so there is nothing to render
+			return "", fmt.Errorf("template key %s not defined", key)
+		}
 		buf.Reset()
 		err := tpl.Execute(buf, ctx)
 		if err != nil {
-			e := fmt.Sprintf("executing unknown template '%s': %s", key, err)
+			e := fmt.Sprintf("executing %s template '%s': %s", kind, key, err)
 			pn.Errors = append(pn.Errors, e)
 			slog.Errorf(e)
 			return "", err
@@ -81,8 +115,6 @@ func (n *Notification) PrepareUnknown(t *Template, c SystemConfProvider, name st
 		return buf.String(), nil
 	}
 
-	tks := n.UnknownTemplateKeys
-
 	ak := map[string][]string{
 		"alert_key": {},
 	}
@@ -105,10 +137,10 @@ func (n *Notification) PrepareUnknown(t *Template, c SystemConfProvider, name st
 		NotifyName:  n.Name,
 		TemplateKey: tks.BodyTemplate,
 		Ak:          ak["alert_key"],
-		NotifyType:  2,
+		NotifyType:  notifyType,
 	}
 
-	n.prepareFromTemplateKeys(pn, tks, render, unknownDefaults, details)
+	n.prepareFromTemplateKeys(pn, tks, render, defaults, details)
 	return pn
 }
 
@@ -116,6 +148,10 @@ func (n *Notification) NotifyUnknown(t *Template, c SystemConfProvider, name str
 	go n.PrepareUnknown(t, c, name, aks, states).Send(c)
 }
 
+func (n *Notification) NotifyNoData(t *Template, c SystemConfProvider, name string, aks []models.AlertKey, states *models.IncidentState) {
+	go n.PrepareNoData(t, c, name, aks, states).Send(c)
+}
+
 var unknownMultiDefaults defaultTemplates
 
 type unknownMultiContext struct {
@@ -154,7 +190,7 @@ func (n *Notification) PrepareMultipleUnknowns(t *Template, c SystemConfProvider
 		Groups:    groups,
 		States:    states,
 	}
-	pn := &PreparedNotifications{}
+	pn := &PreparedNotifications{Name: n.Name, SendLimits: n.SendLimits}
 	buf := &bytes.Buffer{}
 	render := func(key string, defaultTmpl *template.Template) (string, error) {
 		tpl := defaultTmpl
@@ -163,6 +199,10 @@ func (n *Notification) PrepareMultipleUnknowns(t *Template, c SystemConfProvider
 		} else {
 			key = "default"
 		}
+		if tpl == nil {
+			// the template does not define the key, so there is nothing to render
+			return "", fmt.Errorf("template key %s not defined", key)
+		}
 		buf.Reset()
 		err := tpl.Execute(buf, ctx)
 		if err != nil {
@@ -186,7 +226,7 @@ func (n *Notification) PrepareMultipleUnknowns(t *Template, c SystemConfProvider
 		NotifyName:  n.Name,
 		TemplateKey: tks.BodyTemplate,
 		Ak:          ak,
-		NotifyType:  3,
+		NotifyType:  multiunknown,
 	}
 
 	n.prepareFromTemplateKeys(pn, tks, render, unknownMultiDefaults, details)
@@ -203,6 +243,8 @@ func (n *Notification) prepareFromTemplateKeys(pn *PreparedNotifications, tks No
 		body, _ := render(tks.BodyTemplate, defaults.body)
 		if subject, err := render(tks.EmailSubjectTemplate, defaults.subject); err == nil {
 			pn.Email = n.PrepEmail(subject, body, "", nil)
+			pn.Email.TemplateKey = tks.BodyTemplate
+			pn.Email.Aks = alertDetails.Ak
 		}
 	}
 
@@ -228,4 +270,22 @@ func (n *Notification) prepareFromTemplateKeys(pn *PreparedNotifications, tks No
 	if getURL != "" {
 		pn.HTTP = append(pn.HTTP, n.PrepHttp("GET", getURL, "", alertDetails))
 	}
+	for _, h := range pn.HTTP {
+		for name, key := range n.HeaderTemplates {
+			if v, err := render(key, nil); err == nil {
+				h.Headers[name] = v
+			}
+		}
+	}
+	if len(n.Transports) > 0 {
+		subject, _ := render(tks.EmailSubjectTemplate, defaults.subject)
+		body, _ := render(tks.BodyTemplate, defaults.body)
+		n.prepTransports(pn, subject, body, models.StNone, alertDetails)
+		if n.ShortTemplate != "" {
+			short, _ := render(n.ShortTemplate, nil)
+			for _, t := range pn.Transports {
+				t.Short = short
+			}
+		}
+	}
 }
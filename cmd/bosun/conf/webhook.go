@@ -0,0 +1,171 @@
+package conf
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+)
+
+// DefaultHMACHeader is the header of the signature of the body of http
+// notifications signed with an HMAC secret.
+const DefaultHMACHeader = "X-Bosun-Signature"
+
+// HTTPAuth authenticates the http requests of a notification. It only refers
+// to the secrets, which are read when the requests are sent, so they are not
+// stored with prepared notifications.
+type HTTPAuth struct {
+	// The bearer token is read from the environment variable
+	// BearerTokenEnv or the file BearerTokenFile.
+	BearerTokenEnv  string `json:",omitempty"`
+	BearerTokenFile string `json:",omitempty"`
+	// The body is signed with HMAC-SHA256 with the secret read from
+	// HMACSecretEnv or HMACSecretFile, and the hex signature is sent in
+	// HMACHeader as "sha256=<signature>".
+	HMACSecretEnv  string `json:",omitempty"`
+	HMACSecretFile string `json:",omitempty"`
+	HMACHeader     string `json:",omitempty"`
+	// ClientCert and ClientKey are the PEM files of the client certificate
+	// of mTLS, and CACert the PEM file of the CAs to verify the server with
+	// instead of the system ones.
+	ClientCert string `json:",omitempty"`
+	ClientKey  string `json:",omitempty"`
+	CACert     string `json:",omitempty"`
+}
+
+// Valid returns an error if the settings of a are inconsistent or the
+// certificates can not be loaded.
+func (a *HTTPAuth) Valid() error {
+	if a.BearerTokenEnv != "" && a.BearerTokenFile != "" {
+		return fmt.Errorf("bearerTokenEnv and bearerTokenFile are mutually exclusive")
+	}
+	if a.HMACSecretEnv != "" && a.HMACSecretFile != "" {
+		return fmt.Errorf("hmacSecretEnv and hmacSecretFile are mutually exclusive")
+	}
+	if a.HMACHeader != "" && a.HMACSecretEnv == "" && a.HMACSecretFile == "" {
+		return fmt.Errorf("hmacHeader specified without hmacSecretEnv or hmacSecretFile")
+	}
+	if (a.ClientCert == "") != (a.ClientKey == "") {
+		return fmt.Errorf("clientCert and clientKey must be specified together")
+	}
+	_, err := a.tlsConfig()
+	return err
+}
+
+// apply sets the bearer token and the signature of body on req.
+func (a *HTTPAuth) apply(req *http.Request, body string) error {
+	if a == nil {
+		return nil
+	}
+	if a.BearerTokenEnv != "" || a.BearerTokenFile != "" {
+		token, err := readSecret(a.BearerTokenEnv, a.BearerTokenFile)
+		if err != nil {
+			return fmt.Errorf("bearer token: %v", err)
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	if a.HMACSecretEnv != "" || a.HMACSecretFile != "" {
+		secret, err := readSecret(a.HMACSecretEnv, a.HMACSecretFile)
+		if err != nil {
+			return fmt.Errorf("hmac secret: %v", err)
+		}
+		header := a.HMACHeader
+		if header == "" {
+			header = DefaultHMACHeader
+		}
+		req.Header.Set(header, "sha256="+signHMAC(secret, body))
+	}
+	return nil
+}
+
+func signHMAC(secret, body string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(body))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// readSecret reads a secret from the environment variable env or the file
+// file. Surrounding whitespace, like the newline at the end of a file, is
+// removed.
+func readSecret(env, file string) (string, error) {
+	var s string
+	if env != "" {
+		v, ok := os.LookupEnv(env)
+		if !ok {
+			return "", fmt.Errorf("environment variable %s is not set", env)
+		}
+		s = v
+	} else {
+		b, err := ioutil.ReadFile(file)
+		if err != nil {
+			return "", err
+		}
+		s = string(b)
+	}
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return "", fmt.Errorf("empty secret")
+	}
+	return s, nil
+}
+
+func (a *HTTPAuth) tlsConfig() (*tls.Config, error) {
+	if a.ClientCert == "" && a.CACert == "" {
+		return nil, nil
+	}
+	tc := &tls.Config{}
+	if a.ClientCert != "" {
+		cert, err := tls.LoadX509KeyPair(a.ClientCert, a.ClientKey)
+		if err != nil {
+			return nil, fmt.Errorf("loading client certificate: %v", err)
+		}
+		tc.Certificates = []tls.Certificate{cert}
+	}
+	if a.CACert != "" {
+		b, err := ioutil.ReadFile(a.CACert)
+		if err != nil {
+			return nil, fmt.Errorf("loading CA certificate: %v", err)
+		}
+		tc.RootCAs = x509.NewCertPool()
+		if !tc.RootCAs.AppendCertsFromPEM(b) {
+			return nil, fmt.Errorf("no certificates in %s", a.CACert)
+		}
+	}
+	return tc, nil
+}
+
+var (
+	httpClientsLock sync.Mutex
+	httpClients     = make(map[string]*http.Client)
+)
+
+// client returns the http client to send requests with: one with the
+// certificates of a, or NotifyClient. Clients are kept to reuse their
+// connections.
+func (a *HTTPAuth) client() (*http.Client, error) {
+	if a == nil || (a.ClientCert == "" && a.CACert == "") {
+		return NotifyClient, nil
+	}
+	key := a.ClientCert + "\x00" + a.ClientKey + "\x00" + a.CACert
+	httpClientsLock.Lock()
+	defer httpClientsLock.Unlock()
+	if c, ok := httpClients[key]; ok {
+		return c, nil
+	}
+	tc, err := a.tlsConfig()
+	if err != nil {
+		return nil, err
+	}
+	t := http.DefaultTransport.(*http.Transport).Clone()
+	t.TLSClientConfig = tc
+	c := &http.Client{Transport: t, Timeout: NotifyTimeout}
+	httpClients[key] = c
+	return c, nil
+}
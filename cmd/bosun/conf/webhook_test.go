@@ -0,0 +1,155 @@
+package conf
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"bosun.org/models"
+	"bosun.org/util"
+)
+
+func TestHTTPAuth(t *testing.T) {
+	util.InitHostManager("", false) // for the collect metrics
+
+	var got http.Header
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header
+	}))
+	defer ts.Close()
+
+	dir, err := ioutil.TempDir("", "bosun-webhook")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	secretFile := filepath.Join(dir, "secret")
+	if err := ioutil.WriteFile(secretFile, []byte("s3cret\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	os.Setenv("BOSUN_TEST_TOKEN", "tok")
+	defer os.Unsetenv("BOSUN_TEST_TOKEN")
+
+	n := &Notification{
+		Name:            "hook",
+		ContentType:     "application/json",
+		Headers:         map[string]string{"X-Api-Key": "api-key"},
+		HeaderTemplates: map[string]string{"X-Severity": "severity"},
+		HTTPAuth:        &HTTPAuth{BearerTokenEnv: "BOSUN_TEST_TOKEN", HMACSecretFile: secretFile},
+	}
+	n.Post, _ = n.Post.Parse(ts.URL)
+	if err := n.HTTPAuth.Valid(); err != nil {
+		t.Fatal(err)
+	}
+	rt := &models.RenderedTemplates{Subject: "subject", Body: "body", Custom: map[string]string{"severity": "critical"}}
+	st := &models.IncidentState{AlertKey: "a{host=x}"}
+	pn := n.PrepareAlert(rt, st)
+	if len(pn.HTTP) != 1 {
+		t.Fatalf("expected 1 request, got %d", len(pn.HTTP))
+	}
+	if _, err := pn.HTTP[0].Send(); err != nil {
+		t.Fatal(err)
+	}
+	for k, v := range map[string]string{
+		"Content-Type":    "application/json",
+		"X-Api-Key":       "api-key",
+		"X-Severity":      "critical",
+		"Authorization":   "Bearer tok",
+		DefaultHMACHeader: "sha256=" + signHMAC("s3cret", pn.HTTP[0].Body),
+	} {
+		if got.Get(k) != v {
+			t.Errorf("header %s: got %q, expected %q", k, got.Get(k), v)
+		}
+	}
+
+	// The headers of the notification are not stored with it, and are set
+	// again before it is resent.
+	b, err := json.Marshal(pn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(b), "api-key") {
+		t.Fatalf("the headers of the notification are stored: %s", b)
+	}
+	stored := new(PreparedNotifications)
+	if err := json.Unmarshal(b, stored); err != nil {
+		t.Fatal(err)
+	}
+	n.SetHeaders(stored.HTTP[0])
+	if _, err := stored.HTTP[0].Send(); err != nil {
+		t.Fatal(err)
+	}
+	if got.Get("X-Api-Key") != "api-key" || got.Get("X-Severity") != "critical" {
+		t.Errorf("unexpected headers of the resent request %v", got)
+	}
+
+	// A missing secret fails the send instead of sending it unauthenticated.
+	os.Unsetenv("BOSUN_TEST_TOKEN")
+	if _, err := pn.HTTP[0].Send(); err == nil {
+		t.Fatal("expected an error without the bearer token")
+	}
+
+	for _, a := range []*HTTPAuth{
+		{BearerTokenEnv: "A", BearerTokenFile: "b"},
+		{HMACHeader: "X-Sig"},
+		{ClientCert: "cert.pem"},
+		{CACert: filepath.Join(dir, "missing.pem")},
+	} {
+		if err := a.Valid(); err == nil {
+			t.Errorf("expected %+v to be invalid", a)
+		}
+	}
+}
+
+func TestHTTPAuthClientCert(t *testing.T) {
+	util.InitHostManager("", false) // for the collect metrics
+
+	ts := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if len(r.TLS.PeerCertificates) == 0 {
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+	}))
+	ts.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+	ts.StartTLS()
+	defer ts.Close()
+
+	// The certificate of the server is its own CA, and is reused as the
+	// client certificate.
+	dir, err := ioutil.TempDir("", "bosun-webhook")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	cert := ts.TLS.Certificates[0]
+	key, err := x509.MarshalPKCS8PrivateKey(cert.PrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certFile, keyFile := filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem")
+	if err := ioutil.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Certificate[0]}), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: key}), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	p := &PreparedHttp{URL: ts.URL, Method: "GET", Details: &NotificationDetails{NotifyType: alert}}
+	if _, err := p.Send(); err == nil {
+		t.Fatal("expected an error without the CA and client certificate")
+	}
+	p.Auth = &HTTPAuth{ClientCert: certFile, ClientKey: keyFile, CACert: certFile}
+	if err := p.Auth.Valid(); err != nil {
+		t.Fatal(err)
+	}
+	if code, err := p.Send(); err != nil {
+		t.Fatalf("got %d: %v", code, err)
+	}
+}
@@ -5,7 +5,9 @@ package database
 
 import (
 	"fmt"
+	"io/ioutil"
 	"log"
+	"os"
 	"runtime"
 	"strings"
 	"sync"
@@ -23,7 +25,7 @@ import (
 	"github.com/captncraig/easyauth/providers/token/redisStore"
 )
 
-var SchemaVersion = int64(2)
+var SchemaVersion = int64(3)
 
 // Core data access interface for everything sched needs
 type DataAccess interface {
@@ -32,9 +34,12 @@ type DataAccess interface {
 	Configs() ConfigDataAccess
 	Search() SearchDataAccess
 	Errors() ErrorDataAccess
+	Runs() RunDataAccess
 	State() StateDataAccess
 	Silence() SilenceDataAccess
 	Notifications() NotificationDataAccess
+	Outbox() OutboxDataAccess
+	Deliveries() DeliveryDataAccess
 	Migrate() error
 }
 
@@ -102,6 +107,33 @@ func StartLedis(dataDir string, bind string) (stop func(), err error) {
 	return app.Close, nil
 }
 
+// NewMemoryDataAccess starts an in-process ledis server that keeps all data
+// in memory and listens on a random local port. It is used to sandbox
+// schedules that must not touch the real data store, such as replays. The
+// returned function stops the server and removes its working directory.
+func NewMemoryDataAccess() (DataAccess, func(), error) {
+	dir, err := ioutil.TempDir("", "bosun_ledis_memory")
+	if err != nil {
+		return nil, nil, err
+	}
+	cfg := config.NewConfigDefault()
+	cfg.DBName = "memory"
+	cfg.Addr = ""
+	cfg.DataDir = dir
+	app, err := server.NewApp(cfg)
+	if err != nil {
+		os.RemoveAll(dir)
+		return nil, nil, err
+	}
+	go app.Run()
+	d := newDataAccess([]string{app.Address()}, false, "", 0, "")
+	return d, func() {
+		d.pool.Close()
+		app.Close()
+		os.RemoveAll(dir)
+	}, nil
+}
+
 //RedisConnector is a simple interface so things can get a raw connection (mostly tests), but still discourage it.
 // makes dataAccess interchangable with redis.Pool
 type RedisConnector interface {
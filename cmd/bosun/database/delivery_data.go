@@ -0,0 +1,68 @@
+package database
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"bosun.org/models"
+	"bosun.org/slog"
+	"github.com/garyburd/redigo/redis"
+)
+
+/*
+
+deliveries:{id} = list of json models.DeliveryAttempt of an incident, most recent first, trimmed to maxDeliveries.
+
+*/
+
+const maxDeliveries = 500
+
+func deliveriesKey(id int64) string {
+	return fmt.Sprintf("deliveries:%d", id)
+}
+
+// DeliveryDataAccess stores the attempts to send the notifications of
+// incidents.
+type DeliveryDataAccess interface {
+	AddDelivery(incidentId int64, d *models.DeliveryAttempt) error
+	// GetDeliveries returns the attempts of an incident, most recent first.
+	GetDeliveries(incidentId int64) ([]*models.DeliveryAttempt, error)
+}
+
+func (d *dataAccess) Deliveries() DeliveryDataAccess {
+	return d
+}
+
+func (d *dataAccess) AddDelivery(incidentId int64, attempt *models.DeliveryAttempt) error {
+	conn := d.Get()
+	defer conn.Close()
+
+	b, err := json.Marshal(attempt)
+	if err != nil {
+		return slog.Wrap(err)
+	}
+	if _, err = conn.Do("LPUSH", deliveriesKey(incidentId), b); err != nil {
+		return slog.Wrap(err)
+	}
+	_, err = conn.Do("LTRIM", deliveriesKey(incidentId), 0, maxDeliveries-1)
+	return slog.Wrap(err)
+}
+
+func (d *dataAccess) GetDeliveries(incidentId int64) ([]*models.DeliveryAttempt, error) {
+	conn := d.Get()
+	defer conn.Close()
+
+	rows, err := redis.Strings(conn.Do("LRANGE", deliveriesKey(incidentId), 0, maxDeliveries-1))
+	if err != nil {
+		return nil, slog.Wrap(err)
+	}
+	attempts := make([]*models.DeliveryAttempt, 0, len(rows))
+	for _, row := range rows {
+		a := &models.DeliveryAttempt{}
+		if err := json.Unmarshal([]byte(row), a); err != nil {
+			return nil, slog.Wrap(err)
+		}
+		attempts = append(attempts, a)
+	}
+	return attempts, nil
+}
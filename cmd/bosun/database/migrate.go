@@ -32,6 +32,11 @@ var tasks = []Migration{
 		Task:    populatePreviousIncidents,
 		Version: 2,
 	},
+	{
+		UID:     "Index Open Incidents By Alert",
+		Task:    indexOpenIncidentsByAlert,
+		Version: 3,
+	},
 }
 
 type oldIncidentState struct {
@@ -134,6 +139,25 @@ func populatePreviousIncidents(d *dataAccess) error {
 	return nil
 }
 
+// indexOpenIncidentsByAlert adds the open incidents to the hash of the open
+// incidents of their alert.
+func indexOpenIncidentsByAlert(d *dataAccess) error {
+	conn := d.Get()
+	defer conn.Close()
+
+	open, err := stringInt64Map(conn.Do("HGETALL", statesOpenIncidentsKey))
+	if err != nil {
+		return slog.Wrap(err)
+	}
+	slog.Infof("indexing %v open incidents by alert", len(open))
+	for ak, id := range open {
+		if _, err := conn.Do("HSET", statesOpenIncidentsByAlertKey(models.AlertKey(ak).Name()), ak, id); err != nil {
+			return slog.Wrap(err)
+		}
+	}
+	return nil
+}
+
 func (d *dataAccess) Migrate() error {
 	slog.Infoln("checking migrations")
 	conn := d.Get()
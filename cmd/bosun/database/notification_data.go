@@ -15,21 +15,31 @@ import (
 
 pendingNotifications: ZSET timestamp ak:notification
 
-notsByAlert:alert SET of notifications possible per alert. used to clear alerts by alert key
+notsByAlert:alert SET of notifications possible per alert. used to clear alerts by alert key. digest notifications are not in it, so they are never cleared
+
+notificationThread:id:transport:dst STRING reference of the message thread a transport started for an incident, expires after threadLifetime without use
 
 */
 
 const (
 	pendingNotificationsKey = "pendingNotifications"
+	threadLifetime          = 60 * 60 * 24 * 30 // 30 days
 )
 
 func notsByAlertKeyKey(ak models.AlertKey) string {
 	return fmt.Sprintf("notsByAlert:%s", ak.Name())
 }
 
+func notificationThreadKey(id int64, transport, dst string) string {
+	return fmt.Sprintf("notificationThread:%d:%s:%s", id, transport, dst)
+}
+
 type NotificationDataAccess interface {
 	InsertNotification(ak models.AlertKey, notification string, dueAt time.Time) error
 
+	//Insert a notification that ClearNotifications does not clear, such as the events of a digest, which are sent even if the incident is acknowledged or closed before the digest is due.
+	InsertDigestNotification(ak models.AlertKey, notification string, dueAt time.Time) error
+
 	//Get notifications that are currently due or past due. Does not delete.
 	GetDueNotifications() (map[models.AlertKey]map[string]time.Time, error)
 
@@ -39,6 +49,10 @@ type NotificationDataAccess interface {
 	ClearNotifications(ak models.AlertKey) error
 
 	GetNextNotificationTime() (time.Time, error)
+
+	//Get the reference of the message thread a transport started for an incident at a destination, such as the ts of a Slack message. Returns "" if there is none.
+	GetThread(incidentId int64, transport, dst string) (string, error)
+	SetThread(incidentId int64, transport, dst, ref string) error
 }
 
 func (d *dataAccess) Notifications() NotificationDataAccess {
@@ -57,6 +71,14 @@ func (d *dataAccess) InsertNotification(ak models.AlertKey, notification string,
 	return slog.Wrap(err)
 }
 
+func (d *dataAccess) InsertDigestNotification(ak models.AlertKey, notification string, dueAt time.Time) error {
+	conn := d.Get()
+	defer conn.Close()
+
+	_, err := conn.Do("ZADD", pendingNotificationsKey, dueAt.UTC().Unix(), fmt.Sprintf("%s:%s", ak, notification))
+	return slog.Wrap(err)
+}
+
 func (d *dataAccess) GetDueNotifications() (map[models.AlertKey]map[string]time.Time, error) {
 	conn := d.Get()
 	defer conn.Close()
@@ -124,3 +146,30 @@ func (d *dataAccess) GetNextNotificationTime() (time.Time, error) {
 	}
 	return t, nil
 }
+
+func (d *dataAccess) GetThread(incidentId int64, transport, dst string) (string, error) {
+	conn := d.Get()
+	defer conn.Close()
+
+	key := notificationThreadKey(incidentId, transport, dst)
+	ref, err := redis.String(conn.Do("GET", key))
+	if err == redis.ErrNil {
+		return "", nil
+	} else if err != nil {
+		return "", slog.Wrap(err)
+	}
+	_, err = conn.Do("EXPIRE", key, threadLifetime)
+	return ref, slog.Wrap(err)
+}
+
+func (d *dataAccess) SetThread(incidentId int64, transport, dst, ref string) error {
+	conn := d.Get()
+	defer conn.Close()
+
+	key := notificationThreadKey(incidentId, transport, dst)
+	if _, err := conn.Do("SET", key, ref); err != nil {
+		return slog.Wrap(err)
+	}
+	_, err := conn.Do("EXPIRE", key, threadLifetime)
+	return slog.Wrap(err)
+}
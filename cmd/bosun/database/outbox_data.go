@@ -0,0 +1,182 @@
+package database
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/garyburd/redigo/redis"
+
+	"bosun.org/slog"
+)
+
+/*
+
+outbox: HASH of id - json of a notification that is waiting to be sent
+
+outboxDue: ZSET of id to the unix time of its next attempt
+
+outboxDead: HASH of id - json of a notification that failed too many times
+
+outboxCount: counter of ids
+
+*/
+
+const (
+	outboxKey      = "outbox"
+	outboxDueKey   = "outboxDue"
+	outboxDeadKey  = "outboxDead"
+	outboxCountKey = "outboxCount"
+)
+
+// OutboxDataAccess stores the notifications that are being sent, so they can
+// be retried. The entries are opaque to the database.
+type OutboxDataAccess interface {
+	// Add an entry to the outbox, with its first attempt due at due. Returns the id of the entry.
+	AddOutbox(entry []byte, due time.Time) (int64, error)
+	// Update an entry and the time of its next attempt.
+	UpdateOutbox(id int64, entry []byte, due time.Time) error
+	// Move the next attempt of an entry that is still in the outbox to due.
+	ExtendOutbox(id int64, due time.Time) error
+	// Remove a sent entry from the outbox.
+	RemoveOutbox(id int64) error
+	// Get the entries with an attempt due on or before a given time.
+	GetDueOutbox(until time.Time) (map[int64][]byte, error)
+	GetOutbox() (map[int64][]byte, error)
+
+	// Move an entry from the outbox to the dead letters.
+	AddDeadLetter(id int64, entry []byte) error
+	GetDeadLetters() (map[int64][]byte, error)
+	RemoveDeadLetter(id int64) error
+}
+
+func (d *dataAccess) Outbox() OutboxDataAccess {
+	return d
+}
+
+func (d *dataAccess) AddOutbox(entry []byte, due time.Time) (int64, error) {
+	conn := d.Get()
+	defer conn.Close()
+
+	id, err := redis.Int64(conn.Do("INCR", outboxCountKey))
+	if err != nil {
+		return 0, slog.Wrap(err)
+	}
+	return id, d.updateOutbox(conn, id, entry, due)
+}
+
+func (d *dataAccess) UpdateOutbox(id int64, entry []byte, due time.Time) error {
+	conn := d.Get()
+	defer conn.Close()
+
+	return d.updateOutbox(conn, id, entry, due)
+}
+
+func (d *dataAccess) updateOutbox(conn redis.Conn, id int64, entry []byte, due time.Time) error {
+	if _, err := conn.Do("HSET", outboxKey, id, entry); err != nil {
+		return slog.Wrap(err)
+	}
+	_, err := conn.Do("ZADD", outboxDueKey, due.UTC().Unix(), id)
+	return slog.Wrap(err)
+}
+
+func (d *dataAccess) ExtendOutbox(id int64, due time.Time) error {
+	conn := d.Get()
+	defer conn.Close()
+
+	ok, err := redis.Bool(conn.Do("HEXISTS", outboxKey, id))
+	if err != nil || !ok {
+		return slog.Wrap(err)
+	}
+	_, err = conn.Do("ZADD", outboxDueKey, due.UTC().Unix(), id)
+	return slog.Wrap(err)
+}
+
+func (d *dataAccess) RemoveOutbox(id int64) error {
+	conn := d.Get()
+	defer conn.Close()
+
+	if _, err := conn.Do("ZREM", outboxDueKey, id); err != nil {
+		return slog.Wrap(err)
+	}
+	_, err := conn.Do("HDEL", outboxKey, id)
+	return slog.Wrap(err)
+}
+
+func (d *dataAccess) GetDueOutbox(until time.Time) (map[int64][]byte, error) {
+	conn := d.Get()
+	defer conn.Close()
+
+	ids, err := redis.Strings(conn.Do("ZRANGEBYSCORE", outboxDueKey, 0, until.UTC().Unix()))
+	if err != nil {
+		return nil, slog.Wrap(err)
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	args := []interface{}{outboxKey}
+	for _, id := range ids {
+		args = append(args, id)
+	}
+	entries, err := redis.ByteSlices(conn.Do("HMGET", args...))
+	if err != nil {
+		return nil, slog.Wrap(err)
+	}
+	due := make(map[int64][]byte, len(ids))
+	for i, id := range ids {
+		n, err := strconv.ParseInt(id, 10, 64)
+		if err != nil || entries[i] == nil {
+			continue
+		}
+		due[n] = entries[i]
+	}
+	return due, nil
+}
+
+func (d *dataAccess) GetOutbox() (map[int64][]byte, error) {
+	return d.getOutboxHash(outboxKey)
+}
+
+func (d *dataAccess) AddDeadLetter(id int64, entry []byte) error {
+	conn := d.Get()
+	defer conn.Close()
+
+	if _, err := conn.Do("HSET", outboxDeadKey, id, entry); err != nil {
+		return slog.Wrap(err)
+	}
+	if _, err := conn.Do("ZREM", outboxDueKey, id); err != nil {
+		return slog.Wrap(err)
+	}
+	_, err := conn.Do("HDEL", outboxKey, id)
+	return slog.Wrap(err)
+}
+
+func (d *dataAccess) GetDeadLetters() (map[int64][]byte, error) {
+	return d.getOutboxHash(outboxDeadKey)
+}
+
+func (d *dataAccess) RemoveDeadLetter(id int64) error {
+	conn := d.Get()
+	defer conn.Close()
+
+	_, err := conn.Do("HDEL", outboxDeadKey, id)
+	return slog.Wrap(err)
+}
+
+func (d *dataAccess) getOutboxHash(key string) (map[int64][]byte, error) {
+	conn := d.Get()
+	defer conn.Close()
+
+	m, err := redis.StringMap(conn.Do("HGETALL", key))
+	if err != nil {
+		return nil, slog.Wrap(err)
+	}
+	entries := make(map[int64][]byte, len(m))
+	for id, entry := range m {
+		n, err := strconv.ParseInt(id, 10, 64)
+		if err != nil {
+			continue
+		}
+		entries[n] = []byte(entry)
+	}
+	return entries, nil
+}
@@ -0,0 +1,70 @@
+package database
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"bosun.org/models"
+	"bosun.org/slog"
+	"github.com/garyburd/redigo/redis"
+)
+
+/*
+
+alertRuns:{name} = list of json models.AlertRun, most recent first, trimmed to maxAlertRuns.
+
+*/
+
+const maxAlertRuns = 200
+
+func alertRunsKey(name string) string {
+	return fmt.Sprintf("alertRuns:%s", name)
+}
+
+type RunDataAccess interface {
+	AddAlertRun(name string, run *models.AlertRun) error
+	// GetAlertRuns returns up to count of the most recent runs of an alert,
+	// most recent first.
+	GetAlertRuns(name string, count int) ([]*models.AlertRun, error)
+}
+
+func (d *dataAccess) Runs() RunDataAccess {
+	return d
+}
+
+func (d *dataAccess) AddAlertRun(name string, run *models.AlertRun) error {
+	conn := d.Get()
+	defer conn.Close()
+
+	b, err := json.Marshal(run)
+	if err != nil {
+		return slog.Wrap(err)
+	}
+	if _, err = conn.Do("LPUSH", alertRunsKey(name), b); err != nil {
+		return slog.Wrap(err)
+	}
+	_, err = conn.Do("LTRIM", alertRunsKey(name), 0, maxAlertRuns-1)
+	return slog.Wrap(err)
+}
+
+func (d *dataAccess) GetAlertRuns(name string, count int) ([]*models.AlertRun, error) {
+	conn := d.Get()
+	defer conn.Close()
+
+	if count <= 0 || count > maxAlertRuns {
+		count = maxAlertRuns
+	}
+	rows, err := redis.Strings(conn.Do("LRANGE", alertRunsKey(name), 0, count-1))
+	if err != nil {
+		return nil, slog.Wrap(err)
+	}
+	runs := make([]*models.AlertRun, 0, len(rows))
+	for _, row := range rows {
+		run := &models.AlertRun{}
+		if err := json.Unmarshal([]byte(row), run); err != nil {
+			return nil, slog.Wrap(err)
+		}
+		runs = append(runs, run)
+	}
+	return runs, nil
+}
@@ -84,6 +84,9 @@ func (d *dataAccess) getSilences(ids []string, conn redis.Conn) ([]*models.Silen
 				return nil, err
 			}
 		}
+		if err := s.CompileRegexps(); err != nil {
+			slog.Errorf("Error compiling the regular expressions of silence %s: %v", ids[idx], err)
+		}
 		silences = append(silences, s)
 	}
 	return silences, nil
@@ -23,6 +23,7 @@ unknown:{alert} - Set of unknown alert keys for alert
 unevel:{alert} - Set of unevaluated alert keys for alert
 
 openIncidents - Hash of open incident Ids. Alert Key -> incident id
+openIncidents:{alert} - Hash of the open incident Ids of alert. Alert Key -> incident id
 incidents:{ak} - List of incidents for alert key
 
 allIncidents - List of all incidents ever. Value is "incidentId:timestamp:ak"
@@ -32,6 +33,9 @@ const (
 	statesOpenIncidentsKey = "openIncidents"
 )
 
+func statesOpenIncidentsByAlertKey(alert string) string {
+	return fmt.Sprintf("openIncidents:%s", alert)
+}
 func statesLastTouchedKey(alert string) string {
 	return fmt.Sprintf("lastTouched:%s", alert)
 }
@@ -58,6 +62,8 @@ type StateDataAccess interface {
 	GetOpenIncident(ak models.AlertKey) (*models.IncidentState, error)
 	GetLatestIncident(ak models.AlertKey) (*models.IncidentState, error)
 	GetAllOpenIncidents() ([]*models.IncidentState, error)
+	GetOpenIncidentsByAlert(alert string) ([]*models.IncidentState, error)
+	GetIncidentsStartingInRange(start, end time.Time) ([]*models.IncidentState, error)
 	GetIncidentState(incidentId int64) (*models.IncidentState, error)
 
 	GetAllIncidentsByAlertKey(ak models.AlertKey) ([]*models.IncidentState, error)
@@ -252,6 +258,57 @@ func (d *dataAccess) GetAllOpenIncidents() ([]*models.IncidentState, error) {
 	return d.incidentMultiGet(conn, ids)
 }
 
+func (d *dataAccess) GetOpenIncidentsByAlert(alert string) ([]*models.IncidentState, error) {
+	conn := d.Get()
+	defer conn.Close()
+
+	ids, err := int64s(conn.Do("HVALS", statesOpenIncidentsByAlertKey(alert)))
+	if err != nil {
+		return nil, slog.Wrap(err)
+	}
+	return d.incidentMultiGet(conn, ids)
+}
+
+// GetIncidentsStartingInRange returns the incidents that started between start
+// and end. It walks allIncidents from the newest incident and stops at the
+// first incident that started before start.
+func (d *dataAccess) GetIncidentsStartingInRange(start, end time.Time) ([]*models.IncidentState, error) {
+	conn := d.Get()
+	defer conn.Close()
+
+	const batch = 1000
+	ids := []int64{}
+	for i := 0; ; i += batch {
+		entries, err := redis.Strings(conn.Do("LRANGE", "allIncidents", i, i+batch-1))
+		if err != nil {
+			return nil, slog.Wrap(err)
+		}
+		for _, e := range entries {
+			parts := strings.SplitN(e, ":", 3)
+			if len(parts) != 3 {
+				return nil, slog.Wrap(fmt.Errorf("bad allIncidents entry: %s", e))
+			}
+			id, err := strconv.ParseInt(parts[0], 10, 64)
+			if err != nil {
+				return nil, slog.Wrap(err)
+			}
+			ts, err := strconv.ParseInt(parts[1], 10, 64)
+			if err != nil {
+				return nil, slog.Wrap(err)
+			}
+			if ts < start.Unix() {
+				return d.incidentMultiGet(conn, ids)
+			}
+			if ts <= end.Unix() {
+				ids = append(ids, id)
+			}
+		}
+		if len(entries) < batch {
+			return d.incidentMultiGet(conn, ids)
+		}
+	}
+}
+
 func (d *dataAccess) GetAllIncidentsByAlertKey(ak models.AlertKey) ([]*models.IncidentState, error) {
 	conn := d.Get()
 	defer conn.Close()
@@ -432,14 +489,20 @@ func (d *dataAccess) save(s *models.IncidentState, isImport bool) (int64, error)
 			if _, err = conn.Do("HSET", statesOpenIncidentsKey, s.AlertKey, s.Id); err != nil {
 				return slog.Wrap(err)
 			}
+			if _, err = conn.Do("HSET", statesOpenIncidentsByAlertKey(s.Alert), s.AlertKey, s.Id); err != nil {
+				return slog.Wrap(err)
+			}
 		} else {
 			if _, err = conn.Do("HDEL", statesOpenIncidentsKey, s.AlertKey); err != nil {
 				return slog.Wrap(err)
 			}
+			if _, err = conn.Do("HDEL", statesOpenIncidentsByAlertKey(s.Alert), s.AlertKey); err != nil {
+				return slog.Wrap(err)
+			}
 		}
 
 		//appropriately add or remove from unknown and uneval sets
-		if _, err = conn.Do(addRem(s.CurrentStatus == models.StUnknown), statesUnknownKey(s.Alert), s.AlertKey); err != nil {
+		if _, err = conn.Do(addRem(s.CurrentStatus == models.StUnknown || s.CurrentStatus == models.StNoData), statesUnknownKey(s.Alert), s.AlertKey); err != nil {
 			return slog.Wrap(err)
 		}
 		if _, err = conn.Do(addRem(s.Unevaluated), statesUnevalKey(s.Alert), s.AlertKey); err != nil {
@@ -487,7 +550,7 @@ func (d *dataAccess) Forget(ak models.AlertKey) error {
 		if _, err := conn.Do("HDEL", statesOpenIncidentsKey, ak); err != nil {
 			return slog.Wrap(err)
 		}
-		if _, err = conn.Do("HDEL", statesOpenIncidentsKey, ak); err != nil {
+		if _, err = conn.Do("HDEL", statesOpenIncidentsByAlertKey(alert), ak); err != nil {
 			return slog.Wrap(err)
 		}
 		for _, id := range ids {
@@ -497,6 +560,9 @@ func (d *dataAccess) Forget(ak models.AlertKey) error {
 			if _, err = conn.Do("DEL", renderedTemplatesKey(id)); err != nil {
 				return slog.Wrap(err)
 			}
+			if _, err = conn.Do("DEL", deliveriesKey(id)); err != nil {
+				return slog.Wrap(err)
+			}
 		}
 		if _, err := conn.Do(d.LCLEAR(), incidentsForAlertKeyKey(ak)); err != nil {
 			return slog.Wrap(err)
@@ -0,0 +1,33 @@
+package dbtest
+
+import (
+	"testing"
+	"time"
+
+	"bosun.org/models"
+)
+
+func TestDeliveries_RoundTrip(t *testing.T) {
+	dd := testData.Deliveries()
+	start := time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC)
+	check(t, dd.AddDelivery(41, &models.DeliveryAttempt{Time: start, Notification: "n", Transport: "http", Destination: "POST http://example.com", StatusCode: 500, Error: "boom"}))
+	check(t, dd.AddDelivery(41, &models.DeliveryAttempt{Time: start.Add(time.Minute), Notification: "n", Transport: "http", Destination: "POST http://example.com", StatusCode: 200, Latency: time.Second}))
+	check(t, dd.AddDelivery(42, &models.DeliveryAttempt{Time: start, Notification: "n", Transport: "email"}))
+
+	attempts, err := dd.GetDeliveries(41)
+	check(t, err)
+	if len(attempts) != 2 {
+		t.Fatalf("expected 2 attempts, got %d", len(attempts))
+	}
+	if a := attempts[0]; a.StatusCode != 200 || a.Latency != time.Second || !a.Time.Equal(start.Add(time.Minute)) {
+		t.Errorf("expected the most recent attempt first, got %+v", a)
+	}
+	if a := attempts[1]; a.StatusCode != 500 || a.Error != "boom" {
+		t.Errorf("unexpected attempt %+v", a)
+	}
+	attempts, err = dd.GetDeliveries(43)
+	check(t, err)
+	if len(attempts) != 0 {
+		t.Errorf("expected no attempts, got %+v", attempts)
+	}
+}
@@ -66,3 +66,41 @@ func TestNotifications_RoundTrip(t *testing.T) {
 		t.Fatalf("wrong next time. %s != %s", next, future)
 	}
 }
+
+func TestNotifications_Digest(t *testing.T) {
+	nd := testData.Notifications()
+	ak := models.AlertKey("notdigest{foo=a}")
+	past := time.Now().UTC().Add(-time.Minute).Truncate(time.Second)
+
+	check(t, nd.InsertNotification(ak, "chat", past))
+	check(t, nd.InsertDigestNotification(ak, "digest#chat#alert", past))
+	check(t, nd.ClearNotifications(ak))
+	due, err := nd.GetDueNotifications()
+	check(t, err)
+	if len(due[ak]) != 1 || !due[ak]["digest#chat#alert"].Equal(past) {
+		t.Fatalf("expected only the digest notification to be left, got %v", due[ak])
+	}
+	check(t, nd.ClearNotificationsBefore(past))
+}
+
+func TestNotifications_Threads(t *testing.T) {
+	nd := testData.Notifications()
+
+	ref, err := nd.GetThread(42, "slack", "C0123")
+	check(t, err)
+	if ref != "" {
+		t.Fatalf("expected no thread, got %s", ref)
+	}
+	check(t, nd.SetThread(42, "slack", "C0123", "1503435956.000247"))
+	check(t, nd.SetThread(42, "slack", "C0456", "1503435957.000100"))
+	ref, err = nd.GetThread(42, "slack", "C0123")
+	check(t, err)
+	if ref != "1503435956.000247" {
+		t.Fatalf("wrong thread. %s != %s", ref, "1503435956.000247")
+	}
+	ref, err = nd.GetThread(43, "slack", "C0123")
+	check(t, err)
+	if ref != "" {
+		t.Fatalf("expected no thread for another incident, got %s", ref)
+	}
+}
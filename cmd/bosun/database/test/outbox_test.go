@@ -0,0 +1,67 @@
+package dbtest
+
+import (
+	"testing"
+	"time"
+)
+
+func TestOutbox_RoundTrip(t *testing.T) {
+	od := testData.Outbox()
+	now := time.Now().UTC()
+
+	a, err := od.AddOutbox([]byte("a"), now.Add(-time.Minute))
+	check(t, err)
+	b, err := od.AddOutbox([]byte("b"), now.Add(time.Hour))
+	check(t, err)
+	if a == b {
+		t.Fatalf("ids are not unique: %d", a)
+	}
+
+	due, err := od.GetDueOutbox(now)
+	check(t, err)
+	if len(due) != 1 || string(due[a]) != "a" {
+		t.Fatalf("wrong due entries: %v", due)
+	}
+
+	// retrying b now makes it due
+	check(t, od.UpdateOutbox(b, []byte("b2"), now.Add(-time.Second)))
+	due, err = od.GetDueOutbox(now)
+	check(t, err)
+	if len(due) != 2 || string(due[b]) != "b2" {
+		t.Fatalf("wrong due entries: %v", due)
+	}
+
+	// extending the claim of b makes it due later
+	check(t, od.ExtendOutbox(b, now.Add(time.Hour)))
+	due, err = od.GetDueOutbox(now)
+	check(t, err)
+	if len(due) != 1 || due[b] != nil {
+		t.Fatalf("wrong due entries after extending b: %v", due)
+	}
+
+	check(t, od.RemoveOutbox(a))
+	// extending a removed entry does not bring it back
+	check(t, od.ExtendOutbox(a, now.Add(-time.Minute)))
+	check(t, od.AddDeadLetter(b, []byte("b3")))
+	all, err := od.GetOutbox()
+	check(t, err)
+	if len(all) != 0 {
+		t.Fatalf("expected an empty outbox, got %v", all)
+	}
+	due, err = od.GetDueOutbox(now)
+	check(t, err)
+	if len(due) != 0 {
+		t.Fatalf("expected nothing due, got %v", due)
+	}
+	dead, err := od.GetDeadLetters()
+	check(t, err)
+	if len(dead) != 1 || string(dead[b]) != "b3" {
+		t.Fatalf("wrong dead letters: %v", dead)
+	}
+	check(t, od.RemoveDeadLetter(b))
+	dead, err = od.GetDeadLetters()
+	check(t, err)
+	if len(dead) != 0 {
+		t.Fatalf("expected no dead letters, got %v", dead)
+	}
+}
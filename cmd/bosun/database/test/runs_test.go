@@ -0,0 +1,30 @@
+package dbtest
+
+import (
+	"testing"
+	"time"
+
+	"bosun.org/models"
+)
+
+func TestRuns_Bounded(t *testing.T) {
+	rd := testData.Runs()
+	alert := randString(8)
+	start := time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 250; i++ {
+		check(t, rd.AddAlertRun(alert, &models.AlertRun{Time: start.Add(time.Duration(i) * time.Minute), Results: i}))
+	}
+	runs, err := rd.GetAlertRuns(alert, 0)
+	check(t, err)
+	if len(runs) != 200 {
+		t.Fatalf("expected 200 runs, got %d", len(runs))
+	}
+	if runs[0].Results != 249 || runs[199].Results != 50 {
+		t.Errorf("expected the most recent runs first, got %d to %d", runs[0].Results, runs[199].Results)
+	}
+	runs, err = rd.GetAlertRuns(alert, 5)
+	check(t, err)
+	if len(runs) != 5 || !runs[0].Time.Equal(start.Add(249*time.Minute)) {
+		t.Errorf("unexpected runs: %+v", runs)
+	}
+}
@@ -0,0 +1,41 @@
+package dbtest
+
+import (
+	"testing"
+
+	"bosun.org/models"
+)
+
+func TestState_OpenIncidentsByAlert(t *testing.T) {
+	sd := testData.State()
+	a, b := randString(8), randString(8)
+	incident := func(ak models.AlertKey) *models.IncidentState {
+		is := &models.IncidentState{AlertKey: ak, Alert: ak.Name(), Open: true}
+		_, err := sd.UpdateIncidentState(is)
+		check(t, err)
+		return is
+	}
+	a1 := incident(models.AlertKey(a + "{host=1}"))
+	incident(models.AlertKey(a + "{host=2}"))
+	incident(models.AlertKey(b + "{host=1}"))
+
+	open, err := sd.GetOpenIncidentsByAlert(a)
+	check(t, err)
+	if len(open) != 2 {
+		t.Fatalf("expected 2 open incidents of %s, got %d", a, len(open))
+	}
+	for _, is := range open {
+		if is.Alert != a {
+			t.Errorf("unexpected incident of %s", is.Alert)
+		}
+	}
+
+	a1.Open = false
+	_, err = sd.UpdateIncidentState(a1)
+	check(t, err)
+	open, err = sd.GetOpenIncidentsByAlert(a)
+	check(t, err)
+	if len(open) != 1 || open[0].AlertKey != models.AlertKey(a+"{host=2}") {
+		t.Errorf("expected the closed incident to be removed, got %v", open)
+	}
+}
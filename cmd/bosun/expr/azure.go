@@ -237,7 +237,7 @@ func azureQuery(prefix string, e *State, metric, tagKeysCSV, rsg, resName, resou
 		return resp, err
 	}
 	// Get Azure metric values by calling the Azure API or via cache if available
-	val, err, hit := e.Cache.Get(cacheKey, getFn)
+	val, err, hit := e.Cache.Get(cacheKey, e.guard("azure", prefix, getFn))
 	if err != nil {
 		return r, err
 	}
@@ -424,7 +424,7 @@ func azureListResources(prefix string, e *State) (AzureResources, error) {
 		}
 		return r, nil
 	}
-	val, err, hit := e.Cache.Get(key, getFn)
+	val, err, hit := e.Cache.Get(key, e.guard("azure", prefix, getFn))
 	collectCacheHit(e.Cache, "azure_resource", hit)
 	if err != nil {
 		return AzureResources{}, err
@@ -80,7 +80,7 @@ func AzureAIQuery(prefix string, e *State, metric, segmentCSV, filter string, ap
 			})
 			return resp, err
 		}
-		val, err, hit := e.Cache.Get(cacheKey, getFn)
+		val, err, hit := e.Cache.Get(cacheKey, e.guard("azure", prefix, getFn))
 		if err != nil {
 			return r, err
 		}
@@ -301,7 +301,7 @@ func AzureAIListApps(prefix string, e *State) (r *Results, err error) {
 		r.Results = append(r.Results, &Result{Value: applist})
 		return r, nil
 	}
-	val, err, hit := e.Cache.Get(key, getFn)
+	val, err, hit := e.Cache.Get(key, e.guard("azure", prefix, getFn))
 	collectCacheHit(e.Cache, "azure_aiapplist", hit)
 	if err != nil {
 		return r, err
@@ -252,10 +252,10 @@ func getCloudwatchData(e *State, req *cloudwatch.Request) (resp cloudwatch.Respo
 
 	var val interface{}
 	var hit bool
-	val, err, hit = e.Cache.Get(key, getFn)
+	val, err, hit = e.Cache.Get(key, e.guard("cloudwatch", req.Region, getFn))
 
 	collectCacheHit(e.Cache, "cloudwatch", hit)
-	resp = val.(cloudwatch.Response)
+	resp, _ = val.(cloudwatch.Response)
 
 	return
 }
@@ -130,9 +130,9 @@ func timeESRequest2(e *State, req *ElasticRequest2) (resp *elastic.SearchResult,
 		}
 		var val interface{}
 		var hit bool
-		val, err, hit = e.Cache.Get(key, getFn)
+		val, err, hit = e.Cache.Get(key, e.guard("elastic", req.HostKey, getFn))
 		collectCacheHit(e.Cache, "elastic", hit)
-		resp = val.(*elastic.SearchResult)
+		resp, _ = val.(*elastic.SearchResult)
 	})
 	return
 }
@@ -131,9 +131,9 @@ func timeESRequest5(e *State, req *ElasticRequest5) (resp *elastic.SearchResult,
 		}
 		var val interface{}
 		var hit bool
-		val, err, hit = e.Cache.Get(key, getFn)
+		val, err, hit = e.Cache.Get(key, e.guard("elastic", req.HostKey, getFn))
 		collectCacheHit(e.Cache, "elastic", hit)
-		resp = val.(*elastic.SearchResult)
+		resp, _ = val.(*elastic.SearchResult)
 	})
 	return
 }
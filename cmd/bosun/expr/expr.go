@@ -56,6 +56,8 @@ type State struct {
 }
 
 type Backends struct {
+	queries int64 // first for 64-bit alignment of the atomic counter
+
 	TSDBContext       opentsdb.Context
 	GraphiteContext   graphite.Context
 	ElasticHosts      ElasticHosts
@@ -64,6 +66,10 @@ type Backends struct {
 	AzureMonitor      AzureMonitorClients
 	CloudWatchContext cloudwatch.Context
 	PromConfig        PromClients
+	Guard             *BackendGuard
+	// Done is closed when the queries are abandoned, such as when the check
+	// of an alert times out. Nil means they are never abandoned.
+	Done <-chan struct{}
 }
 
 type BosunProviders struct {
@@ -78,6 +84,9 @@ type BosunProviders struct {
 // This facilitates alerts referencing other alerts, even when they go unknown or unevaluated.
 type AlertStatusProvider interface {
 	GetUnknownAndUnevaluatedAlertKeys(alertName string) (unknown, unevaluated []models.AlertKey)
+	// GetAbnormalAlertKeys returns the alert keys of the alert that have an
+	// open incident with a non-normal status or are unevaluated.
+	GetAbnormalAlertKeys(alertName string) []models.AlertKey
 }
 
 var ErrUnknownOp = fmt.Errorf("expr: unknown op type")
@@ -219,9 +219,9 @@ func timeGraphiteRequest(e *State, req *graphite.Request) (resp graphite.Respons
 		}
 		var val interface{}
 		var hit bool
-		val, err, hit = e.Cache.Get(key, getFn)
+		val, err, hit = e.Cache.Get(key, e.guard("graphite", graphiteHost(e.GraphiteContext), getFn))
 		collectCacheHit(e.Cache, "graphite", hit)
-		resp = val.(graphite.Response)
+		resp, _ = val.(graphite.Response)
 	})
 	return
 }
@@ -0,0 +1,333 @@
+package expr
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"bosun.org/collect"
+	"bosun.org/graphite"
+	"bosun.org/metadata"
+	"bosun.org/opentsdb"
+
+	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
+)
+
+// BackendUnavailableError is returned for queries to a backend host whose
+// circuit breaker is open.
+type BackendUnavailableError struct {
+	Backend string
+	Host    string
+	Until   time.Time
+	Err     error
+}
+
+func (e *BackendUnavailableError) Error() string {
+	return fmt.Sprintf("backend unavailable: %s %s has failed repeatedly (last error: %v), not querying it until %s",
+		e.Backend, e.Host, e.Err, e.Until.UTC().Format(time.RFC3339))
+}
+
+// IsBackendUnavailable returns true if err is a BackendUnavailableError.
+func IsBackendUnavailable(err error) bool {
+	_, ok := err.(*BackendUnavailableError)
+	return ok
+}
+
+// BackendGuard protects query backends from alert checks. It limits the
+// number of concurrent queries per backend type, and has a circuit breaker
+// per backend host: after Failures consecutive connection failures or server
+// errors the host is not queried for Cooldown, after which a single trial
+// query decides if it is closed again. A nil *BackendGuard does nothing.
+type BackendGuard struct {
+	// MaxConcurrent is the maximum number of concurrent queries by backend
+	// type ("opentsdb", "graphite", "influx", "elastic", "prom", "azure",
+	// "cloudwatch"). Missing or zero entries are unlimited.
+	MaxConcurrent map[string]int
+	// MaxWait is how long a query waits for one of the MaxConcurrent slots
+	// of its backend before it fails, so queries of abandoned checks don't
+	// queue up behind a slow backend forever. Zero waits without limit.
+	MaxWait  time.Duration
+	Failures int
+	Cooldown time.Duration
+
+	sync.Mutex
+	sems     map[string]chan struct{}
+	breakers map[string]*breaker
+}
+
+type breaker struct {
+	backend   string
+	host      string
+	failures  int
+	openUntil time.Time
+	trial     bool
+	lastErr   error
+}
+
+// BreakerState describes the circuit breaker of a backend host.
+type BreakerState struct {
+	Backend   string
+	Host      string
+	Failures  int
+	Open      bool
+	OpenUntil time.Time `json:",omitempty"`
+	LastError string    `json:",omitempty"`
+}
+
+func init() {
+	metadata.AddMetricMeta("bosun.backend.breaker_open", metadata.Counter, metadata.Count,
+		"The number of times a backend host circuit breaker opened after repeated failures.")
+	metadata.AddMetricMeta("bosun.backend.rejected", metadata.Counter, metadata.Count,
+		"The number of queries not sent to a backend host because its circuit breaker was open.")
+	metadata.AddMetricMeta("bosun.backend.busy", metadata.Counter, metadata.Count,
+		"The number of queries not sent to a backend because no query slot was free within the maximum wait.")
+}
+
+// errAbandoned is the error of queries that are not sent because their check
+// was abandoned.
+var errAbandoned = errors.New("query not sent: the check was abandoned")
+
+// Do runs the query fn against host of backend.
+func (g *BackendGuard) Do(backend, host string, fn func() (interface{}, error)) (interface{}, error) {
+	return g.DoUntil(backend, host, nil, fn)
+}
+
+// DoUntil runs the query fn against host of backend for a check that is
+// abandoned when done is closed, such as when it times out. Queries are not
+// sent once done is closed, and a query that is still running gives its slot
+// back when done is closed so it doesn't hold up the queries of other checks.
+func (g *BackendGuard) DoUntil(backend, host string, done <-chan struct{}, fn func() (interface{}, error)) (interface{}, error) {
+	select {
+	case <-done:
+		return nil, errAbandoned
+	default:
+	}
+	if g == nil {
+		return fn()
+	}
+	if err := g.allow(backend, host); err != nil {
+		collect.Add("backend.rejected", opentsdb.TagSet{"backend": backend}, 1)
+		return nil, err
+	}
+	if sem := g.sem(backend); sem != nil {
+		if err := g.acquire(backend, sem, done); err != nil {
+			if err != errAbandoned {
+				collect.Add("backend.busy", opentsdb.TagSet{"backend": backend}, 1)
+			}
+			return nil, err
+		}
+		finished := make(chan struct{})
+		defer close(finished)
+		go func() {
+			select {
+			case <-finished:
+			case <-done:
+			}
+			<-sem
+		}()
+	}
+	v, err := fn()
+	g.record(backend, host, err)
+	return v, err
+}
+
+// guard wraps the cacheable query function fn so it goes through the
+// backend guard of e, and counts it in the queries of the backends.
+func (e *State) guard(backend, host string, fn func() (interface{}, error)) func() (interface{}, error) {
+	return func() (interface{}, error) {
+		atomic.AddInt64(&e.Backends.queries, 1)
+		return e.Guard.DoUntil(backend, host, e.Backends.Done, fn)
+	}
+}
+
+// Queries returns the number of queries sent to the backends since b was
+// created. Queries answered from the cache are not sent.
+func (b *Backends) Queries() int64 {
+	return atomic.LoadInt64(&b.queries)
+}
+
+func (g *BackendGuard) sem(backend string) chan struct{} {
+	n := g.MaxConcurrent[backend]
+	if n <= 0 {
+		return nil
+	}
+	g.Lock()
+	defer g.Unlock()
+	if g.sems == nil {
+		g.sems = make(map[string]chan struct{})
+	}
+	sem := g.sems[backend]
+	if sem == nil {
+		sem = make(chan struct{}, n)
+		g.sems[backend] = sem
+	}
+	return sem
+}
+
+// acquire takes a slot of sem, waiting at most MaxWait for one to be free,
+// or until done is closed.
+func (g *BackendGuard) acquire(backend string, sem chan struct{}, done <-chan struct{}) error {
+	var wait <-chan time.Time
+	if g.MaxWait > 0 {
+		t := time.NewTimer(g.MaxWait)
+		defer t.Stop()
+		wait = t.C
+	}
+	select {
+	case sem <- struct{}{}:
+		return nil
+	case <-wait:
+		return fmt.Errorf("backend busy: no %s query finished within %v of the %d allowed at once", backend, g.MaxWait, cap(sem))
+	case <-done:
+		return errAbandoned
+	}
+}
+
+func (g *BackendGuard) allow(backend, host string) error {
+	if g.Failures <= 0 {
+		return nil
+	}
+	g.Lock()
+	defer g.Unlock()
+	b := g.breakers[backend+" "+host]
+	if b == nil || b.failures < g.Failures {
+		return nil
+	}
+	if time.Now().Before(b.openUntil) || b.trial {
+		return &BackendUnavailableError{Backend: backend, Host: host, Until: b.openUntil, Err: b.lastErr}
+	}
+	// half open: let one query through to see if the host is back
+	b.trial = true
+	return nil
+}
+
+func (g *BackendGuard) record(backend, host string, err error) {
+	if g.Failures <= 0 {
+		return
+	}
+	g.Lock()
+	defer g.Unlock()
+	key := backend + " " + host
+	b := g.breakers[key]
+	if !isConnectionFailure(err) {
+		if b != nil {
+			delete(g.breakers, key)
+		}
+		return
+	}
+	if g.breakers == nil {
+		g.breakers = make(map[string]*breaker)
+	}
+	if b == nil {
+		b = &breaker{backend: backend, host: host}
+		g.breakers[key] = b
+	}
+	b.failures++
+	b.lastErr = err
+	b.trial = false
+	if b.failures >= g.Failures {
+		b.openUntil = time.Now().Add(g.Cooldown)
+		collect.Add("backend.breaker_open", opentsdb.TagSet{"backend": backend}, 1)
+	}
+}
+
+// Breakers returns the state of the backend hosts that have recently failed.
+func (g *BackendGuard) Breakers() []BreakerState {
+	if g == nil {
+		return nil
+	}
+	g.Lock()
+	defer g.Unlock()
+	states := []BreakerState{}
+	for _, b := range g.breakers {
+		s := BreakerState{
+			Backend:  b.backend,
+			Host:     b.host,
+			Failures: b.failures,
+			Open:     b.failures >= g.Failures,
+		}
+		if s.Open {
+			s.OpenUntil = b.openUntil
+		}
+		if b.lastErr != nil {
+			s.LastError = b.lastErr.Error()
+		}
+		states = append(states, s)
+	}
+	sort.Slice(states, func(i, j int) bool {
+		if states[i].Backend != states[j].Backend {
+			return states[i].Backend < states[j].Backend
+		}
+		return states[i].Host < states[j].Host
+	})
+	return states
+}
+
+// isConnectionFailure reports whether err means the backend could not be
+// reached or failed with a server error, as opposed to rejecting the query,
+// so bad queries in one alert don't open the circuit for everyone.
+func isConnectionFailure(err error) bool {
+	if err == nil {
+		return false
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+	var tsdbErr *opentsdb.RequestError
+	if errors.As(err, &tsdbErr) {
+		return tsdbErr.Err.Code >= 500
+	}
+	var promErr *promv1.Error
+	if errors.As(err, &promErr) {
+		return promErr.Type == promv1.ErrServer
+	}
+	return hasServerErrorStatus(err.Error())
+}
+
+// serverErrorStatuses are the statuses of backends that are down or
+// overloaded rather than failing the query.
+var serverErrorStatuses = []int{
+	http.StatusInternalServerError,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// hasServerErrorStatus reports whether msg contains a server error status
+// the way the backend clients format them, like "503 Service Unavailable" or
+// "Error 503 (Service Unavailable)".
+func hasServerErrorStatus(msg string) bool {
+	for _, code := range serverErrorStatuses {
+		text := http.StatusText(code)
+		if strings.Contains(msg, fmt.Sprintf("%d %s", code, text)) || strings.Contains(msg, fmt.Sprintf("%d (%s)", code, text)) {
+			return true
+		}
+	}
+	return false
+}
+
+func tsdbHost(c opentsdb.Context) string {
+	if c, ok := c.(*opentsdb.LimitContext); ok {
+		return c.Host
+	}
+	return fmt.Sprint(c)
+}
+
+func graphiteHost(c graphite.Context) string {
+	switch c := c.(type) {
+	case graphite.Host:
+		return string(c)
+	case graphite.HostHeader:
+		return c.Host
+	case *graphite.HostHeader:
+		return c.Host
+	}
+	return fmt.Sprint(c)
+}
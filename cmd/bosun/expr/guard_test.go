@@ -0,0 +1,129 @@
+package expr
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"bosun.org/opentsdb"
+	"bosun.org/util"
+
+	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
+)
+
+func TestBackendGuardBreaker(t *testing.T) {
+	util.InitHostManager("", false) // for the collect metrics
+	g := &BackendGuard{Failures: 2, Cooldown: time.Hour}
+	calls := 0
+	refused := func() (interface{}, error) {
+		calls++
+		return nil, &net.OpError{Op: "dial", Net: "tcp", Err: fmt.Errorf("connection refused")}
+	}
+	for i := 0; i < 2; i++ {
+		if _, err := g.Do("opentsdb", "a:4242", refused); err == nil || IsBackendUnavailable(err) {
+			t.Fatalf("query %d: expected connection error, got %v", i, err)
+		}
+	}
+	if _, err := g.Do("opentsdb", "a:4242", refused); !IsBackendUnavailable(err) {
+		t.Fatalf("expected backend unavailable error, got %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("expected the open breaker to skip the query, got %d calls", calls)
+	}
+	// Other hosts and query errors are not affected.
+	if _, err := g.Do("opentsdb", "b:4242", func() (interface{}, error) { return 1, nil }); err != nil {
+		t.Errorf("unexpected error for other host: %v", err)
+	}
+	states := g.Breakers()
+	if len(states) != 1 || !states[0].Open || states[0].Host != "a:4242" {
+		t.Fatalf("unexpected breakers: %+v", states)
+	}
+
+	// After the cooldown a single trial query closes the breaker again.
+	g.breakers["opentsdb a:4242"].openUntil = time.Now().Add(-time.Second)
+	if _, err := g.Do("opentsdb", "a:4242", func() (interface{}, error) { return 1, nil }); err != nil {
+		t.Fatalf("unexpected error for trial query: %v", err)
+	}
+	if states := g.Breakers(); len(states) != 0 {
+		t.Errorf("expected breaker to be closed, got %+v", states)
+	}
+	if _, err := g.Do("opentsdb", "a:4242", func() (interface{}, error) { return nil, fmt.Errorf("bad query") }); err == nil {
+		t.Error("expected query error")
+	}
+	if states := g.Breakers(); len(states) != 0 {
+		t.Errorf("query errors should not count as failures, got %+v", states)
+	}
+}
+
+func TestBackendGuardServerErrors(t *testing.T) {
+	tsdbErr := &opentsdb.RequestError{}
+	tsdbErr.Err.Code = 500
+	for _, err := range []error{
+		fmt.Errorf("query: %w", &net.OpError{Op: "dial", Net: "tcp", Err: fmt.Errorf("connection refused")}),
+		fmt.Errorf("opentsdb: 503 Service Unavailable"),
+		fmt.Errorf("elastic: Error 502 (Bad Gateway)"),
+		tsdbErr,
+		&promv1.Error{Type: promv1.ErrServer},
+	} {
+		if !isConnectionFailure(err) {
+			t.Errorf("expected %v to be a failure of the backend", err)
+		}
+	}
+	for _, err := range []error{
+		fmt.Errorf("opentsdb: 400 Bad Request"),
+		&promv1.Error{Type: promv1.ErrBadData},
+	} {
+		if isConnectionFailure(err) {
+			t.Errorf("expected %v to be a query error", err)
+		}
+	}
+}
+
+func TestBackendGuardMaxWait(t *testing.T) {
+	util.InitHostManager("", false) // for the collect metrics
+	g := &BackendGuard{MaxConcurrent: map[string]int{"opentsdb": 1}, MaxWait: 50 * time.Millisecond}
+	running := make(chan struct{})
+	release := make(chan struct{})
+	go g.Do("opentsdb", "a:4242", func() (interface{}, error) {
+		close(running)
+		<-release
+		return nil, nil
+	})
+	<-running
+	if _, err := g.Do("opentsdb", "a:4242", func() (interface{}, error) { return 1, nil }); err == nil || !strings.Contains(err.Error(), "backend busy") {
+		t.Fatalf("expected the query to give up waiting for a slot, got %v", err)
+	}
+	close(release)
+	if _, err := g.Do("opentsdb", "a:4242", func() (interface{}, error) { return 1, nil }); err != nil {
+		t.Errorf("expected the freed slot to be used, got %v", err)
+	}
+}
+
+func TestBackendGuardAbandoned(t *testing.T) {
+	util.InitHostManager("", false) // for the collect metrics
+	g := &BackendGuard{MaxConcurrent: map[string]int{"opentsdb": 1}, MaxWait: time.Second}
+	done := make(chan struct{})
+	running := make(chan struct{})
+	release := make(chan struct{})
+	defer close(release)
+	go g.DoUntil("opentsdb", "a:4242", done, func() (interface{}, error) {
+		close(running)
+		<-release
+		return nil, nil
+	})
+	<-running
+	close(done)
+	// The slot of the abandoned query is given back while it still runs.
+	if _, err := g.Do("opentsdb", "a:4242", func() (interface{}, error) { return 1, nil }); err != nil {
+		t.Fatalf("expected the slot of the abandoned query to be used, got %v", err)
+	}
+	sent := false
+	if _, err := g.DoUntil("opentsdb", "a:4242", done, func() (interface{}, error) {
+		sent = true
+		return 1, nil
+	}); err != errAbandoned || sent {
+		t.Errorf("expected the query of the abandoned check not to be sent, got %v", err)
+	}
+}
@@ -223,7 +223,7 @@ func timeInfluxRequest(e *State, db, query, startDuration, endDuration, groupByI
 		var val interface{}
 		var ok bool
 		var hit bool
-		val, err, hit = e.Cache.Get(q_key, getFn)
+		val, err, hit = e.Cache.Get(q_key, e.guard("influx", e.InfluxConfig.Addr, getFn))
 		collectCacheHit(e.Cache, "influx", hit)
 		if s, ok = val.([]influxModels.Row); !ok {
 			err = fmt.Errorf("influx: did not get a valid result from InfluxDB")
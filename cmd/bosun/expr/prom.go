@@ -440,7 +440,7 @@ func timePromRequest(e *State, prefix, query string, start, end time.Time, step
 			}
 			return m, nil
 		}
-		val, err, hit := e.Cache.Get(string(cacheKeyBytes), getFn)
+		val, err, hit := e.Cache.Get(string(cacheKeyBytes), e.guard("prom", prefix, getFn))
 		collectCacheHit(e.Cache, "prom_ts", hit)
 		var ok bool
 		if s, ok = val.(promModels.Matrix); !ok {
@@ -502,7 +502,7 @@ func PromMetricList(prefix string, e *State) (r *Results, err error) {
 		}
 		return metrics, nil
 	}
-	val, err, hit := e.Cache.Get(fmt.Sprintf("%v:metriclist", prefix), getFn)
+	val, err, hit := e.Cache.Get(fmt.Sprintf("%v:metriclist", prefix), e.guard("prom", prefix, getFn))
 	collectCacheHit(e.Cache, "prom_metrics", hit)
 	if err != nil {
 		return nil, err
@@ -541,7 +541,7 @@ func PromTagInfo(prefix string, e *State, metric, sdur, edur string) (r *Results
 		}
 		return m, nil
 	}
-	val, err, hit := e.Cache.Get(fmt.Sprintf("%v:%v:taginfo", prefix, metric), getFn)
+	val, err, hit := e.Cache.Get(fmt.Sprintf("%v:%v:taginfo", prefix, metric), e.guard("prom", prefix, getFn))
 	collectCacheHit(e.Cache, "prom_metrics", hit)
 	if err != nil {
 		return nil, err
@@ -96,9 +96,9 @@ func timeTSDBRequest(e *State, req *opentsdb.Request) (s opentsdb.ResponseSet, e
 			}
 			var val interface{}
 			var hit bool
-			val, err, hit = e.Cache.Get(string(b), getFn)
+			val, err, hit = e.Cache.Get(string(b), e.guard("opentsdb", tsdbHost(e.TSDBContext), getFn))
 			collectCacheHit(e.Cache, "opentsdb", hit)
-			rs := val.(opentsdb.ResponseSet)
+			rs, _ := val.(opentsdb.ResponseSet)
 			s = rs.Copy()
 			for _, r := range rs {
 				if r.SQL != "" {
@@ -106,7 +106,7 @@ func timeTSDBRequest(e *State, req *opentsdb.Request) (s opentsdb.ResponseSet, e
 				}
 			}
 		})
-		if err == nil || tries == tsdbMaxTries {
+		if err == nil || tries == tsdbMaxTries || IsBackendUnavailable(err) {
 			break
 		}
 		slog.Errorf("Error on tsdb query %d: %s", tries, err.Error())
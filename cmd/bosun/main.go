@@ -26,6 +26,7 @@ import (
 	"bosun.org/cmd/bosun/expr"
 	"bosun.org/cmd/bosun/ping"
 	"bosun.org/cmd/bosun/sched"
+	"bosun.org/cmd/bosun/sched/slack"
 	"bosun.org/cmd/bosun/web"
 	"bosun.org/collect"
 	"bosun.org/graphite"
@@ -155,6 +156,7 @@ func main() {
 	if err != nil {
 		slog.Fatal(err)
 	}
+	slack.SetThreads(da.Notifications())
 	if sysProvider.GetMaxRenderedTemplateAge() != 0 {
 		go da.State().CleanupOldRenderedTemplates(time.Hour * 24 * time.Duration(sysProvider.GetMaxRenderedTemplateAge()))
 	}
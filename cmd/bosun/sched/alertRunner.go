@@ -16,6 +16,7 @@ func (s *Schedule) Run() error {
 	}
 	s.nc = make(chan interface{}, 1)
 	go s.dispatchNotifications()
+	go s.dispatchOutbox()
 	type alertCh struct {
 		ch     chan<- *checkContext
 		modulo int
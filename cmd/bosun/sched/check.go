@@ -1,6 +1,7 @@
 package sched
 
 import (
+	"bytes"
 	"fmt"
 	"math"
 	"sort"
@@ -53,6 +54,13 @@ type RunHistory struct {
 	Backends *expr.Backends
 	Events   map[models.AlertKey]*models.Event
 	schedule *Schedule
+
+	// deadline is closed when the alert being checked has run longer than
+	// timeout.
+	deadline <-chan struct{}
+	timeout  time.Duration
+	// results counts the results of the expressions of the alert.
+	results int
 }
 
 // AtTime creates a new RunHistory starting at t with the same context and
@@ -77,6 +85,7 @@ func (s *Schedule) NewRunHistory(start time.Time, cache *cache.Cache) *RunHistor
 			AzureMonitor:      s.SystemConf.GetAzureMonitorContext(),
 			PromConfig:        s.SystemConf.GetPromContext(),
 			CloudWatchContext: s.SystemConf.GetCloudWatchContext(),
+			Guard:             s.SystemConf.GetBackendGuard(),
 		},
 	}
 	return r
@@ -108,9 +117,12 @@ func (s *Schedule) runHistory(r *RunHistory, ak models.AlertKey, event *models.E
 	if a.UnknownsNormal && event.Status == models.StUnknown {
 		event.Status = models.StNormal
 	}
+	if a.NoDataState != models.StNone && event.Status == models.StUnknown {
+		applyNoDataState(a, event)
+	}
 
 	data := s.DataAccess.State()
-	err = data.TouchAlertKey(ak, utcNow())
+	err = data.TouchAlertKey(ak, r.Start)
 	if err != nil {
 		return
 	}
@@ -145,7 +157,7 @@ func (s *Schedule) runHistory(r *RunHistory, ak models.AlertKey, event *models.E
 		}
 		for i, action := range incident.Actions {
 			if action.Type == models.ActionDelayedClose && !(action.Fullfilled || action.Cancelled) {
-				if event.Status > incident.WorstStatus {
+				if models.CompareLevels(event.Status, event.Severity, incident.WorstStatus, incident.WorstSeverity) > 0 {
 					// If the lifetime severity of the incident has increased, cancel the delayed close
 					err = s.ActionByAlertKey("bosun", "cancelled delayed close due to severity increase", models.ActionCancelClose, nil, ak)
 					if err != nil {
@@ -169,6 +181,7 @@ func (s *Schedule) runHistory(r *RunHistory, ak models.AlertKey, event *models.E
 							incident.Events = append(incident.Events, *event)
 						}
 						incident.CurrentStatus = event.Status
+						incident.CurrentSeverity = nil
 						// Action needs to know it is normal, so update the incident that action will read
 						_, err = data.UpdateIncidentState(incident)
 						if err != nil {
@@ -217,8 +230,26 @@ func (s *Schedule) runHistory(r *RunHistory, ak models.AlertKey, event *models.E
 
 	shouldNotify := false
 	newIncident := false
+	// Once an acknowledgement expires the incident needs an ack again and the
+	// notification chain starts over.
+	ackExpired := false
+	if incident != nil && !incident.NeedAck {
+		if i := pendingAckExpiry(incident); i != -1 && !r.Start.Before(*incident.Actions[i].Deadline) {
+			msg := fmt.Sprintf("acknowledgement by %v expired", incident.Actions[i].User)
+			slog.Infof("%s: %s", ak, msg)
+			if _, err = s.action("bosun", msg, models.ActionAckExpired, nil, incident); err != nil {
+				return
+			}
+			if err := s.ActionNotify(models.ActionAckExpired, "bosun", msg, []models.AlertKey{ak}); err != nil {
+				slog.Errorln(err)
+			}
+			ackExpired = true
+			shouldNotify = true
+		}
+	}
 	if incident == nil {
 		incident = NewIncident(ak)
+		incident.Start = r.Start
 		newIncident = true
 		shouldNotify = true
 	}
@@ -231,16 +262,21 @@ func (s *Schedule) runHistory(r *RunHistory, ak models.AlertKey, event *models.E
 
 	if event.Status > models.StNormal {
 		incident.LastAbnormalStatus = event.Status
+		incident.LastAbnormalSeverity = event.Severity
 		incident.LastAbnormalTime = models.Epoch{Time: event.Time.UTC()}
 	}
-	if event.Status > incident.WorstStatus {
+	// A higher severity level of an alert with custom levels is an increase
+	// even when the status stays the same.
+	if models.CompareLevels(event.Status, event.Severity, incident.WorstStatus, incident.WorstSeverity) > 0 {
 		incident.WorstStatus = event.Status
+		incident.WorstSeverity = event.Severity
 		shouldNotify = true
 	}
-	if event.Status != incident.CurrentStatus {
+	if models.CompareLevels(event.Status, event.Severity, incident.CurrentStatus, incident.CurrentSeverity) != 0 {
 		incident.Events = append(incident.Events, *event)
 	}
 	incident.CurrentStatus = event.Status
+	incident.CurrentSeverity = event.Severity
 
 	//run a preliminary save on new incidents to get an id
 	if newIncident {
@@ -289,10 +325,10 @@ func (s *Schedule) runHistory(r *RunHistory, ak models.AlertKey, event *models.E
 
 	// On state increase, clear old notifications and notify current.
 	// Do nothing if state did not change.
-	notify := func(ns *conf.Notifications) {
+	notify := func(ns *conf.Notifications, e *conf.Escalation) {
 		if a.Log {
 			lastLogTime := s.lastLogTimes[ak]
-			now := utcNow()
+			now := r.Start
 			if now.Before(lastLogTime.Add(a.MaxLogFrequency)) {
 				return
 			}
@@ -303,6 +339,10 @@ func (s *Schedule) runHistory(r *RunHistory, ak models.AlertKey, event *models.E
 			s.Notify(incident, rt, n)
 			checkNotify = true
 		}
+		if e != nil {
+			s.escalate(incident, rt, e, 0, r.Start)
+			checkNotify = true
+		}
 	}
 
 	notifyCurrent := func() {
@@ -320,11 +360,18 @@ func (s *Schedule) runHistory(r *RunHistory, ak models.AlertKey, event *models.E
 			return
 		}
 		incident.NeedAck = true
-		switch event.Status {
-		case models.StCritical, models.StUnknown:
-			notify(a.CritNotification)
-		case models.StWarning:
-			notify(a.WarnNotification)
+		status, sev := event.Status, event.Severity
+		if ackExpired && status == models.StNormal {
+			// renotify an incident that is still open after it went back
+			// to normal with the status it was abnormal with
+			status, sev = incident.LastAbnormalStatus, incident.LastAbnormalSeverity
+		}
+		if a.Routed {
+			if s.routeNotify(incident, rt, a, status, sev) {
+				checkNotify = true
+			}
+		} else if ns, e := a.StatusNotifications(status, sev); ns != nil {
+			notify(ns, e)
 		}
 	}
 
@@ -332,6 +379,7 @@ func (s *Schedule) runHistory(r *RunHistory, ak models.AlertKey, event *models.E
 	s.Lock("RunHistory")
 	if shouldNotify {
 		incident.NeedAck = false
+		cancelAckExpiry(incident)
 		if err = s.DataAccess.Notifications().ClearNotifications(ak); err != nil {
 			return
 		}
@@ -352,15 +400,36 @@ func (s *Schedule) runHistory(r *RunHistory, ak models.AlertKey, event *models.E
 	return checkNotify, nil
 }
 
+// applyNoDataState changes the status of an event for an alert key that has
+// not returned data to the noDataState of the alert. When that is warning or
+// critical the event gets a result without a value so templates can tell
+// there was no data.
+func applyNoDataState(a *conf.Alert, event *models.Event) {
+	event.Status = a.NoDataState
+	noData := &models.Result{Value: models.Float(math.NaN()), Expr: "nodata"}
+	switch a.NoDataState {
+	case models.StCritical:
+		event.Crit = noData
+		if n := len(a.Severities); n > 0 {
+			event.Severity = &a.Severities[n-1].Severity
+		}
+	case models.StWarning:
+		event.Warn = noData
+		if len(a.Severities) > 0 {
+			event.Severity = &a.Severities[0].Severity
+		}
+	}
+}
+
 func silencedOrIgnored(a *conf.Alert, event *models.Event, si *models.Silence) bool {
-	if a.IgnoreUnknown && event.Status == models.StUnknown {
+	if a.IgnoreUnknown && (event.Status == models.StUnknown || event.Status == models.StNoData) {
 		return true
 	}
 	return false
 }
 
 func (s *Schedule) executeTemplates(st *models.IncidentState, event *models.Event, a *conf.Alert, r *RunHistory) *models.RenderedTemplates {
-	if event.Status == models.StUnknown {
+	if event.Status == models.StUnknown || event.Status == models.StNoData {
 		return nil
 	}
 	rt, errs := s.ExecuteAll(r, a, st, true)
@@ -524,6 +593,34 @@ func (s *Schedule) GetUnknownAndUnevaluatedAlertKeys(alert string) (unknown, une
 	return unknown, uneval
 }
 
+// GetAbnormalAlertKeys returns the alert keys of alert that have an open
+// incident with a status other than normal, or that are unevaluated.
+func (s *Schedule) GetAbnormalAlertKeys(alert string) []models.AlertKey {
+	_, uneval, err := s.DataAccess.State().GetUnknownAndUnevalAlertKeys(alert)
+	if err != nil {
+		slog.Errorf("Error getting unevaluated alert keys: %s", err)
+		return nil
+	}
+	incidents, err := s.DataAccess.State().GetOpenIncidentsByAlert(alert)
+	if err != nil {
+		slog.Errorf("Error getting open incidents: %s", err)
+		return nil
+	}
+	seen := make(map[models.AlertKey]bool)
+	keys := []models.AlertKey{}
+	for _, ak := range uneval {
+		seen[ak] = true
+		keys = append(keys, ak)
+	}
+	for _, is := range incidents {
+		if is.CurrentStatus > models.StNormal && !seen[is.AlertKey] {
+			seen[is.AlertKey] = true
+			keys = append(keys, is.AlertKey)
+		}
+	}
+	return keys
+}
+
 var bosunStartupTime = utcNow()
 
 func (s *Schedule) findUnknownAlerts(now time.Time, alert string) []models.AlertKey {
@@ -564,6 +661,19 @@ func (s *Schedule) CheckAlert(T miniprofiler.Timer, r *RunHistory, a *conf.Alert
 	for _, ak := range s.findUnknownAlerts(r.Start, a.Name) {
 		r.Events[ak] = &models.Event{Status: models.StUnknown}
 	}
+	timeout := a.Timeout
+	if timeout == 0 {
+		timeout = s.SystemConf.GetAlertTimeout()
+	}
+	if timeout > 0 {
+		// The queries of the expressions are abandoned along with them, so
+		// they give back their backend slots.
+		deadline := make(chan struct{})
+		t := time.AfterFunc(timeout, func() { close(deadline) })
+		defer t.Stop()
+		r.deadline, r.timeout = deadline, timeout
+		r.Backends.Done = deadline
+	}
 	var warns, crits models.AlertKeys
 	type res struct {
 		results *expr.Results
@@ -586,13 +696,19 @@ func (s *Schedule) CheckAlert(T miniprofiler.Timer, r *RunHistory, a *conf.Alert
 	// execution of the expression
 	case <-s.runnerContext.Done():
 		return true
+	case <-r.deadline:
+		err = r.timeoutError(a)
 	}
 	var deps expr.ResultSlice
 	if err == nil {
 		deps = filterDependencyResults(d)
-		crits, err, cancelled = s.CheckExpr(T, r, a, a.Crit, models.StCritical, nil)
-		if err == nil && !cancelled {
-			warns, err, cancelled = s.CheckExpr(T, r, a, a.Warn, models.StWarning, crits)
+		if len(a.Severities) > 0 {
+			crits, warns, err, cancelled = s.CheckSeverities(T, r, a)
+		} else {
+			crits, err, cancelled = s.CheckExpr(T, r, a, a.Crit, models.StCritical, nil)
+			if err == nil && !cancelled {
+				warns, err, cancelled = s.CheckExpr(T, r, a, a.Warn, models.StWarning, crits)
+			}
 		}
 	}
 	if cancelled {
@@ -606,11 +722,19 @@ func (s *Schedule) CheckAlert(T miniprofiler.Timer, r *RunHistory, a *conf.Alert
 	} else {
 		s.markAlertSuccessful(a.Name)
 	}
+	s.recordAlertRun(a, r, time.Since(start), unevalCount, err)
 	collect.Put("check.duration", opentsdb.TagSet{"name": a.Name}, time.Since(start).Seconds())
 	slog.Infof("check alert %v done (%s): %v crits, %v warns, %v unevaluated, %v unknown", a.Name, time.Since(start), len(crits), len(warns), unevalCount, unknownCount)
 	return false
 }
 
+// timeoutError is the error of an alert check that has run for longer than its
+// timeout. The expression is abandoned like it is when the schedule closes.
+func (r *RunHistory) timeoutError(a *conf.Alert) error {
+	collect.Add("check.timeouts", opentsdb.TagSet{"name": a.Name}, 1)
+	return fmt.Errorf("alert %s timed out after %v", a.Name, r.timeout)
+}
+
 func removeUnknownEvents(evs map[models.AlertKey]*models.Event, alert string) {
 	for k, v := range evs {
 		if v.Status == models.StUnknown && k.Name() == alert {
@@ -676,6 +800,37 @@ func (s *Schedule) executeExpr(T miniprofiler.Timer, rh *RunHistory, a *conf.Ale
 }
 
 func (s *Schedule) CheckExpr(T miniprofiler.Timer, rh *RunHistory, a *conf.Alert, e *expr.Expr, checkStatus models.Status, ignore models.AlertKeys) (alerts models.AlertKeys, err error, cancelled bool) {
+	return s.checkExpr(T, rh, a, e, checkStatus, nil, ignore)
+}
+
+// CheckSeverities checks the custom severity levels of a, the highest level
+// first. An alert key gets the highest level whose expression is non-zero for
+// it. crits are the alert keys at the highest level, which is critical, and
+// warns the alert keys at the other levels.
+func (s *Schedule) CheckSeverities(T miniprofiler.Timer, rh *RunHistory, a *conf.Alert) (crits, warns models.AlertKeys, err error, cancelled bool) {
+	var abnormal models.AlertKeys
+	for i := len(a.Severities) - 1; i >= 0; i-- {
+		l := a.Severities[i]
+		status := models.StWarning
+		if i == len(a.Severities)-1 {
+			status = models.StCritical
+		}
+		var alerts models.AlertKeys
+		alerts, err, cancelled = s.checkExpr(T, rh, a, l.Expr, status, &l.Severity, abnormal)
+		if err != nil || cancelled {
+			return
+		}
+		abnormal = append(abnormal, alerts...)
+		if status == models.StCritical {
+			crits = alerts
+		} else {
+			warns = append(warns, alerts...)
+		}
+	}
+	return
+}
+
+func (s *Schedule) checkExpr(T miniprofiler.Timer, rh *RunHistory, a *conf.Alert, e *expr.Expr, checkStatus models.Status, sev *models.Severity, ignore models.AlertKeys) (alerts models.AlertKeys, err error, cancelled bool) {
 	if e == nil {
 		return
 	}
@@ -703,10 +858,16 @@ func (s *Schedule) CheckExpr(T miniprofiler.Timer, rh *RunHistory, a *conf.Alert
 		err = res.error
 	case <-s.runnerContext.Done():
 		return nil, nil, true
+	case <-rh.deadline:
+		err = rh.timeoutError(a)
 	}
 	if err != nil {
 		return
 	}
+	if err = s.checkMaxAlertKeys(a, results.Results); err != nil {
+		return
+	}
+	rh.results += len(results.Results)
 Loop:
 	for _, r := range results.Results {
 		if s.RuleConf.Squelched(a, r.Group) {
@@ -750,11 +911,75 @@ Loop:
 		}
 		if status > rh.Events[ak].Status {
 			event.Status = status
+			if status != models.StNormal {
+				event.Severity = sev
+			}
 		}
 	}
 	return
 }
 
+// checkMaxAlertKeys returns an error if the results of an expression of a
+// would create more alert keys than the alert allows, so that an expression
+// that groups by a high cardinality tag by mistake does not create a state
+// for each of its values. The error names the tag keys with the most values.
+func (s *Schedule) checkMaxAlertKeys(a *conf.Alert, results expr.ResultSlice) error {
+	max := a.MaxAlertKeys
+	if max == 0 {
+		max = s.SystemConf.GetMaxAlertKeys()
+	}
+	if max <= 0 || len(results) <= max {
+		return nil
+	}
+	n := 0
+	values := make(map[string]map[string]bool)
+	for _, r := range results {
+		if s.RuleConf.Squelched(a, r.Group) {
+			continue
+		}
+		n++
+		for k, v := range r.Group {
+			if values[k] == nil {
+				values[k] = make(map[string]bool)
+			}
+			values[k][v] = true
+		}
+	}
+	if n <= max {
+		return nil
+	}
+	collect.Add("check.max_alert_keys", opentsdb.TagSet{"name": a.Name}, 1)
+	return fmt.Errorf("alert %s: expression returned %d alert keys, more than maxAlertKeys (%d); values per tag key: %s", a.Name, n, max, tagCardinality(values))
+}
+
+// tagCardinality summarizes the number of values of the tag keys, the keys
+// with the most values first.
+func tagCardinality(values map[string]map[string]bool) string {
+	const maxKeys = 5
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if len(values[keys[i]]) != len(values[keys[j]]) {
+			return len(values[keys[i]]) > len(values[keys[j]])
+		}
+		return keys[i] < keys[j]
+	})
+	var b bytes.Buffer
+	for i, k := range keys {
+		if i == maxKeys {
+			fmt.Fprintf(&b, ", and %d more", len(keys)-maxKeys)
+			break
+		}
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		fmt.Fprintf(&b, "%s=%d", k, len(values[k]))
+	}
+	return b.String()
+}
+
 func valueToFloat(val expr.Value) (float64, error) {
 	var n float64
 	switch v := val.(type) {
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"strings"
 	"testing"
 	"time"
 
@@ -137,7 +138,14 @@ func TestCheckSilence(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	_, err = s.AddSilence(utcNow().Add(-time.Hour), utcNow().Add(time.Hour), "a", "", false, true, "", "user", "message")
+	si := &models.Silence{
+		Start:   utcNow().Add(-time.Hour),
+		End:     utcNow().Add(time.Hour),
+		Alert:   "a",
+		User:    "user",
+		Message: "message",
+	}
+	_, err = s.AddSilence(si, "", true, "")
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -349,6 +357,257 @@ func TestIncidentIds(t *testing.T) {
 	expect(2)
 }
 
+func TestAckExpiry(t *testing.T) {
+	defer setup()()
+	c, err := rule.NewConf("", conf.EnabledBackends{}, nil, `
+		alert a {
+			warn = 1
+			warnNotification = test
+			template = test
+			ackFor = 1h
+		}
+		template test {
+			subject = test
+			body = test2
+		}
+		notification test {
+			print = true
+		}
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, _ := initSched(&conf.SystemConf{}, c)
+	ak := models.NewAlertKey("a", nil)
+	r := &RunHistory{
+		Start: time.Now(),
+		Events: map[models.AlertKey]*models.Event{
+			ak: {Status: models.StWarning},
+		},
+	}
+	expect := func(needAck bool, pending int) {
+		t.Helper()
+		incident, err := s.DataAccess.State().GetLatestIncident(ak)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if incident.Id != 1 || !incident.Open {
+			t.Fatalf("expected incident 1 to be open, got %d (open %v)", incident.Id, incident.Open)
+		}
+		if incident.NeedAck != needAck {
+			t.Fatalf("expected NeedAck %v, got %v", needAck, incident.NeedAck)
+		}
+		if n := len(s.pendingNotifications[s.RuleConf.GetNotification("test")]); n != pending {
+			t.Fatalf("expected %v pending notifications but got %v", pending, n)
+		}
+		s.pendingNotifications = nil
+	}
+	advance := func(d time.Duration) {
+		r.Start = r.Start.Add(d)
+	}
+	s.RunHistory(r)
+	expect(true, 1)
+
+	// The ack expires after the ackFor of the alert.
+	if err := s.ActionByAlertKey("u", "", models.ActionAcknowledge, nil, ak); err != nil {
+		t.Fatal(err)
+	}
+	advance(time.Minute)
+	s.RunHistory(r)
+	expect(false, 0)
+	advance(time.Hour)
+	s.RunHistory(r)
+	expect(true, 1)
+
+	// An explicit expiry overrides ackFor, and still applies once the alert
+	// is normal again.
+	tenMin := r.Start.Add(10 * time.Minute)
+	if err := s.ActionByAlertKey("u", "", models.ActionAcknowledge, &tenMin, ak); err != nil {
+		t.Fatal(err)
+	}
+	r.Events[ak].Status = models.StNormal
+	advance(time.Minute)
+	s.RunHistory(r)
+	expect(false, 0)
+	r.Start = tenMin
+	s.RunHistory(r)
+	expect(true, 1)
+	incident, err := s.DataAccess.State().GetLatestIncident(ak)
+	if err != nil {
+		t.Fatal(err)
+	}
+	last := incident.Actions[len(incident.Actions)-1]
+	if last.Type != models.ActionAckExpired || last.User != "bosun" {
+		t.Fatalf("expected the ack to expire, got %v by %v", last.Type, last.User)
+	}
+	if err := s.ActionByAlertKey("", "", models.ActionAckExpired, nil, ak); err == nil {
+		t.Fatal("expected an error expiring an incident without an expiring ack")
+	}
+
+	// Closing the incident cancels the expiry.
+	r.Events[ak].Status = models.StWarning
+	advance(time.Minute)
+	s.RunHistory(r)
+	expect(true, 0)
+	if err := s.ActionByAlertKey("u", "", models.ActionAcknowledge, nil, ak); err != nil {
+		t.Fatal(err)
+	}
+	inTwoHours := r.Start.Add(2 * time.Hour)
+	if err := s.ActionByAlertKey("u", "", models.ActionClose, &inTwoHours, ak); err != nil {
+		t.Fatal(err)
+	}
+	advance(time.Minute)
+	s.RunHistory(r)
+	expect(false, 0)
+}
+
+// TestAckExpiryUnevaluated tests that an acknowledgement expires at its
+// deadline even when its alert key is unevaluated.
+func TestAckExpiryUnevaluated(t *testing.T) {
+	defer setup()()
+	util.InitHostManager("", false) // for the collect metrics
+	posts := make(chan string, 10)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		posts <- string(b)
+	}))
+	defer ts.Close()
+	c, err := rule.NewConf("", conf.EnabledBackends{}, nil, fmt.Sprintf(`
+		alert a {
+			warn = 1
+			warnNotification = test
+			template = test
+		}
+		template test {
+			subject = test
+			body = test2
+		}
+		notification test {
+			post = %s
+			runOnActions = false
+		}
+	`, ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, _ := initSched(&conf.SystemConf{}, c)
+	ak := models.NewAlertKey("a", nil)
+	r := &RunHistory{
+		Start: utcNow(),
+		Events: map[models.AlertKey]*models.Event{
+			ak: {Status: models.StWarning},
+		},
+	}
+	s.RunHistory(r)
+	s.pendingNotifications = nil
+	deadline := utcNow().Add(time.Second)
+	if err := s.ActionByAlertKey("u", "", models.ActionAcknowledge, &deadline, ak); err != nil {
+		t.Fatal(err)
+	}
+
+	// The alert key is unevaluated from then on, because of a dependency.
+	r.Events[ak] = &models.Event{Status: models.StWarning, Unevaluated: true}
+	time.Sleep(deadline.Sub(utcNow()) + time.Second)
+	r.Start = utcNow()
+	s.RunHistory(r)
+	s.CheckNotifications()
+
+	incident, err := s.DataAccess.State().GetLatestIncident(ak)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !incident.NeedAck || incident.Actions[len(incident.Actions)-1].Type != models.ActionAckExpired {
+		t.Fatalf("expected the ack to expire, got NeedAck %v and actions %+v", incident.NeedAck, incident.Actions)
+	}
+	select {
+	case body := <-posts:
+		if body != "test" {
+			t.Errorf("expected the alert notification, got %q", body)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected the incident to be notified again")
+	}
+}
+
+func TestCheckSeverities(t *testing.T) {
+	defer setup()()
+	c, err := rule.NewConf("", conf.EnabledBackends{}, nil, `
+		alert a {
+			template = test
+			severities = P3,P2,P1
+			severity.P3 = 1
+			severity.P2 = 1
+			severity.P1 = 0
+			severityNotification.P2 = two
+			severityNotification.P1 = one
+		}
+		template test {
+			subject = {{.Level}}
+			body = test
+		}
+		notification one {
+			print = true
+		}
+		notification two {
+			print = true
+		}
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, _ := initSched(&conf.SystemConf{}, c)
+	ak := models.NewAlertKey("a", nil)
+	expect := func(current, worst string, notified string) {
+		t.Helper()
+		incident, err := s.DataAccess.State().GetLatestIncident(ak)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if l := incident.Level(); l != current {
+			t.Fatalf("expected current level %v, got %v", current, l)
+		}
+		if l := incident.WorstStatus.Label(incident.WorstSeverity); l != worst {
+			t.Fatalf("expected worst level %v, got %v", worst, l)
+		}
+		for _, name := range []string{"one", "two"} {
+			n := len(s.pendingNotifications[s.RuleConf.GetNotification(name)])
+			if name == notified && n != 1 || name != notified && n != 0 {
+				t.Fatalf("expected a notification to %q, got %v to %v", notified, n, name)
+			}
+		}
+		s.pendingNotifications = nil
+	}
+
+	// The highest level with a non-zero expression wins.
+	start := utcNow()
+	check(s, start)
+	expect("P2", "P2", "two")
+	incident, err := s.DataAccess.State().GetLatestIncident(ak)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if incident.Subject != "P2" || incident.WorstStatus != models.StWarning {
+		t.Fatalf("expected a warning with subject P2, got %v with subject %v", incident.WorstStatus, incident.Subject)
+	}
+
+	a := s.RuleConf.GetAlert("a")
+	r := &RunHistory{
+		Start: start.Add(time.Minute),
+		Events: map[models.AlertKey]*models.Event{
+			ak: {Status: models.StCritical, Severity: &a.Severity("P1").Severity},
+		},
+	}
+	// An increase of the level renotifies with the notifications of the level.
+	s.RunHistory(r)
+	expect("P1", "P1", "one")
+
+	// A decrease does not.
+	r.Start = r.Start.Add(time.Minute)
+	r.Events[ak] = &models.Event{Status: models.StWarning, Severity: &a.Severity("P3").Severity}
+	s.RunHistory(r)
+	expect("P3", "P1", "")
+}
+
 func TestCheckNotify(t *testing.T) {
 	defer setup()()
 	nc := make(chan string)
@@ -458,6 +717,103 @@ Loop:
 	}
 }
 
+func TestCheckNotifyDigest(t *testing.T) {
+	defer setup()()
+	nc := make(chan string, 2)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		nc <- string(b)
+	}))
+	defer ts.Close()
+	u, err := url.Parse(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := rule.NewConf("", conf.EnabledBackends{}, nil, fmt.Sprintf(`
+		template t {
+			subject = s
+			body = b
+		}
+		template d {
+			subject = {{len .Incidents}}
+			body = {{range .Incidents}}{{.AlertKey}}={{range .Events}}{{.}}{{end}};{{end}}
+		}
+		notification n {
+			post = http://%s/
+			digest = 5m
+			digestTemplate = d
+		}
+		alert a {
+			template = t
+			critNotification = n
+			crit = 1
+		}
+	`, u.Host))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := initSched(&conf.SystemConf{}, c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// A digest period of a second, started right away, so the test can wait
+	// for the digest the scheduler queued instead of making it due by hand.
+	n := c.GetNotification("n")
+	n.Digest = time.Second
+	time.Sleep(time.Until(utcNow().Truncate(time.Second).Add(time.Second + 10*time.Millisecond)))
+	end := digestEnd(n.Digest, utcNow())
+
+	x := models.NewAlertKey("a", opentsdb.TagSet{"h": "x"})
+	y := models.NewAlertKey("a", opentsdb.TagSet{"h": "y"})
+	z := models.NewAlertKey("a", opentsdb.TagSet{"h": "z"})
+	s.RunHistory(&RunHistory{
+		Start: utcNow(),
+		Events: map[models.AlertKey]*models.Event{
+			x: {Status: models.StCritical},
+			y: {Status: models.StUnknown},
+			z: {Status: models.StCritical},
+		},
+	})
+	s.CheckNotifications()
+	// Acknowledging x within the period keeps it in the digest.
+	if err := s.ActionByAlertKey("u", "m", models.ActionAcknowledge, nil, x); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.ActionNotify(models.ActionAcknowledge, "u", "m", []models.AlertKey{x}); err != nil {
+		t.Fatal(err)
+	}
+	due, err := s.DataAccess.Notifications().GetNextNotificationTime()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !due.Equal(end) {
+		t.Fatalf("expected the digest to be due at %v, got %v", end, due)
+	}
+	select {
+	case r := <-nc:
+		t.Fatalf("expected nothing to be sent before the digest is due, got %v", r)
+	default:
+	}
+
+	time.Sleep(time.Until(end.Add(10 * time.Millisecond)))
+	s.CheckNotifications()
+	select {
+	case r := <-nc:
+		for _, expected := range []string{"a{h=x}=actionalert;", "a{h=y}=unknown;", "a{h=z}=alert;"} {
+			if !strings.Contains(r, expected) {
+				t.Errorf("expected %s in digest %s", expected, r)
+			}
+		}
+	case <-time.After(time.Second):
+		t.Fatal("failed to receive digest before timeout")
+	}
+	select {
+	case r := <-nc:
+		t.Fatalf("expected a single digest, got another: %v", r)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
 // TestCheckNotifyUnknownDefault tests the default unknownTemplate.
 func TestCheckNotifyUnknownDefault(t *testing.T) {
 	defer setup()()
@@ -0,0 +1,47 @@
+package sched
+
+import (
+	"bosun.org/cmd/bosun/conf"
+	"bosun.org/models"
+	"bosun.org/slog"
+)
+
+// recordDeliveries adds the attempts and suppressed sends of pn to the
+// delivery logs of their incidents, and notes the suppressed sends on the
+// timelines of the incidents.
+func (s *Schedule) recordDeliveries(pn *conf.PreparedNotifications) {
+	for _, d := range pn.Deliveries {
+		for _, id := range s.sendIncidents(d.Incidents, d.Ak) {
+			if err := s.DataAccess.Deliveries().AddDelivery(id, &d.DeliveryAttempt); err != nil {
+				slog.Errorf("adding a delivery of notification %s to incident %d: %v", pn.Name, id, err)
+			}
+		}
+	}
+	s.recordSuppressed(pn)
+}
+
+// sendIncidents returns the incidents of a send: the given ones, or the
+// latest incidents of its alert keys.
+func (s *Schedule) sendIncidents(incidents []int64, aks []string) []int64 {
+	if len(incidents) > 0 {
+		return incidents
+	}
+	var ids []int64
+	for _, ak := range aks {
+		st, err := s.DataAccess.State().GetLatestIncident(models.AlertKey(ak))
+		if err != nil {
+			slog.Errorf("getting the incident of %s: %v", ak, err)
+			continue
+		}
+		if st != nil {
+			ids = append(ids, st.Id)
+		}
+	}
+	return ids
+}
+
+// Deliveries returns the attempts to send the notifications of an incident,
+// most recent first.
+func (s *Schedule) Deliveries(incidentId int64) ([]*models.DeliveryAttempt, error) {
+	return s.DataAccess.Deliveries().GetDeliveries(incidentId)
+}
@@ -0,0 +1,132 @@
+package sched
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"bosun.org/cmd/bosun/conf"
+	"bosun.org/cmd/bosun/conf/rule"
+	"bosun.org/models"
+	"bosun.org/util"
+)
+
+func TestDeliveries(t *testing.T) {
+	defer setup()()
+	util.InitHostManager("", false) // for the collect metrics
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	}))
+	defer ts.Close()
+
+	c, err := rule.NewConf("", conf.EnabledBackends{}, nil, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sc := &conf.SystemConf{OutboxConf: conf.OutboxConf{MaxAttempts: 1}}
+	s, err := initSched(sc, c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ak := models.AlertKey("a{host=x}")
+	id, err := s.DataAccess.State().UpdateIncidentState(&models.IncidentState{
+		AlertKey:      ak,
+		Alert:         ak.Name(),
+		Tags:          ak.Group().Tags(),
+		Start:         utcNow(),
+		Open:          true,
+		WorstStatus:   models.StCritical,
+		CurrentStatus: models.StCritical,
+		Events:        []models.Event{{Status: models.StCritical, Time: utcNow()}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	n := &conf.Notification{Name: "n"}
+	details := &conf.NotificationDetails{Ak: []string{string(ak)}, NotifyName: "n", TemplateKey: "postBody"}
+	s.deliver(&conf.PreparedNotifications{Name: "n", HTTP: []*conf.PreparedHttp{n.PrepHttp("POST", ts.URL, "body", details)}})
+
+	var attempts []*models.DeliveryAttempt
+	for i := 0; i < 100 && len(attempts) == 0; i++ {
+		time.Sleep(20 * time.Millisecond)
+		if attempts, err = s.Deliveries(id); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(attempts) != 1 {
+		t.Fatalf("expected 1 attempt, got %d", len(attempts))
+	}
+	a := attempts[0]
+	if a.Notification != "n" || a.Transport != "http" || a.Destination != "POST "+ts.URL || a.TemplateKey != "postBody" {
+		t.Errorf("unexpected attempt %+v", a)
+	}
+	if a.StatusCode != http.StatusTeapot || a.Error == "" || a.Latency <= 0 {
+		t.Errorf("expected a failed attempt, got %+v", a)
+	}
+}
+
+func TestDeliveriesRedacted(t *testing.T) {
+	defer setup()()
+	util.InitHostManager("", false) // for the collect metrics
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer ts.Close()
+
+	c, err := rule.NewConf("", conf.EnabledBackends{}, nil, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := initSched(&conf.SystemConf{}, c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ak := models.AlertKey("a{host=x}")
+	st := &models.IncidentState{
+		AlertKey:      ak,
+		Alert:         ak.Name(),
+		Tags:          ak.Group().Tags(),
+		Start:         utcNow(),
+		Open:          true,
+		WorstStatus:   models.StCritical,
+		CurrentStatus: models.StCritical,
+	}
+	if st.Id, err = s.DataAccess.State().UpdateIncidentState(st); err != nil {
+		t.Fatal(err)
+	}
+
+	n := &conf.Notification{
+		Name:       "n",
+		Post:       mustParseURL(t, ts.URL+"/hook/secret-post"),
+		Transports: map[string]string{"teams": ts.URL + "/webhookb2/secret-teams"},
+	}
+	s.deliver(n.PrepareAlert(&models.RenderedTemplates{Subject: "s", Body: "b"}, st))
+
+	var attempts []*models.DeliveryAttempt
+	for i := 0; i < 100 && len(attempts) < 2; i++ {
+		time.Sleep(20 * time.Millisecond)
+		if attempts, err = s.Deliveries(st.Id); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(attempts) != 2 {
+		t.Fatalf("expected 2 attempts, got %d", len(attempts))
+	}
+	for _, a := range attempts {
+		if strings.Contains(a.Destination, "secret") || a.Destination == "" {
+			t.Errorf("expected a redacted destination, got %+v", a)
+		}
+	}
+}
+
+func mustParseURL(t *testing.T, s string) *url.URL {
+	u, err := url.Parse(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return u
+}
@@ -0,0 +1,103 @@
+package sched
+
+import (
+	"sort"
+
+	"bosun.org/cmd/bosun/conf"
+	"bosun.org/models"
+)
+
+// DependencyGraph shows how alerts depend on each other through the alert
+// and dependsOnAlert expression functions. Only alerts that are part of at
+// least one edge are included.
+type DependencyGraph struct {
+	Nodes []*DependencyNode
+	Edges []*DependencyEdge
+}
+
+// DependencyNode is an alert in the dependency graph along with a summary
+// of its current state.
+type DependencyNode struct {
+	Alert         string
+	CurrentStatus models.Status
+	OpenIncidents int
+	Unevaluated   int
+}
+
+// DependencyEdge means that alert From references alert To.
+type DependencyEdge struct {
+	From      string
+	To        string
+	Kind      string
+	TagsMatch string `json:",omitempty"`
+}
+
+// DependencyGraph builds the alert dependency graph of the current rule
+// configuration.
+func (s *Schedule) DependencyGraph() (*DependencyGraph, error) {
+	g := dependencyGraph(s.RuleConf)
+	incidents, err := s.DataAccess.State().GetAllOpenIncidents()
+	if err != nil {
+		return nil, err
+	}
+	nodes := make(map[string]*DependencyNode, len(g.Nodes))
+	for _, n := range g.Nodes {
+		nodes[n.Alert] = n
+		_, uneval, err := s.DataAccess.State().GetUnknownAndUnevalAlertKeys(n.Alert)
+		if err != nil {
+			return nil, err
+		}
+		n.Unevaluated = len(uneval)
+	}
+	for _, is := range incidents {
+		n := nodes[is.AlertKey.Name()]
+		if n == nil {
+			continue
+		}
+		n.OpenIncidents++
+		if is.CurrentStatus > n.CurrentStatus {
+			n.CurrentStatus = is.CurrentStatus
+		}
+	}
+	return g, nil
+}
+
+func dependencyGraph(c conf.RuleConfProvider) *DependencyGraph {
+	g := &DependencyGraph{
+		Nodes: []*DependencyNode{},
+		Edges: []*DependencyEdge{},
+	}
+	seen := make(map[string]bool)
+	add := func(name string) {
+		if !seen[name] {
+			seen[name] = true
+			g.Nodes = append(g.Nodes, &DependencyNode{Alert: name, CurrentStatus: models.StNormal})
+		}
+	}
+	for name, a := range c.GetAlerts() {
+		for _, d := range a.Dependencies {
+			add(name)
+			add(d.Alert)
+			g.Edges = append(g.Edges, &DependencyEdge{
+				From:      name,
+				To:        d.Alert,
+				Kind:      d.Kind,
+				TagsMatch: d.TagsMatch,
+			})
+		}
+	}
+	sort.Slice(g.Nodes, func(i, j int) bool {
+		return g.Nodes[i].Alert < g.Nodes[j].Alert
+	})
+	sort.Slice(g.Edges, func(i, j int) bool {
+		a, b := g.Edges[i], g.Edges[j]
+		if a.From != b.From {
+			return a.From < b.From
+		}
+		if a.To != b.To {
+			return a.To < b.To
+		}
+		return a.Kind < b.Kind
+	})
+	return g
+}
@@ -304,3 +304,88 @@ alert c {
 		},
 	})
 }
+
+func TestDependency_DependsOnAlert(t *testing.T) {
+	defer setup()()
+	s := testSched(t, &schedTest{
+		conf: `
+		alert a.switch {
+			crit = avg(q("avg:switch.status{switch=*}", "5m", "")) > 0
+		}
+
+		alert b.host {
+			depends = dependsOnAlert("a.switch", "switch=*")
+			crit = avg(q("avg:os.cpu{host=*,switch=*}", "5m", "")) > 0
+		}
+
+		alert c.app {
+			depends = dependsOnAlert("b.host", "host=*")
+			crit = avg(q("avg:app.errors{host=*}", "5m", "")) > 0
+		}
+		`,
+		queries: map[string]opentsdb.ResponseSet{
+			`q("avg:switch.status{switch=*}", ` + window5Min + `)`: {
+				{
+					Metric: "switch.status",
+					Tags:   opentsdb.TagSet{"switch": "sw1"},
+					DPS:    map[string]opentsdb.Point{"0": 1},
+				},
+				{
+					Metric: "switch.status",
+					Tags:   opentsdb.TagSet{"switch": "sw2"},
+					DPS:    map[string]opentsdb.Point{"0": 0},
+				},
+			},
+			`q("avg:os.cpu{host=*,switch=*}", ` + window5Min + `)`: {
+				{
+					Metric: "os.cpu",
+					Tags:   opentsdb.TagSet{"host": "h1", "switch": "sw1"},
+					DPS:    map[string]opentsdb.Point{"0": 1},
+				},
+				{
+					Metric: "os.cpu",
+					Tags:   opentsdb.TagSet{"host": "h2", "switch": "sw2"},
+					DPS:    map[string]opentsdb.Point{"0": 1},
+				},
+			},
+			`q("avg:app.errors{host=*}", ` + window5Min + `)`: {
+				{
+					Metric: "app.errors",
+					Tags:   opentsdb.TagSet{"host": "h1"},
+					DPS:    map[string]opentsdb.Point{"0": 1},
+				},
+				{
+					Metric: "app.errors",
+					Tags:   opentsdb.TagSet{"host": "h3"},
+					DPS:    map[string]opentsdb.Point{"0": 1},
+				},
+			},
+		},
+		state: map[schedState]bool{
+			{"a.switch{switch=sw1}", "critical"}:       true,
+			{"b.host{host=h2,switch=sw2}", "critical"}: true,
+			{"c.app{host=h3}", "critical"}:             true,
+		},
+	})
+	// b.host{host=h1} is unevaluated because its switch is down, which in
+	// turn makes c.app{host=h1} unevaluated.
+	for _, ak := range []models.AlertKey{"b.host{host=h1,switch=sw1}", "c.app{host=h1}"} {
+		_, uneval, err := s.DataAccess.State().GetUnknownAndUnevalAlertKeys(ak.Name())
+		if err != nil {
+			t.Fatal(err)
+		}
+		found := false
+		for _, u := range uneval {
+			if u == ak {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("expected %s to be unevaluated", ak)
+		}
+	}
+	deps := s.RuleConf.GetAlert("c.app").Dependencies
+	if len(deps) != 1 || deps[0].Alert != "b.host" || deps[0].Kind != "depends" {
+		t.Errorf("unexpected dependencies: %v", deps)
+	}
+}
@@ -0,0 +1,95 @@
+package sched
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"bosun.org/cmd/bosun/conf"
+	"bosun.org/models"
+	"bosun.org/slog"
+)
+
+// Levels of escalations are queued with the pending notifications of an
+// alert key, under a name that can not be the name of a notification. The
+// notifications of a level are resolved when it is due, so they follow the
+// rotations, and acknowledging or closing the incident clears the queued
+// level like any notification chain.
+const escalationPrefix = "escalation#"
+
+func escalationQueueName(e *conf.Escalation, level int) string {
+	return fmt.Sprintf("%s%s#%d", escalationPrefix, e.Name, level)
+}
+
+// queuedEscalation returns the escalation and level of a queued
+// notification name, or nil if it is not an escalation level or the
+// escalation or level no longer exist.
+func (s *Schedule) queuedEscalation(name string) (*conf.Escalation, int) {
+	if !strings.HasPrefix(name, escalationPrefix) {
+		return nil, 0
+	}
+	name = strings.TrimPrefix(name, escalationPrefix)
+	i := strings.LastIndex(name, "#")
+	if i == -1 {
+		return nil, 0
+	}
+	level, err := strconv.Atoi(name[i+1:])
+	if err != nil {
+		return nil, 0
+	}
+	e := s.RuleConf.GetEscalation(name[:i])
+	if e == nil || level < 0 || level >= len(e.Levels) {
+		return nil, 0
+	}
+	return e, level
+}
+
+func (s *Schedule) queueEscalation(ak models.AlertKey, e *conf.Escalation, level int, t time.Time) error {
+	return s.DataAccess.Notifications().InsertNotification(ak, escalationQueueName(e, level), t)
+}
+
+// escalate notifies the notifications on call at now for a level of an
+// escalation and queues the next level after its delay. now is the time of
+// the check that escalated, so the levels of a replayed check follow the
+// replayed time. It returns true if the notifications of the incident
+// changed.
+func (s *Schedule) escalate(st *models.IncidentState, rt *models.RenderedTemplates, e *conf.Escalation, level int, now time.Time) bool {
+	changed := false
+	for _, n := range e.Levels[level].Resolve(now) {
+		if s.Notify(st, rt, n) {
+			changed = true
+		}
+	}
+	if next := level + 1; next < len(e.Levels) {
+		if err := s.queueEscalation(st.AlertKey, e, next, now.Add(e.Levels[next].Delay)); err != nil {
+			slog.Errorf("queueing level %d of escalation %s for %s: %v", next+1, e.Name, st.AlertKey, err)
+		}
+	}
+	return changed
+}
+
+// escalationChain returns the notifications on call for each level of e, as
+// a notification chain for display.
+func escalationChain(e *conf.Escalation, t time.Time) []string {
+	var chain []string
+	for _, l := range e.Levels {
+		var names []string
+		for name := range l.Resolve(t) {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		chain = append(chain, strings.Join(names, ", "))
+	}
+	return chain
+}
+
+// OnCall returns who is on call at t for each level of the escalations.
+func (s *Schedule) OnCall(t time.Time) map[string][]conf.OnCall {
+	m := make(map[string][]conf.OnCall)
+	for name, e := range s.RuleConf.GetEscalations() {
+		m[name] = e.OnCall(t)
+	}
+	return m
+}
@@ -0,0 +1,121 @@
+package sched
+
+import (
+	"testing"
+	"time"
+
+	"bosun.org/cmd/bosun/conf"
+	"bosun.org/cmd/bosun/conf/rule"
+	"bosun.org/models"
+)
+
+func TestEscalation(t *testing.T) {
+	defer setup()()
+	c, err := rule.NewConf("", conf.EnabledBackends{}, nil, `
+		template t {
+			subject = 1
+			body = 1
+		}
+		notification n1 {
+			print = true
+		}
+		notification n2 {
+			print = true
+		}
+		notification n3 {
+			print = true
+		}
+		rotation r {
+			members = n2
+			shift = daily
+			start = 2017-01-02T09:00:00Z
+		}
+		escalation e {
+			level1 = n1
+			level2 = r
+			level2Delay = 10m
+			level3 = n3
+			level3Delay = 2h
+		}
+		alert a {
+			template = t
+			crit = 1
+			critEscalation = e
+		}
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := initSched(&conf.SystemConf{}, c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.quiet = true // only the notified incidents are checked
+	ak := models.AlertKey("a{host=x}")
+	id, err := s.DataAccess.State().UpdateIncidentState(&models.IncidentState{
+		AlertKey:      ak,
+		Alert:         ak.Name(),
+		Tags:          ak.Group().Tags(),
+		Start:         utcNow(),
+		Open:          true,
+		NeedAck:       true,
+		WorstStatus:   models.StCritical,
+		CurrentStatus: models.StCritical,
+		Events:        []models.Event{{Status: models.StCritical, Time: utcNow()}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	e := c.GetEscalation("e")
+	notified := func(expected ...string) {
+		t.Helper()
+		st, err := s.DataAccess.State().GetIncidentState(id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(st.Notifications) != len(expected) {
+			t.Fatalf("expected notifications %v, got %v", expected, st.Notifications)
+		}
+		for i, n := range expected {
+			if st.Notifications[i] != n {
+				t.Fatalf("expected notifications %v, got %v", expected, st.Notifications)
+			}
+		}
+	}
+
+	// The second level is due, it notifies the member on call and queues
+	// the third level.
+	if err := s.queueEscalation(ak, e, 1, utcNow().Add(-time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	s.CheckNotifications()
+	notified("n2")
+	next, err := s.DataAccess.Notifications().GetNextNotificationTime()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d := next.Sub(utcNow()); d < 110*time.Minute || d > 2*time.Hour {
+		t.Errorf("expected the third level in 2h, got %v", d)
+	}
+
+	// Acknowledging stops the escalation.
+	if err := s.ActionByAlertKey("u", "m", models.ActionAcknowledge, nil, ak); err != nil {
+		t.Fatal(err)
+	}
+	next, err = s.DataAccess.Notifications().GetNextNotificationTime()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d := next.Sub(utcNow()); d > 61*time.Minute {
+		t.Errorf("expected the third level to be cleared, next notification in %v", d)
+	}
+	if err := s.queueEscalation(ak, e, 2, utcNow().Add(-time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	s.CheckNotifications()
+	notified("n2")
+
+	if on := s.OnCall(utcNow())["e"]; len(on) != 3 || on[1].Rotations[0].OnCall != "n2" {
+		t.Errorf("unexpected on call: %+v", on)
+	}
+}
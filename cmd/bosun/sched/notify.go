@@ -1,6 +1,9 @@
 package sched
 
 import (
+	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"bosun.org/cmd/bosun/conf"
@@ -56,9 +59,21 @@ func (s *Schedule) Notify(st *models.IncidentState, rt *models.RenderedTemplates
 	return st.SetNotified(n.Name)
 }
 
+// wakeNotifications makes the dispatcher check the notifications now, so it
+// takes notifications queued for before its next check into account.
+func (s *Schedule) wakeNotifications() {
+	if s.nc == nil {
+		return
+	}
+	select {
+	case s.nc <- true:
+	default:
+	}
+}
+
 // CheckNotifications processes past notification events. It returns the next time a notification is needed.
 func (s *Schedule) CheckNotifications() time.Time {
-	silenced := s.Silenced()
+	silenced := s.NotificationSilenced()
 	s.Lock("CheckNotifications")
 	defer s.Unlock()
 	latestTime := utcNow()
@@ -67,14 +82,38 @@ func (s *Schedule) CheckNotifications() time.Time {
 		slog.Error("Error getting notifications", err)
 		return utcNow().Add(time.Minute)
 	}
+	digests := make(map[*conf.Notification]map[models.AlertKey][]string)
+	groups := make(map[routeGroup]map[models.AlertKey]bool)
 	for ak, ns := range notifications {
-		if si := silenced(ak); si != nil {
+		// Acknowledgements expire even when the alert key is silenced,
+		// unevaluated or no longer checked.
+		if _, ok := ns[ackExpiryName]; ok {
+			delete(ns, ackExpiryName)
+			s.expireAck(ak)
+		}
+		if si := silenced(ak, ""); si != nil {
 			slog.Infoln("silencing", ak)
 			continue
 		}
 		for name, t := range ns {
+			if n, event := s.queuedDigest(name); n != nil {
+				if digests[n] == nil {
+					digests[n] = make(map[models.AlertKey][]string)
+				}
+				digests[n][ak] = append(digests[n][ak], event)
+				continue
+			}
+			if r, n := s.queuedRoute(name); n != nil {
+				rg := routeGroup{route: r, notification: n}
+				if groups[rg] == nil {
+					groups[rg] = make(map[models.AlertKey]bool)
+				}
+				groups[rg][ak] = true
+				continue
+			}
+			e, level := s.queuedEscalation(name)
 			n := s.RuleConf.GetNotification(name)
-			if n == nil {
+			if n == nil && e == nil {
 				continue
 			}
 			//If alert is currently unevaluated because of a dependency,
@@ -89,7 +128,11 @@ func (s *Schedule) CheckNotifications() time.Time {
 			}
 			if unevaluated {
 				// look at it again in a minute
-				s.QueueNotification(ak, n, t.Add(time.Minute))
+				if e != nil {
+					s.queueEscalation(ak, e, level, t.Add(time.Minute))
+				} else {
+					s.QueueNotification(ak, n, t.Add(time.Minute))
+				}
 				continue
 			}
 			st, err := s.DataAccess.State().GetLatestIncident(ak)
@@ -105,7 +148,17 @@ func (s *Schedule) CheckNotifications() time.Time {
 				slog.Error(err)
 				continue
 			}
-			if s.Notify(st, rt, n) {
+			var changed bool
+			if e != nil {
+				// escalate only while the incident still needs an ack
+				if !st.Open || !st.NeedAck {
+					continue
+				}
+				changed = s.escalate(st, rt, e, level, utcNow())
+			} else {
+				changed = s.Notify(st, rt, n)
+			}
+			if changed {
 				_, err = s.DataAccess.State().UpdateIncidentState(st)
 				if err != nil {
 					slog.Error(err)
@@ -116,6 +169,8 @@ func (s *Schedule) CheckNotifications() time.Time {
 	}
 	s.sendNotifications(silenced)
 	s.pendingNotifications = nil
+	s.sendDigests(digests, silenced)
+	s.sendRouteGroups(groups, silenced)
 	err = s.DataAccess.Notifications().ClearNotificationsBefore(latestTime)
 	if err != nil {
 		slog.Error("Error clearing notifications", err)
@@ -132,8 +187,9 @@ func (s *Schedule) CheckNotifications() time.Time {
 // sendNotifications processes the schedule's pendingNotifications queue. It silences notifications,
 // moves unknown notifications to the unknownNotifications queue so they can be grouped, calls the notification
 // Notify method to trigger notification actions, and queues notifications that are in the future because they
-// are part of a notification chain
-func (s *Schedule) sendNotifications(silenced SilenceTester) {
+// are part of a notification chain. Silences that only apply to some notifications are checked against
+// each notification.
+func (s *Schedule) sendNotifications(silenced NotificationSilenceTester) {
 	if s.quiet {
 		slog.Infoln("quiet mode prevented", len(s.pendingNotifications), "notifications")
 		return
@@ -145,16 +201,20 @@ func (s *Schedule) sendNotifications(silenced SilenceTester) {
 			if alert == nil {
 				continue
 			}
-			silenced := silenced(ak) != nil
-			if st.CurrentStatus == models.StUnknown {
+			silenced := silenced(ak, n.Name) != nil
+			if st.CurrentStatus == models.StUnknown || st.CurrentStatus == models.StNoData {
 				if silenced {
-					slog.Infoln("silencing unknown", ak)
+					slog.Infoln("silencing", st.CurrentStatus, ak, n.Name)
+					continue
+				}
+				if n.Digest > 0 {
+					s.queueDigest(ak, n, digestUnknown)
 					continue
 				}
-				gk := notificationGroupKey{notification: n, template: alert.Template}
+				gk := notificationGroupKey{notification: n, template: alert.Template, noData: st.CurrentStatus == models.StNoData}
 				s.pendingUnknowns[gk] = append(s.pendingUnknowns[gk], st.IncidentState)
 			} else if silenced {
-				slog.Infof("silencing %s", ak)
+				slog.Infof("silencing %s notification %s", ak, n.Name)
 				continue
 			} else if !alert.Log && (!st.Open || !st.NeedAck) {
 				slog.Errorf("Cannot notify acked or closed alert %s. Clearing.", ak)
@@ -162,6 +222,8 @@ func (s *Schedule) sendNotifications(silenced SilenceTester) {
 					slog.Error(err)
 				}
 				continue
+			} else if n.Digest > 0 {
+				s.queueDigest(ak, n, digestAlert)
 			} else {
 				s.notify(st.IncidentState, st.RenderedTemplates, n)
 			}
@@ -176,7 +238,8 @@ func (s *Schedule) sendNotifications(silenced SilenceTester) {
 // sendUnknownNotifications processes the schedule's pendingUnknowns queue. It puts unknowns into groups
 // to be processed by the notification. When it is done processing the pendingUnknowns queue,
 // it reinitializes the queue. Will send a maximum of $Unknown_Threshold notifications. If more are needed,
-// the last one will be a multi-group.
+// the last one will be a multi-group. Alert keys with the nodata status are grouped the same way, but
+// use the noData template keys of the notification.
 func (s *Schedule) sendUnknownNotifications() {
 	if len(s.pendingUnknowns) > 0 {
 		slog.Info("Batching and sending unknown notifications")
@@ -184,6 +247,13 @@ func (s *Schedule) sendUnknownNotifications() {
 	}
 	for gk, states := range s.pendingUnknowns {
 		n := gk.notification
+		prepareUnknown := n.PrepareUnknown
+		if gk.noData {
+			prepareUnknown = n.PrepareNoData
+		}
+		notifyUnknown := func(t *conf.Template, c conf.SystemConfProvider, name string, aks []models.AlertKey, st *models.IncidentState) {
+			s.deliver(prepareUnknown(t, c, name, aks, st))
+		}
 		ustates := make(States)
 		for _, st := range states {
 			ustates[st.AlertKey] = st
@@ -208,28 +278,27 @@ func (s *Schedule) sendUnknownNotifications() {
 				if c >= threshold && threshold > 0 {
 					if !hitThreshold && len(groupSets) == c {
 						// If the threshold is hit but only 1 email remains, just send the normal unknown
-						n.NotifyUnknown(gk.template, s.SystemConf, name, group, ustates[ak])
+						notifyUnknown(gk.template, s.SystemConf, name, group, ustates[ak])
 						break
 					}
 					hitThreshold = true
 					overThresholdSets[name] = group
 					multiUstates = append(multiUstates, ustates[ak])
 				} else {
-					n.NotifyUnknown(gk.template, s.SystemConf, name, group, ustates[ak])
+					notifyUnknown(gk.template, s.SystemConf, name, group, ustates[ak])
 				}
 			}
 		}
 		if len(overThresholdSets) > 0 {
-			n.NotifyMultipleUnknowns(gk.template, s.SystemConf, overThresholdSets, multiUstates)
+			s.deliver(n.PrepareMultipleUnknowns(gk.template, s.SystemConf, overThresholdSets, multiUstates))
 		}
 	}
 	s.pendingUnknowns = make(map[notificationGroupKey][]*models.IncidentState)
 }
 
-// notify is a wrapper for the notifications Notify method that sets the EmailSubject and EmailBody for the rendered
-// template. It passes properties from the schedule that the Notification's Notify method requires.
+// notify prepares the alert notification of n for the rendered templates and sends it through the outbox.
 func (s *Schedule) notify(st *models.IncidentState, rt *models.RenderedTemplates, n *conf.Notification) {
-	n.NotifyAlert(rt, s.SystemConf, string(st.AlertKey), rt.Attachments...)
+	s.deliver(n.PrepareAlert(rt, st, rt.Attachments...))
 }
 
 // QueueNotification persists a notification to the datastore to be sent in the future. This happens when
@@ -238,23 +307,174 @@ func (s *Schedule) QueueNotification(ak models.AlertKey, n *conf.Notification, t
 	return s.DataAccess.Notifications().InsertNotification(ak, n.Name, time)
 }
 
+// An acknowledgement with an expiry queues ackExpiryName for its alert key,
+// due at the deadline, so it expires even if the alert key is not evaluated
+// again. Like chains it is cleared when the incident is acknowledged again
+// or closed.
+const ackExpiryName = "ackexpiry#"
+
+// expireAck expires the acknowledgement of the latest incident of ak if its
+// deadline has passed and it was not expired by a check already, and notifies
+// the incident again with the status it was last abnormal with.
+func (s *Schedule) expireAck(ak models.AlertKey) {
+	st, err := s.DataAccess.State().GetLatestIncident(ak)
+	if err != nil {
+		slog.Error(err)
+		return
+	}
+	if st == nil || !st.Open || st.NeedAck {
+		return
+	}
+	now := utcNow()
+	i := pendingAckExpiry(st)
+	if i == -1 || now.Before(*st.Actions[i].Deadline) {
+		return
+	}
+	msg := fmt.Sprintf("acknowledgement by %v expired", st.Actions[i].User)
+	slog.Infof("%s: %s", ak, msg)
+	if _, err := s.action("bosun", msg, models.ActionAckExpired, nil, st); err != nil {
+		slog.Errorln(err)
+		return
+	}
+	if err := s.ActionNotify(models.ActionAckExpired, "bosun", msg, []models.AlertKey{ak}); err != nil {
+		slog.Errorln(err)
+	}
+	a := s.RuleConf.GetAlert(ak.Name())
+	if a == nil {
+		return
+	}
+	rt, err := s.DataAccess.State().GetRenderedTemplates(st.Id)
+	if err != nil {
+		slog.Error(err)
+		return
+	}
+	status, sev := st.CurrentStatus, st.CurrentSeverity
+	if status <= models.StNormal {
+		status, sev = st.LastAbnormalStatus, st.LastAbnormalSeverity
+	}
+	if a.Routed {
+		s.routeNotify(st, rt, a, status, sev)
+	} else if ns, e := a.StatusNotifications(status, sev); ns != nil {
+		for _, n := range ns.Get(s.RuleConf, ak.Group()) {
+			s.Notify(st, rt, n)
+		}
+		if e != nil {
+			s.escalate(st, rt, e, 0, now)
+		}
+	}
+	if _, err := s.DataAccess.State().UpdateIncidentState(st); err != nil {
+		slog.Error(err)
+	}
+}
+
+// Notifications with a digest are queued like notification chains, under a
+// name made of digestPrefix, the notification name and the kind of event,
+// due at the end of the current digest period. All events of a period are
+// due at the same time, so they are sent together as one digest. Unlike
+// chains they are not cleared when the incident is acknowledged or closed,
+// so the digest still lists the incidents that were handled in the period.
+const digestPrefix = "digest#"
+
+const (
+	digestAlert   = "alert"
+	digestUnknown = "unknown"
+	digestAction  = "action"
+)
+
+// digestEnd returns the end of the digest period of d that t is in.
+func digestEnd(d time.Duration, t time.Time) time.Time {
+	return t.Truncate(d).Add(d)
+}
+
+func (s *Schedule) queueDigest(ak models.AlertKey, n *conf.Notification, event string) {
+	name := fmt.Sprintf("%s%s#%s", digestPrefix, n.Name, event)
+	if err := s.DataAccess.Notifications().InsertDigestNotification(ak, name, digestEnd(n.Digest, utcNow())); err != nil {
+		slog.Errorf("queueing %s digest of %s for %s: %v", event, n.Name, ak, err)
+	}
+}
+
+// queuedDigest returns the notification and kind of event of a queued
+// notification name, or nil if it is not a digest or the notification no
+// longer has a digest.
+func (s *Schedule) queuedDigest(name string) (*conf.Notification, string) {
+	if !strings.HasPrefix(name, digestPrefix) {
+		return nil, ""
+	}
+	name = strings.TrimPrefix(name, digestPrefix)
+	i := strings.LastIndex(name, "#")
+	if i == -1 {
+		return nil, ""
+	}
+	n := s.RuleConf.GetNotification(name[:i])
+	if n == nil || n.Digest == 0 {
+		return nil, ""
+	}
+	return n, name[i+1:]
+}
+
+// sendDigests sends a digest to each notification of the incidents of the
+// alert keys that had events in the period, with the kinds of the events.
+func (s *Schedule) sendDigests(digests map[*conf.Notification]map[models.AlertKey][]string, silenced NotificationSilenceTester) {
+	if s.quiet {
+		return
+	}
+	for n, aks := range digests {
+		var incidents []*conf.DigestIncident
+		for ak, events := range aks {
+			if silenced(ak, n.Name) != nil {
+				continue
+			}
+			st, err := s.DataAccess.State().GetLatestIncident(ak)
+			if err != nil {
+				slog.Error(err)
+				continue
+			}
+			if st == nil {
+				continue
+			}
+			sort.Strings(events)
+			incidents = append(incidents, &conf.DigestIncident{IncidentState: st, Events: events})
+		}
+		if len(incidents) == 0 {
+			continue
+		}
+		sort.Slice(incidents, func(i, j int) bool {
+			return incidents[i].Id < incidents[j].Id
+		})
+		end := utcNow().Truncate(n.Digest)
+		s.deliver(n.PrepareDigest(s.SystemConf, end.Add(-n.Digest), end, incidents))
+	}
+}
+
 func (s *Schedule) ActionNotify(at models.ActionType, user, message string, aks []models.AlertKey) error {
 	groupings, err := s.groupActionNotifications(at, aks)
 	if err != nil {
 		return err
 	}
+	digested := false
 	for groupKey, states := range groupings {
 		not := groupKey.notification
+		if not.Digest > 0 {
+			for _, state := range states {
+				s.queueDigest(state.AlertKey, not, digestAction)
+			}
+			digested = true
+			continue
+		}
 		if not.GroupActions == false {
 			for _, state := range states {
-				not.NotifyAction(at, groupKey.template, s.SystemConf, []*models.IncidentState{state}, user, message, s.RuleConf)
+				s.deliver(not.PrepareAction(at, groupKey.template, s.SystemConf, []*models.IncidentState{state}, user, message, s.RuleConf))
 			}
 		} else {
 			incidents := []*models.IncidentState{}
 			incidents = append(incidents, states...)
-			not.NotifyAction(at, groupKey.template, s.SystemConf, incidents, user, message, s.RuleConf)
+			s.deliver(not.PrepareAction(at, groupKey.template, s.SystemConf, incidents, user, message, s.RuleConf))
 		}
 	}
+	if digested {
+		// wake the dispatcher, which may not be due before the digest
+		s.wakeNotifications()
+	}
 	return nil
 }
 
@@ -265,6 +485,7 @@ func (s *Schedule) ActionNotify(at models.ActionType, user, message string, aks
 type notificationGroupKey struct {
 	notification *conf.Notification
 	template     *conf.Template
+	noData       bool
 }
 
 // group by notification and template
@@ -291,7 +512,9 @@ func (s *Schedule) groupActionNotifications(at models.ActionType, aks []models.A
 		if len(nots) == 0 {
 			// legacy behavior. Infer notifications from conf:
 			var n *conf.Notifications
-			if status.WorstStatus == models.StWarning || alert.CritNotification == nil {
+			if len(alert.Severities) > 0 {
+				n, _ = alert.StatusNotifications(status.WorstStatus, status.WorstSeverity)
+			} else if status.WorstStatus == models.StWarning || alert.CritNotification == nil {
 				n = alert.WarnNotification
 			} else {
 				n = alert.CritNotification
@@ -305,7 +528,7 @@ func (s *Schedule) groupActionNotifications(at models.ActionType, aks []models.A
 			if !not.RunOnActionType(at) {
 				continue
 			}
-			key := notificationGroupKey{not, tmpl}
+			key := notificationGroupKey{notification: not, template: tmpl}
 			groupings[key] = append(groupings[key], status)
 		}
 	}
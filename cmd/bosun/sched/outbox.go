@@ -0,0 +1,307 @@
+package sched
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"sort"
+	"time"
+
+	"bosun.org/cmd/bosun/conf"
+	"bosun.org/collect"
+	"bosun.org/metadata"
+	"bosun.org/opentsdb"
+	"bosun.org/slog"
+)
+
+func init() {
+	metadata.AddMetricMeta(
+		"bosun.outbox.retried", metadata.Counter, metadata.PerSecond,
+		"The number of notifications retried from the outbox.")
+	metadata.AddMetricMeta(
+		"bosun.outbox.dead_letters", metadata.Counter, metadata.PerSecond,
+		"The number of notifications moved to the dead letters of the outbox after failing too many times.")
+}
+
+// Every notification is added to the outbox before it is sent, and removed
+// once it is sent. Notifications that fail are retried with exponential
+// backoff, and after too many attempts they are moved to the dead letters,
+// where they can be inspected and replayed.
+const (
+	// outboxInterval is how often the outbox is checked for due retries.
+	outboxInterval = 5 * time.Second
+)
+
+// outboxClaim is how long an entry is claimed by an attempt. An entry is due
+// again after it, so entries of attempts cut short by a restart are retried.
+// The claim is extended while an attempt runs, so slow attempts are not
+// retried while they are still sending.
+var outboxClaim = 2 * time.Minute
+
+// outboxMaxAttempt bounds an attempt. The requests of notifications time out
+// on their own, so it only cuts short attempts that make many slow requests.
+// An attempt cut short fails, and is retried or moved to the dead letters.
+var outboxMaxAttempt = 5 * time.Minute
+
+// OutboxEntry is a notification in the outbox, with one email, http request
+// or transport to send.
+type OutboxEntry struct {
+	Id           int64
+	Notification *conf.PreparedNotifications
+	Attempts     int
+	Created      time.Time
+	NextAttempt  time.Time
+	LastError    string `json:",omitempty"`
+}
+
+// deliver adds each part of pn to the outbox and sends it.
+func (s *Schedule) deliver(pn *conf.PreparedNotifications) {
+	for _, p := range pn.Split() {
+		now := utcNow()
+		e := &OutboxEntry{Notification: p, Created: now, NextAttempt: now.Add(outboxClaim)}
+		b, err := json.Marshal(e)
+		if err == nil {
+			e.Id, err = s.DataAccess.Outbox().AddOutbox(b, e.NextAttempt)
+		}
+		if err != nil {
+			// Sending without retries is better than not sending.
+			slog.Errorf("adding notification %s to the outbox: %v", p.Name, err)
+			go func(p *conf.PreparedNotifications) {
+				p.Send(s.SystemConf)
+				s.recordDeliveries(p)
+			}(p)
+			continue
+		}
+		go s.attemptOutbox(e)
+	}
+}
+
+// attemptOutbox sends an entry of the outbox, and removes it or schedules its
+// next attempt.
+func (s *Schedule) attemptOutbox(e *OutboxEntry) {
+	e.Attempts++
+	s.setHeaders(e.Notification)
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		s.extendOutboxClaim(e.Id, done)
+		close(stopped)
+	}()
+	// The attempt sends a copy, which keeps being written by an attempt that
+	// is cut short.
+	p := *e.Notification
+	sent := make(chan []error, 1)
+	go func() {
+		sent <- p.Send(s.SystemConf)
+	}()
+	var errs []error
+	timeout := time.NewTimer(outboxMaxAttempt)
+	select {
+	case errs = <-sent:
+		timeout.Stop()
+		s.recordDeliveries(&p)
+	case <-timeout.C:
+		errs = []error{fmt.Errorf("attempt cut short after %v", outboxMaxAttempt)}
+		go func() {
+			<-sent
+			s.recordDeliveries(&p)
+		}()
+	}
+	close(done)
+	<-stopped
+	od := s.DataAccess.Outbox()
+	if len(errs) == 0 {
+		if err := od.RemoveOutbox(e.Id); err != nil {
+			slog.Errorf("removing notification %d from the outbox: %v", e.Id, err)
+		}
+		return
+	}
+	e.LastError = errs[0].Error()
+	oc := s.SystemConf.GetOutboxConf()
+	if e.Attempts >= oc.MaxAttempts {
+		slog.Errorf("notification %s (%d) failed %d times, moving it to the dead letters: %s", e.Notification.Name, e.Id, e.Attempts, e.LastError)
+		collect.Add("outbox.dead_letters", opentsdb.TagSet{"notification": e.Notification.Name}, 1)
+		b, err := json.Marshal(e)
+		if err == nil {
+			err = od.AddDeadLetter(e.Id, b)
+		}
+		if err != nil {
+			slog.Errorf("moving notification %d to the dead letters: %v", e.Id, err)
+		}
+		return
+	}
+	e.NextAttempt = utcNow().Add(outboxBackoff(oc.MinBackoff.Duration, oc.MaxBackoff.Duration, e.Attempts))
+	if err := s.updateOutbox(e); err != nil {
+		slog.Errorf("scheduling the retry of notification %d: %v", e.Id, err)
+	}
+}
+
+// setHeaders sets the headers of the notification of p on its http requests,
+// since they are not stored in the outbox.
+func (s *Schedule) setHeaders(p *conf.PreparedNotifications) {
+	if len(p.HTTP) == 0 {
+		return
+	}
+	n := s.RuleConf.GetNotification(p.Name)
+	if n == nil {
+		return
+	}
+	for _, h := range p.HTTP {
+		n.SetHeaders(h)
+	}
+}
+
+// extendOutboxClaim keeps the entry with id claimed until done is closed.
+func (s *Schedule) extendOutboxClaim(id int64, done <-chan struct{}) {
+	ticker := time.NewTicker(outboxClaim / 2)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			if err := s.DataAccess.Outbox().ExtendOutbox(id, utcNow().Add(outboxClaim)); err != nil {
+				slog.Errorf("extending the claim of notification %d of the outbox: %v", id, err)
+			}
+		}
+	}
+}
+
+func (s *Schedule) updateOutbox(e *OutboxEntry) error {
+	b, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	return s.DataAccess.Outbox().UpdateOutbox(e.Id, b, e.NextAttempt)
+}
+
+// outboxBackoff returns the delay before the retry after the given number of
+// attempts. It doubles with each attempt from min up to max, with jitter so
+// that notifications failing together are not retried together.
+func outboxBackoff(min, max time.Duration, attempts int) time.Duration {
+	d := max
+	if attempts < 32 {
+		if b := min << uint(attempts-1); b > 0 && b < max {
+			d = b
+		}
+	}
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+}
+
+// dispatchOutbox retries the due notifications of the outbox until the
+// schedule stops.
+func (s *Schedule) dispatchOutbox() {
+	ticker := time.NewTicker(outboxInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.runnerContext.Done():
+			return
+		case <-ticker.C:
+			s.retryOutbox()
+		}
+	}
+}
+
+func (s *Schedule) retryOutbox() {
+	due, err := s.DataAccess.Outbox().GetDueOutbox(utcNow())
+	if err != nil {
+		slog.Errorf("getting due notifications of the outbox: %v", err)
+		return
+	}
+	for id, b := range due {
+		e := new(OutboxEntry)
+		if err := json.Unmarshal(b, e); err != nil {
+			slog.Errorf("bad outbox entry %d: %v", id, err)
+			continue
+		}
+		e.Id = id
+		e.NextAttempt = utcNow().Add(outboxClaim)
+		if err := s.updateOutbox(e); err != nil {
+			slog.Errorf("claiming notification %d of the outbox: %v", id, err)
+			continue
+		}
+		collect.Add("outbox.retried", opentsdb.TagSet{"notification": e.Notification.Name}, 1)
+		go s.attemptOutbox(e)
+	}
+}
+
+// OutboxView is the content of the outbox.
+type OutboxView struct {
+	Pending     []*OutboxEntry
+	DeadLetters []*OutboxEntry
+}
+
+// Outbox returns the notifications waiting to be sent or retried and the dead
+// letters, by id.
+func (s *Schedule) Outbox() (*OutboxView, error) {
+	od := s.DataAccess.Outbox()
+	pending, err := od.GetOutbox()
+	if err != nil {
+		return nil, err
+	}
+	dead, err := od.GetDeadLetters()
+	if err != nil {
+		return nil, err
+	}
+	return &OutboxView{
+		Pending:     outboxEntries(pending),
+		DeadLetters: outboxEntries(dead),
+	}, nil
+}
+
+func outboxEntries(m map[int64][]byte) []*OutboxEntry {
+	entries := make([]*OutboxEntry, 0, len(m))
+	for id, b := range m {
+		e := new(OutboxEntry)
+		if err := json.Unmarshal(b, e); err != nil {
+			slog.Errorf("bad outbox entry %d: %v", id, err)
+			continue
+		}
+		e.Id = id
+		entries = append(entries, e)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Id < entries[j].Id
+	})
+	return entries
+}
+
+// ReplayOutbox sends a notification of the outbox now. A dead letter is moved
+// back to the outbox with its attempts reset.
+func (s *Schedule) ReplayOutbox(id int64) error {
+	view, err := s.Outbox()
+	if err != nil {
+		return err
+	}
+	for _, e := range view.Pending {
+		if e.Id == id {
+			e.NextAttempt = utcNow().Add(outboxClaim)
+			if err := s.updateOutbox(e); err != nil {
+				return err
+			}
+			go s.attemptOutbox(e)
+			return nil
+		}
+	}
+	for _, e := range view.DeadLetters {
+		if e.Id == id {
+			e.Attempts = 0
+			e.NextAttempt = utcNow().Add(outboxClaim)
+			if err := s.updateOutbox(e); err != nil {
+				return err
+			}
+			if err := s.DataAccess.Outbox().RemoveDeadLetter(id); err != nil {
+				return err
+			}
+			go s.attemptOutbox(e)
+			return nil
+		}
+	}
+	return fmt.Errorf("no notification %d in the outbox", id)
+}
+
+// DeleteDeadLetter removes a dead letter from the outbox.
+func (s *Schedule) DeleteDeadLetter(id int64) error {
+	return s.DataAccess.Outbox().RemoveDeadLetter(id)
+}
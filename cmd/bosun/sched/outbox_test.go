@@ -0,0 +1,202 @@
+package sched
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"bosun.org/cmd/bosun/conf"
+	"bosun.org/cmd/bosun/conf/rule"
+	"bosun.org/util"
+)
+
+func TestOutbox(t *testing.T) {
+	defer setup()()
+	util.InitHostManager("", false) // for the collect metrics
+
+	var fail int32 = 1
+	var missingKey int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Api-Key") != "s3cret" {
+			atomic.AddInt32(&missingKey, 1)
+		}
+		if atomic.LoadInt32(&fail) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer ts.Close()
+
+	c, err := rule.NewConf("", conf.EnabledBackends{}, nil, fmt.Sprintf(`
+		notification n {
+			post = %s
+			header.X-Api-Key = s3cret
+		}
+	`, ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sc := &conf.SystemConf{OutboxConf: conf.OutboxConf{
+		MaxAttempts: 2,
+		MinBackoff:  conf.Duration{Duration: time.Millisecond},
+		MaxBackoff:  conf.Duration{Duration: time.Millisecond},
+	}}
+	s, err := initSched(sc, c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// waitFor polls the outbox until it has the given number of pending
+	// entries and dead letters.
+	waitFor := func(pending, dead int) *OutboxView {
+		var view *OutboxView
+		for i := 0; i < 100; i++ {
+			view, err = s.Outbox()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(view.Pending) == pending && len(view.DeadLetters) == dead && (pending == 0 || view.Pending[0].Attempts > 0) {
+				return view
+			}
+			time.Sleep(20 * time.Millisecond)
+		}
+		t.Fatalf("expected %d pending and %d dead letters, got %d and %d", pending, dead, len(view.Pending), len(view.DeadLetters))
+		return nil
+	}
+
+	n := c.GetNotification("n")
+	details := &conf.NotificationDetails{Ak: []string{"a{host=x}"}, NotifyName: "n"}
+	s.deliver(&conf.PreparedNotifications{Name: "n", HTTP: []*conf.PreparedHttp{n.PrepHttp("POST", ts.URL, "body", details)}})
+
+	// The first attempt fails and is scheduled for a retry.
+	view := waitFor(1, 0)
+	if e := view.Pending[0]; e.Attempts != 1 || e.LastError == "" {
+		t.Fatalf("unexpected entry %+v", e)
+	}
+	// The headers of the notification are not stored in the outbox.
+	stored, err := s.DataAccess.Outbox().GetOutbox()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, b := range stored {
+		if strings.Contains(string(b), "s3cret") {
+			t.Fatalf("the outbox stores the headers of the notification: %s", b)
+		}
+	}
+	time.Sleep(10 * time.Millisecond)
+	s.retryOutbox()
+
+	// The second attempt fails and makes it a dead letter.
+	view = waitFor(0, 1)
+	id := view.DeadLetters[0].Id
+	if view.DeadLetters[0].Attempts != 2 {
+		t.Fatalf("unexpected dead letter %+v", view.DeadLetters[0])
+	}
+
+	// Replaying it once the endpoint is back sends it.
+	atomic.StoreInt32(&fail, 0)
+	if err := s.ReplayOutbox(id); err != nil {
+		t.Fatal(err)
+	}
+	waitFor(0, 0)
+	if err := s.ReplayOutbox(id); err == nil {
+		t.Fatal("expected an error replaying a sent notification")
+	}
+	// The retries sent the headers of the notification.
+	if missingKey != 0 {
+		t.Fatalf("%d attempts were sent without the headers of the notification", missingKey)
+	}
+}
+
+// TestOutboxSlowAttempt tests that an attempt that takes longer than the
+// claim of the outbox is not retried while it runs.
+func TestOutboxSlowAttempt(t *testing.T) {
+	defer setup()()
+	util.InitHostManager("", false) // for the collect metrics
+	defer func(d time.Duration) { outboxClaim = d }(outboxClaim)
+	// The outbox stores due times in seconds.
+	outboxClaim = 2 * time.Second
+
+	var requests int32
+	release := make(chan struct{})
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		<-release
+	}))
+	defer ts.Close()
+	defer close(release)
+	c, err := rule.NewConf("", conf.EnabledBackends{}, nil, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := initSched(&conf.SystemConf{OutboxConf: conf.OutboxConf{MaxAttempts: 2}}, c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	n := &conf.Notification{Name: "n"}
+	details := &conf.NotificationDetails{Ak: []string{"a{host=x}"}, NotifyName: "n"}
+	s.deliver(&conf.PreparedNotifications{Name: "n", HTTP: []*conf.PreparedHttp{n.PrepHttp("POST", ts.URL, "body", details)}})
+	// The attempt runs longer than a claim, so without extending it the
+	// entry would be attempted again.
+	for end := time.Now().Add(outboxClaim * 3 / 2); time.Now().Before(end); {
+		s.retryOutbox()
+		time.Sleep(100 * time.Millisecond)
+	}
+	if n := atomic.LoadInt32(&requests); n != 1 {
+		t.Errorf("expected a single request, got %d", n)
+	}
+}
+
+// TestOutboxAttemptCutShort tests that an attempt that never finishes fails
+// instead of keeping its entry claimed forever.
+func TestOutboxAttemptCutShort(t *testing.T) {
+	defer setup()()
+	util.InitHostManager("", false) // for the collect metrics
+	defer func(d time.Duration) { outboxMaxAttempt = d }(outboxMaxAttempt)
+	outboxMaxAttempt = 100 * time.Millisecond
+
+	release := make(chan struct{})
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer ts.Close()
+	defer close(release)
+	c, err := rule.NewConf("", conf.EnabledBackends{}, nil, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := initSched(&conf.SystemConf{OutboxConf: conf.OutboxConf{MaxAttempts: 2, MinBackoff: conf.Duration{Duration: time.Hour}, MaxBackoff: conf.Duration{Duration: time.Hour}}}, c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	n := &conf.Notification{Name: "n"}
+	details := &conf.NotificationDetails{Ak: []string{"a{host=x}"}, NotifyName: "n"}
+	s.deliver(&conf.PreparedNotifications{Name: "n", HTTP: []*conf.PreparedHttp{n.PrepHttp("POST", ts.URL, "body", details)}})
+	for i := 0; i < 100; i++ {
+		view, err := s.Outbox()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(view.Pending) == 1 && view.Pending[0].Attempts == 1 {
+			if e := view.Pending[0]; !strings.Contains(e.LastError, "cut short") || e.NextAttempt.Before(utcNow().Add(time.Minute)) {
+				t.Fatalf("expected a failed attempt with a retry, got %+v", e)
+			}
+			return
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	t.Fatal("expected the attempt to be cut short")
+}
+
+func TestOutboxBackoff(t *testing.T) {
+	min, max := 10*time.Second, 10*time.Minute
+	for attempts, want := range map[int]time.Duration{1: min, 2: 2 * min, 4: 8 * min, 7: max, 100: max} {
+		for i := 0; i < 10; i++ {
+			if d := outboxBackoff(min, max, attempts); d < want/2 || d > want {
+				t.Errorf("backoff after %d attempts: %v is not between %v and %v", attempts, d, want/2, want)
+			}
+		}
+	}
+}
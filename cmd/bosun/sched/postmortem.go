@@ -0,0 +1,297 @@
+package sched
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"time"
+
+	"bosun.org/cmd/bosun/conf"
+	"bosun.org/cmd/bosun/expr"
+	eparse "bosun.org/cmd/bosun/expr/parse"
+	"bosun.org/models"
+	"bosun.org/opentsdb"
+	"github.com/ryanuber/go-glob"
+)
+
+// PostMortemOptions selects the incidents of a post-mortem: either the
+// incidents with Ids, or the incidents that started between From and To,
+// optionally only those of alerts matching the Alert glob.
+type PostMortemOptions struct {
+	Ids      []int64
+	From, To time.Time
+	Alert    string
+	Graphs   bool
+}
+
+const (
+	maxPostMortemGraphs = 20
+	postMortemGraphW    = 800
+	postMortemGraphH    = 400
+)
+
+// PostMortem builds the skeleton of a post-mortem report for the selected
+// incidents. The notifications in the timeline are the attempts to send them
+// in the delivery log of each incident, so sends older than the log are not
+// included.
+func (s *Schedule) PostMortem(opts PostMortemOptions) (*models.PostMortem, error) {
+	incidents, err := s.postMortemIncidents(opts)
+	if err != nil {
+		return nil, err
+	}
+	if len(incidents) == 0 {
+		return nil, fmt.Errorf("no incidents found")
+	}
+	pm := &models.PostMortem{
+		Generated: utcNow(),
+		From:      opts.From,
+		To:        opts.To,
+	}
+	if len(opts.Ids) > 0 {
+		pm.From, pm.To = incidents[0].Start, incidents[0].Start
+		for _, is := range incidents {
+			end := utcNow()
+			if is.End != nil {
+				end = *is.End
+			}
+			if is.Start.Before(pm.From) {
+				pm.From = is.Start
+			}
+			if end.After(pm.To) {
+				pm.To = end
+			}
+		}
+	}
+	switch {
+	case len(incidents) == 1:
+		pm.Title = fmt.Sprintf("incident #%d %s", incidents[0].Id, incidents[0].AlertKey)
+	case opts.Alert != "":
+		pm.Title = fmt.Sprintf("%d incidents of %s", len(incidents), opts.Alert)
+	default:
+		pm.Title = fmt.Sprintf("%d incidents", len(incidents))
+	}
+	hosts := make(map[string]bool)
+	for _, is := range incidents {
+		pm.Incidents = append(pm.Incidents, &models.PostMortemIncident{
+			Id:            is.Id,
+			AlertKey:      is.AlertKey,
+			Subject:       is.Subject,
+			Start:         is.Start,
+			End:           is.End,
+			Open:          is.Open,
+			WorstStatus:   is.WorstStatus,
+			CurrentStatus: is.CurrentStatus,
+		})
+		a := s.RuleConf.GetAlert(is.Alert)
+		if a == nil {
+			pm.Warnings = append(pm.Warnings, fmt.Sprintf("alert %s of incident #%d is no longer in the configuration, its graphs are not included", is.Alert, is.Id))
+		}
+		entries, err := s.postMortemTimeline(is)
+		if err != nil {
+			pm.Warnings = append(pm.Warnings, fmt.Sprintf("unable to get the notifications of incident #%d: %v", is.Id, err))
+		}
+		pm.Timeline = append(pm.Timeline, entries...)
+		if host := is.AlertKey.Group()["host"]; host != "" {
+			hosts[host] = true
+		}
+		if opts.Graphs && a != nil && len(pm.Graphs) < maxPostMortemGraphs {
+			pm.Graphs = append(pm.Graphs, s.postMortemGraphs(is, a)...)
+		}
+	}
+	if len(pm.Graphs) > maxPostMortemGraphs {
+		pm.Graphs = pm.Graphs[:maxPostMortemGraphs]
+		pm.Warnings = append(pm.Warnings, fmt.Sprintf("only the first %d graphs are included", maxPostMortemGraphs))
+	}
+	sort.SliceStable(pm.Timeline, func(i, j int) bool {
+		return pm.Timeline[i].Time.Before(pm.Timeline[j].Time)
+	})
+	if s.annotate != nil {
+		annotations, err := s.annotate.GetAnnotations(&pm.From, &pm.To)
+		if err != nil {
+			pm.Warnings = append(pm.Warnings, fmt.Sprintf("unable to get annotations: %v", err))
+		}
+		for _, a := range annotations {
+			if a.Host != "" && len(hosts) > 0 && !hosts[a.Host] {
+				continue
+			}
+			pm.Annotations = append(pm.Annotations, &models.PostMortemAnnotation{
+				Start:    a.StartDate.Time,
+				End:      a.EndDate.Time,
+				Host:     a.Host,
+				Category: a.Category,
+				Source:   a.Source,
+				User:     a.CreationUser,
+				Message:  a.Message,
+				Url:      a.Url,
+			})
+		}
+		sort.SliceStable(pm.Annotations, func(i, j int) bool {
+			return pm.Annotations[i].Start.Before(pm.Annotations[j].Start)
+		})
+	}
+	return pm, nil
+}
+
+func (s *Schedule) postMortemIncidents(opts PostMortemOptions) ([]*models.IncidentState, error) {
+	var incidents []*models.IncidentState
+	if len(opts.Ids) > 0 {
+		for _, id := range opts.Ids {
+			is, err := s.DataAccess.State().GetIncidentState(id)
+			if err != nil {
+				return nil, fmt.Errorf("incident %d: %v", id, err)
+			}
+			incidents = append(incidents, is)
+		}
+	} else {
+		if opts.From.IsZero() || opts.To.IsZero() {
+			return nil, fmt.Errorf("incident ids or a time range are required")
+		}
+		all, err := s.DataAccess.State().GetIncidentsStartingInRange(opts.From, opts.To)
+		if err != nil {
+			return nil, err
+		}
+		for _, is := range all {
+			if opts.Alert == "" || glob.Glob(opts.Alert, is.Alert) {
+				incidents = append(incidents, is)
+			}
+		}
+	}
+	sort.Slice(incidents, func(i, j int) bool {
+		if !incidents[i].Start.Equal(incidents[j].Start) {
+			return incidents[i].Start.Before(incidents[j].Start)
+		}
+		return incidents[i].Id < incidents[j].Id
+	})
+	return incidents, nil
+}
+
+// postMortemTimeline returns the status changes and actions of an incident,
+// and the attempts to send its notifications from the delivery log.
+func (s *Schedule) postMortemTimeline(is *models.IncidentState) ([]*models.PostMortemEntry, error) {
+	var entries []*models.PostMortemEntry
+	for _, ev := range is.Events {
+		entries = append(entries, &models.PostMortemEntry{
+			Time:       ev.Time,
+			Kind:       "status",
+			IncidentId: is.Id,
+			AlertKey:   is.AlertKey,
+			Status:     ev.Status,
+			Severity:   ev.Severity,
+		})
+	}
+	for _, action := range is.Actions {
+		entries = append(entries, &models.PostMortemEntry{
+			Time:       action.Time,
+			Kind:       "action",
+			IncidentId: is.Id,
+			AlertKey:   is.AlertKey,
+			Action:     action.Type,
+			User:       action.User,
+			Message:    action.Message,
+		})
+	}
+	deliveries, err := s.Deliveries(is.Id)
+	if err != nil {
+		return entries, err
+	}
+	for _, d := range deliveries {
+		entries = append(entries, &models.PostMortemEntry{
+			Time:          d.Time,
+			Kind:          "notification",
+			IncidentId:    is.Id,
+			AlertKey:      is.AlertKey,
+			Notifications: []string{d.Notification},
+			Delivery:      d,
+		})
+	}
+	return entries, nil
+}
+
+// postMortemGraphs graphs the series queried by the crit and warn expressions
+// of a, for the group of the incident, as they were when the incident was
+// last abnormal.
+func (s *Schedule) postMortemGraphs(is *models.IncidentState, a *conf.Alert) []*models.PostMortemGraph {
+	at := is.LastAbnormalTime.Time
+	if at.IsZero() {
+		at = is.Start
+	}
+	group := is.AlertKey.Group()
+	var graphs []*models.PostMortemGraph
+	seen := make(map[string]bool)
+	for _, e := range a.Exprs() {
+		if e == nil {
+			continue
+		}
+		for _, n := range seriesNodes(e.Root) {
+			text := opentsdb.ReplaceTags(n.String(), group)
+			if seen[text] {
+				continue
+			}
+			seen[text] = true
+			g := &models.PostMortemGraph{
+				IncidentId: is.Id,
+				AlertKey:   is.AlertKey,
+				Expr:       text,
+				Time:       at,
+			}
+			png, err := s.postMortemGraph(text, at, group, a)
+			if err != nil {
+				g.Error = err.Error()
+			}
+			g.PNG = png
+			graphs = append(graphs, g)
+		}
+	}
+	return graphs
+}
+
+func (s *Schedule) postMortemGraph(text string, at time.Time, group opentsdb.TagSet, a *conf.Alert) ([]byte, error) {
+	e, err := expr.New(text, s.RuleConf.GetFuncs(s.SystemConf.EnabledBackends()))
+	if err != nil {
+		return nil, err
+	}
+	rh := s.NewRunHistory(at, nil)
+	providers := &expr.BosunProviders{
+		Search:    s.Search,
+		Squelched: s.RuleConf.AlertSquelched(a),
+		History:   s,
+		Annotate:  s.annotate,
+	}
+	origin := fmt.Sprintf("Post-mortem: Alert Key: %v", models.NewAlertKey(a.Name, group))
+	res, _, err := e.Execute(rh.Backends, providers, nil, at, 1000, a.UnjoinedOK, origin)
+	if err != nil {
+		return nil, err
+	}
+	results := res.Results.Filter(group)
+	if len(results) == 0 {
+		return nil, fmt.Errorf("no data")
+	}
+	var buf bytes.Buffer
+	if err := s.ExprPNG(nil, &buf, postMortemGraphW, postMortemGraphH, "", results); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// seriesNodes returns the outermost nodes of n that return a series set,
+// usually the queries of an alert expression.
+func seriesNodes(n eparse.Node) []eparse.Node {
+	if n.Return() == models.TypeSeriesSet {
+		return []eparse.Node{n}
+	}
+	var nodes []eparse.Node
+	switch n := n.(type) {
+	case *eparse.FuncNode:
+		for _, arg := range n.Args {
+			nodes = append(nodes, seriesNodes(arg)...)
+		}
+	case *eparse.BinaryNode:
+		nodes = append(nodes, seriesNodes(n.Args[0])...)
+		nodes = append(nodes, seriesNodes(n.Args[1])...)
+	case *eparse.UnaryNode:
+		nodes = append(nodes, seriesNodes(n.Arg)...)
+	case *eparse.PrefixNode:
+		nodes = append(nodes, seriesNodes(n.Arg)...)
+	}
+	return nodes
+}
@@ -0,0 +1,84 @@
+package sched
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"bosun.org/models"
+	"bosun.org/opentsdb"
+)
+
+func TestPostMortem(t *testing.T) {
+	defer setup()()
+	s := testSched(t, &schedTest{
+		conf: `alert a {
+			crit = avg(q("avg:m{a=*}", "5m", "")) > 0
+		}`,
+		queries: map[string]opentsdb.ResponseSet{
+			`q("avg:m{a=*}", ` + window5Min + `)`: {
+				{
+					Metric: "m",
+					Tags:   opentsdb.TagSet{"a": "b"},
+					DPS:    map[string]opentsdb.Point{"0": 1},
+				},
+			},
+		},
+		state: map[schedState]bool{
+			{"a{a=b}", "critical"}: true,
+		},
+	})
+	ak := models.AlertKey("a{a=b}")
+	if err := s.ActionByAlertKey("alice", "looking", models.ActionAcknowledge, nil, ak); err != nil {
+		t.Fatal(err)
+	}
+	open, err := s.DataAccess.State().GetAllOpenIncidents()
+	if err != nil || len(open) != 1 {
+		t.Fatalf("expected one open incident, got %v, %v", open, err)
+	}
+	d := &models.DeliveryAttempt{Time: queryTime, Notification: "ops", Transport: "email", Destination: "ops@example.com"}
+	if err := s.DataAccess.Deliveries().AddDelivery(open[0].Id, d); err != nil {
+		t.Fatal(err)
+	}
+
+	byId, err := s.PostMortem(PostMortemOptions{Ids: []int64{open[0].Id}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	byRange, err := s.PostMortem(PostMortemOptions{From: queryTime.Add(-time.Hour), To: time.Now().UTC().Add(time.Hour), Alert: "a*"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, pm := range []*models.PostMortem{byId, byRange} {
+		if len(pm.Incidents) != 1 || pm.Incidents[0].AlertKey != ak {
+			t.Fatalf("unexpected incidents: %+v", pm.Incidents)
+		}
+		kinds := make(map[string]int)
+		for _, e := range pm.Timeline {
+			kinds[e.Kind]++
+			if e.Kind == "action" && (e.User != "alice" || e.Action != models.ActionAcknowledge) {
+				t.Errorf("unexpected action entry: %+v", e)
+			}
+			if e.Kind == "notification" && (e.Delivery == nil || e.Delivery.Destination != d.Destination) {
+				t.Errorf("unexpected notification entry: %+v", e)
+			}
+		}
+		if kinds["status"] != 1 || kinds["action"] != 1 || kinds["notification"] != 1 {
+			t.Errorf("unexpected timeline: %v", kinds)
+		}
+	}
+	if _, err := s.PostMortem(PostMortemOptions{From: queryTime.Add(-time.Hour), To: time.Now().UTC().Add(time.Hour), Alert: "b*"}); err == nil {
+		t.Error("expected no incidents for b*")
+	}
+
+	buf := new(bytes.Buffer)
+	if err := byId.WriteMarkdown(buf, nil); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"## Timeline", "Acknowledged by alice: looking", "notification ops sent to ops@example.com", "## Action items"} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("markdown is missing %q:\n%s", want, buf)
+		}
+	}
+}
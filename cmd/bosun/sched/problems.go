@@ -0,0 +1,308 @@
+package sched
+
+import (
+	"crypto/sha1"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"bosun.org/cmd/bosun/conf"
+	"bosun.org/models"
+	"bosun.org/opentsdb"
+)
+
+// Problem is a group of open incidents that were correlated by a
+// correlation rule. Problems are not stored, they are computed from the
+// open incidents whenever they are requested.
+type Problem struct {
+	Id             string
+	Correlation    string
+	Tags           opentsdb.TagSet
+	TagsString     string
+	Start          int64
+	LastStart      int64
+	RootIncidentId int64
+	Alerts         []string
+	CurrentStatus  models.Status
+	WorstStatus    models.Status
+	NeedAck        bool
+	Incidents      []*IncidentSummaryView
+}
+
+// IncidentIds returns the ids of all incidents in the problem.
+func (p *Problem) IncidentIds() []int64 {
+	ids := make([]int64, len(p.Incidents))
+	for i, is := range p.Incidents {
+		ids[i] = is.Id
+	}
+	return ids
+}
+
+// Problems groups the currently open incidents into problems using the
+// correlation rules of the rule configuration. An incident belongs to at most
+// one problem; rules are applied in name order and the first match wins.
+func (s *Schedule) Problems() ([]*Problem, error) {
+	incidents, err := s.DataAccess.State().GetAllOpenIncidents()
+	if err != nil {
+		return nil, err
+	}
+	silenced := s.Silenced()
+	if silenced == nil {
+		return nil, fmt.Errorf("failed to get silences")
+	}
+	return correlate(s.RuleConf, silenced, incidents), nil
+}
+
+// GetProblem returns the open problem with the given id.
+func (s *Schedule) GetProblem(id string) (*Problem, error) {
+	problems, err := s.Problems()
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range problems {
+		if p.Id == id {
+			return p, nil
+		}
+	}
+	return nil, fmt.Errorf("no open problem with id: %v", id)
+}
+
+// ActionByProblemId performs the action on every incident of the problem
+// via ActionByIncidentId. Acknowledging a problem skips incidents that are
+// already acknowledged. The alert keys of the incidents that were acted on
+// are returned along with an error describing any incidents that failed.
+func (s *Schedule) ActionByProblemId(user, message string, t models.ActionType, at *time.Time, id string) ([]models.AlertKey, error) {
+	p, err := s.GetProblem(id)
+	if err != nil {
+		return nil, err
+	}
+	successful := []models.AlertKey{}
+	var errs []string
+	for _, is := range p.Incidents {
+		if t == models.ActionAcknowledge && !is.NeedAck {
+			continue
+		}
+		ak, err := s.ActionByIncidentId(user, message, t, at, is.Id)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("incident %d: %v", is.Id, err))
+			continue
+		}
+		successful = append(successful, ak)
+	}
+	if len(errs) != 0 {
+		return successful, fmt.Errorf("problem %s: %s", id, strings.Join(errs, "; "))
+	}
+	return successful, nil
+}
+
+func correlate(c conf.RuleConfProvider, silenced SilenceTester, incidents []*models.IncidentState) []*Problem {
+	correlations := c.GetCorrelations()
+	names := make([]string, 0, len(correlations))
+	for name := range correlations {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	sort.Slice(incidents, func(i, j int) bool {
+		if incidents[i].Start.Equal(incidents[j].Start) {
+			return incidents[i].Id < incidents[j].Id
+		}
+		return incidents[i].Start.Before(incidents[j].Start)
+	})
+	used := make(map[int64]bool)
+	problems := []*Problem{}
+	for _, name := range names {
+		cr := correlations[name]
+		var candidates []*models.IncidentState
+		var keys []string
+	Loop:
+		for _, is := range incidents {
+			if used[is.Id] || !cr.Matches(is.AlertKey.Name()) {
+				continue
+			}
+			tags := is.AlertKey.Group()
+			key := make(opentsdb.TagSet)
+			for _, k := range cr.Tags {
+				v, ok := tags[k]
+				if !ok {
+					continue Loop
+				}
+				key[k] = v
+			}
+			candidates = append(candidates, is)
+			keys = append(keys, key.String())
+		}
+		var dependents map[int64]bool
+		if cr.Depends {
+			var chains map[int64]int64
+			chains, dependents = dependencyChains(c, candidates)
+			for i, is := range candidates {
+				keys[i] += fmt.Sprintf("|%d", chains[is.Id])
+			}
+		}
+		groups := make(map[string][]*models.IncidentState)
+		var groupOrder []string
+		for i, is := range candidates {
+			ks := keys[i]
+			if _, ok := groups[ks]; !ok {
+				groupOrder = append(groupOrder, ks)
+			}
+			groups[ks] = append(groups[ks], is)
+		}
+		for _, ks := range groupOrder {
+			for _, cluster := range clusterByStart(groups[ks], cr.Window) {
+				p := makeProblem(c, silenced, cr, cluster, dependents)
+				if p == nil {
+					continue
+				}
+				for _, is := range cluster {
+					used[is.Id] = true
+				}
+				problems = append(problems, p)
+			}
+		}
+	}
+	sort.Slice(problems, func(i, j int) bool {
+		if problems[i].WorstStatus != problems[j].WorstStatus {
+			return problems[i].WorstStatus > problems[j].WorstStatus
+		}
+		return problems[i].Start < problems[j].Start
+	})
+	return problems
+}
+
+// dependencyChains links each incident to the incidents of the alerts it
+// depends on through the depends expression of its alert, when their alert
+// keys are covered by the dependency. It returns the chain of each incident,
+// as the id of one of its incidents, and the incidents that depend on
+// another incident.
+func dependencyChains(c conf.RuleConfProvider, incidents []*models.IncidentState) (chains map[int64]int64, dependents map[int64]bool) {
+	chains = make(map[int64]int64, len(incidents))
+	dependents = make(map[int64]bool)
+	var find func(id int64) int64
+	find = func(id int64) int64 {
+		if chains[id] == id {
+			return id
+		}
+		root := find(chains[id])
+		chains[id] = root
+		return root
+	}
+	byAlert := make(map[string][]*models.IncidentState)
+	for _, is := range incidents {
+		chains[is.Id] = is.Id
+		byAlert[is.AlertKey.Name()] = append(byAlert[is.AlertKey.Name()], is)
+	}
+	for _, is := range incidents {
+		a := c.GetAlert(is.AlertKey.Name())
+		if a == nil {
+			continue
+		}
+		for _, d := range a.Dependencies {
+			if d.Kind != "depends" {
+				continue
+			}
+			for _, cause := range byAlert[d.Alert] {
+				if cause.Id == is.Id || !d.Covers(cause.AlertKey.Group(), is.AlertKey.Group()) {
+					continue
+				}
+				dependents[is.Id] = true
+				chains[find(is.Id)] = find(cause.Id)
+			}
+		}
+	}
+	for _, is := range incidents {
+		chains[is.Id] = find(is.Id)
+	}
+	return chains, dependents
+}
+
+// clusterByStart splits incidents sorted by start time into clusters where
+// each incident started within window of the previous one. A zero window
+// puts all incidents into one cluster.
+func clusterByStart(incidents []*models.IncidentState, window time.Duration) [][]*models.IncidentState {
+	var clusters [][]*models.IncidentState
+	var current []*models.IncidentState
+	for _, is := range incidents {
+		if len(current) > 0 && window > 0 && is.Start.Sub(current[len(current)-1].Start) > window {
+			clusters = append(clusters, current)
+			current = nil
+		}
+		current = append(current, is)
+	}
+	if len(current) > 0 {
+		clusters = append(clusters, current)
+	}
+	return clusters
+}
+
+// makeProblem makes a problem of a cluster of incidents. Its root is the
+// incident of the root alert, or else the first incident that does not depend
+// on another.
+func makeProblem(c conf.RuleConfProvider, silenced SilenceTester, cr *conf.Correlation, cluster []*models.IncidentState, dependents map[int64]bool) *Problem {
+	if len(cluster) < cr.MinIncidents {
+		return nil
+	}
+	var root *models.IncidentState
+	if cr.Root != "" {
+		for _, is := range cluster {
+			if is.AlertKey.Name() == cr.Root {
+				root = is
+				break
+			}
+		}
+		if root == nil {
+			return nil
+		}
+	} else {
+		root = cluster[0]
+		for _, is := range cluster {
+			if !dependents[is.Id] {
+				root = is
+				break
+			}
+		}
+	}
+	p := &Problem{
+		Correlation:    cr.Name,
+		Tags:           make(opentsdb.TagSet),
+		Start:          cluster[0].Start.Unix(),
+		LastStart:      cluster[len(cluster)-1].Start.Unix(),
+		RootIncidentId: root.Id,
+	}
+	rootTags := root.AlertKey.Group()
+	for _, k := range cr.Tags {
+		p.Tags[k] = rootTags[k]
+	}
+	p.TagsString = p.Tags.String()
+	seenAlerts := make(map[string]bool)
+	for _, is := range cluster {
+		view, err := MakeIncidentSummary(c, silenced, is)
+		if err != nil {
+			// The alert no longer exists in the configuration.
+			continue
+		}
+		p.Incidents = append(p.Incidents, view)
+		if !seenAlerts[view.AlertName] {
+			seenAlerts[view.AlertName] = true
+			p.Alerts = append(p.Alerts, view.AlertName)
+		}
+		if is.CurrentStatus > p.CurrentStatus {
+			p.CurrentStatus = is.CurrentStatus
+		}
+		if is.WorstStatus > p.WorstStatus {
+			p.WorstStatus = is.WorstStatus
+		}
+		if is.NeedAck {
+			p.NeedAck = true
+		}
+	}
+	if len(p.Incidents) < cr.MinIncidents {
+		return nil
+	}
+	sort.Strings(p.Alerts)
+	h := sha1.New()
+	fmt.Fprintf(h, "%s|%s|%d", cr.Name, p.TagsString, root.Id)
+	p.Id = fmt.Sprintf("%x", h.Sum(nil))[:16]
+	return p
+}
@@ -0,0 +1,138 @@
+package sched
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"bosun.org/cmd/bosun/conf"
+	"bosun.org/cmd/bosun/conf/rule"
+	"bosun.org/models"
+)
+
+func TestCorrelate(t *testing.T) {
+	c, err := rule.NewConf("", conf.EnabledBackends{}, nil, `
+		alert switch.down {
+			crit = 1
+		}
+		alert ping {
+			crit = 1
+		}
+		alert os.cpu {
+			crit = 1
+		}
+		correlation switch {
+			alerts = ping,os.*
+			tags = switch
+			window = 5m
+			root = switch.down
+		}
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC)
+	incident := func(id int64, ak string, offset time.Duration) *models.IncidentState {
+		return &models.IncidentState{
+			Id:            id,
+			AlertKey:      models.AlertKey(ak),
+			Alert:         models.AlertKey(ak).Name(),
+			Start:         start.Add(offset),
+			Open:          true,
+			NeedAck:       true,
+			CurrentStatus: models.StCritical,
+			WorstStatus:   models.StCritical,
+			Events:        []models.Event{{Status: models.StCritical, Time: start.Add(offset)}},
+		}
+	}
+	incidents := []*models.IncidentState{
+		incident(1, "switch.down{switch=sw1}", 0),
+		incident(2, "ping{host=a,switch=sw1}", time.Minute),
+		incident(3, "os.cpu{host=b,switch=sw1}", 2*time.Minute),
+		// too late for the window
+		incident(4, "ping{host=c,switch=sw1}", time.Hour),
+		// different switch without a root incident
+		incident(5, "ping{host=d,switch=sw2}", 0),
+		incident(6, "ping{host=e,switch=sw2}", 0),
+		// no switch tag
+		incident(7, "ping{host=f}", 0),
+	}
+	silenced := func(models.AlertKey) *models.Silence { return nil }
+	problems := correlate(c, silenced, incidents)
+	if len(problems) != 1 {
+		t.Fatalf("expected 1 problem, got %d", len(problems))
+	}
+	p := problems[0]
+	if p.RootIncidentId != 1 {
+		t.Errorf("expected root incident 1, got %d", p.RootIncidentId)
+	}
+	ids := p.IncidentIds()
+	if len(ids) != 3 || ids[0] != 1 || ids[1] != 2 || ids[2] != 3 {
+		t.Errorf("unexpected incidents in problem: %v", ids)
+	}
+	if p.TagsString != "{switch=sw1}" {
+		t.Errorf("unexpected problem tags: %s", p.TagsString)
+	}
+	if p.Id == "" || p.Id != correlate(c, silenced, incidents)[0].Id {
+		t.Errorf("expected stable problem id, got %q", p.Id)
+	}
+}
+
+func TestCorrelateDepends(t *testing.T) {
+	c, err := rule.NewConf("", conf.EnabledBackends{OpenTSDB: true}, nil, `
+		alert switch.down {
+			crit = avg(q("avg:switch.status{switch=*}", "5m", "")) > 0
+		}
+		alert host.down {
+			depends = dependsOnAlert("switch.down", "switch=*")
+			crit = avg(q("avg:host.status{host=*,switch=*}", "5m", "")) > 0
+		}
+		alert os.cpu {
+			depends = dependsOnAlert("host.down", "host=*")
+			crit = avg(q("avg:os.cpu{host=*}", "5m", "")) > 0
+		}
+		correlation chains {
+			depends = true
+		}
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC)
+	incident := func(id int64, ak string) *models.IncidentState {
+		return &models.IncidentState{
+			Id:            id,
+			AlertKey:      models.AlertKey(ak),
+			Alert:         models.AlertKey(ak).Name(),
+			Start:         start.Add(time.Duration(id) * time.Minute),
+			Open:          true,
+			CurrentStatus: models.StCritical,
+			WorstStatus:   models.StCritical,
+		}
+	}
+	incidents := []*models.IncidentState{
+		incident(1, "os.cpu{host=a}"),
+		incident(2, "switch.down{switch=sw1}"),
+		incident(3, "host.down{host=a,switch=sw1}"),
+		// depends on a switch without an incident
+		incident(4, "host.down{host=b,switch=sw2}"),
+		incident(5, "os.cpu{host=b}"),
+		// depends on a host without an incident
+		incident(6, "os.cpu{host=c}"),
+	}
+	silenced := func(models.AlertKey) *models.Silence { return nil }
+	problems := correlate(c, silenced, incidents)
+	if len(problems) != 2 {
+		t.Fatalf("expected 2 problems, got %d", len(problems))
+	}
+	expected := [][]int64{{1, 2, 3}, {4, 5}}
+	for i, p := range problems {
+		ids := p.IncidentIds()
+		if fmt.Sprint(ids) != fmt.Sprint(expected[i]) {
+			t.Errorf("problem %d: expected incidents %v, got %v", i, expected[i], ids)
+		}
+	}
+	if problems[0].RootIncidentId != 2 || problems[1].RootIncidentId != 4 {
+		t.Errorf("expected root incidents 2 and 4, got %d and %d", problems[0].RootIncidentId, problems[1].RootIncidentId)
+	}
+}
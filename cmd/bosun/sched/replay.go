@@ -0,0 +1,470 @@
+package sched
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"bosun.org/cmd/bosun/cache"
+	"bosun.org/cmd/bosun/conf"
+	"bosun.org/cmd/bosun/database"
+	"bosun.org/cmd/bosun/search"
+	"bosun.org/models"
+)
+
+// maxReplayIntervals bounds the number of check intervals of a replay.
+const maxReplayIntervals = 10000
+
+// ReplayOptions describes which alerts to replay and over what time range.
+type ReplayOptions struct {
+	// Alerts to replay. All alerts of the rule configuration are replayed
+	// when empty. The alerts they depend on are replayed as well, and are
+	// always checked first.
+	Alerts []string
+	From   time.Time
+	To     time.Time
+	// Step is the check frequency of the replay. It defaults to the check
+	// frequency of the system configuration. Alerts with runEvery only run
+	// on every runEvery'th step, as they would in the scheduler.
+	Step time.Duration
+	// CloseOnNormal closes incidents as soon as they return to normal, as if
+	// somebody closed them right away. Without it an alert that flaps keeps
+	// a single incident open for the whole replay.
+	CloseOnNormal bool
+}
+
+// ReplayResult is the outcome of a replay.
+type ReplayResult struct {
+	From          time.Time
+	To            time.Time
+	Step          time.Duration
+	Intervals     int
+	Alerts        []string
+	Timeline      []*ReplayEvent
+	Notifications []*ReplayNotification
+	Incidents     []*ReplayIncident
+	Errors        []*ReplayError
+	Summary       ReplaySummary
+}
+
+// ReplayEvent is a status change of an alert key during a replay.
+type ReplayEvent struct {
+	Time        time.Time
+	AlertKey    models.AlertKey
+	Status      models.Status
+	Previous    models.Status
+	Unevaluated bool `json:",omitempty"`
+	IncidentId  int64
+}
+
+// ReplayNotification is a notification that would have been sent during a
+// replay, with the templates rendered at that time. Unknown notifications
+// are grouped when they are sent for real, so they are not rendered.
+type ReplayNotification struct {
+	Time         time.Time
+	Notification string
+	AlertKey     models.AlertKey
+	IncidentId   int64
+	Status       models.Status
+	Subject      string
+	Chained      bool                        `json:",omitempty"`
+	Prepared     *conf.PreparedNotifications `json:",omitempty"`
+}
+
+// ReplayIncident is an incident that was opened during a replay.
+type ReplayIncident struct {
+	Id            int64
+	AlertKey      models.AlertKey
+	Start         time.Time
+	End           *time.Time `json:",omitempty"`
+	Open          bool
+	CurrentStatus models.Status
+	WorstStatus   models.Status
+	Subject       string
+}
+
+// ReplayError is an error that occurred while checking an alert.
+type ReplayError struct {
+	Time    time.Time
+	Alert   string
+	Message string
+}
+
+// ReplaySummary counts the incidents and notifications of a replay.
+type ReplaySummary struct {
+	Opened        int
+	Closed        int
+	Open          int
+	Notifications int
+	WorstStatus   map[string]int
+}
+
+type replayQueued struct {
+	at time.Time
+	ak models.AlertKey
+	n  *conf.Notification
+}
+
+// Replay runs alerts over every interval of a historical time range. It
+// uses a new schedule backed by an in-memory data store, so the real
+// incidents, silences and notifications are never touched, and nothing is
+// sent. Silences are ignored. Alert keys are processed in a fixed order so
+// the same data always produces the same result.
+func Replay(sc conf.SystemConfProvider, rc conf.RuleConfProvider, idx *search.Search, opts ReplayOptions) (*ReplayResult, error) {
+	if opts.To.Before(opts.From) {
+		return nil, fmt.Errorf("replay: from must be before to")
+	}
+	step := opts.Step
+	if step == 0 {
+		step = sc.GetCheckFrequency()
+	}
+	if step <= 0 {
+		return nil, fmt.Errorf("replay: step must be positive")
+	}
+	intervals := int(opts.To.Sub(opts.From)/step) + 1
+	if intervals > maxReplayIntervals {
+		return nil, fmt.Errorf("replay: %d intervals is more than the maximum of %d", intervals, maxReplayIntervals)
+	}
+	alerts, err := replayAlerts(rc, opts.Alerts)
+	if err != nil {
+		return nil, err
+	}
+	da, stop, err := database.NewMemoryDataAccess()
+	if err != nil {
+		return nil, err
+	}
+	defer stop()
+	s := &Schedule{Search: idx}
+	if err := s.Init("replay", sc, rc, da, nil, true, true); err != nil {
+		return nil, err
+	}
+	res := &ReplayResult{
+		From:          opts.From.UTC(),
+		To:            opts.To.UTC(),
+		Step:          step,
+		Intervals:     intervals,
+		Timeline:      []*ReplayEvent{},
+		Notifications: []*ReplayNotification{},
+		Incidents:     []*ReplayIncident{},
+		Errors:        []*ReplayError{},
+		Summary:       ReplaySummary{WorstStatus: make(map[string]int)},
+	}
+	for _, a := range alerts {
+		res.Alerts = append(res.Alerts, a.Name)
+	}
+	notSilenced := func(models.AlertKey) *models.Silence { return nil }
+	c := cache.New("replay", 0)
+	last := make(map[models.AlertKey]models.Event)
+	var queued []*replayQueued
+	for i := 0; i < intervals; i++ {
+		t := res.From.Add(step * time.Duration(i))
+		queued = s.replayChained(res, t, queued)
+		if err := s.replayEscalations(res, t); err != nil {
+			return nil, err
+		}
+		for _, a := range alerts {
+			runEvery := a.RunEvery
+			if runEvery == 0 {
+				runEvery = sc.GetDefaultRunEvery()
+			}
+			if runEvery > 1 && i%runEvery != 0 {
+				continue
+			}
+			rh := s.NewRunHistory(t, c)
+			if s.CheckAlert(nil, rh, a) {
+				return nil, fmt.Errorf("replay: cancelled")
+			}
+			if !s.AlertSuccessful(a.Name) {
+				res.Errors = append(res.Errors, &ReplayError{Time: t, Alert: a.Name, Message: s.lastAlertError(a.Name)})
+				if err := da.Errors().ClearAlert(a.Name); err != nil {
+					return nil, err
+				}
+			}
+			keys := make(models.AlertKeys, 0, len(rh.Events))
+			for ak := range rh.Events {
+				keys = append(keys, ak)
+			}
+			sort.Sort(keys)
+			for _, ak := range keys {
+				ev := rh.Events[ak]
+				if _, err := s.runHistory(rh, ak, ev, notSilenced); err != nil {
+					return nil, err
+				}
+				incident, err := da.State().GetLatestIncident(ak)
+				if err != nil {
+					return nil, err
+				}
+				var id int64
+				if incident != nil {
+					id = incident.Id
+				}
+				// Alert keys are considered normal until their first event.
+				prev, seen := last[ak]
+				if !seen {
+					prev.Status = models.StNormal
+				}
+				if ev.Status != prev.Status || ev.Unevaluated != prev.Unevaluated {
+					res.Timeline = append(res.Timeline, &ReplayEvent{
+						Time:        t,
+						AlertKey:    ak,
+						Status:      ev.Status,
+						Previous:    prev.Status,
+						Unevaluated: ev.Unevaluated,
+						IncidentId:  id,
+					})
+				}
+				last[ak] = models.Event{Status: ev.Status, Unevaluated: ev.Unevaluated}
+				if opts.CloseOnNormal && incident != nil && incident.Open && ev.Status == models.StNormal {
+					if err := s.replayClose(incident, t); err != nil {
+						return nil, err
+					}
+				}
+			}
+		}
+		queued = append(queued, s.replayPending(res, t)...)
+	}
+	if err := s.replayIncidents(res, last); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// replayAlerts returns the named alerts, or all alerts, and the alerts
+// they depend on, ordered so that each alert comes after its dependencies.
+func replayAlerts(rc conf.RuleConfProvider, names []string) ([]*conf.Alert, error) {
+	names = append([]string{}, names...)
+	if len(names) == 0 {
+		for name := range rc.GetAlerts() {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	var ordered []*conf.Alert
+	done := make(map[string]bool)
+	var visit func(name string) error
+	visit = func(name string) error {
+		if done[name] {
+			return nil
+		}
+		a := rc.GetAlert(name)
+		if a == nil {
+			return fmt.Errorf("replay: unknown alert %s", name)
+		}
+		// Dependency cycles are rejected when the configuration is loaded.
+		done[name] = true
+		for _, d := range a.Dependencies {
+			if err := visit(d.Alert); err != nil {
+				return err
+			}
+		}
+		ordered = append(ordered, a)
+		return nil
+	}
+	for _, name := range names {
+		if err := visit(name); err != nil {
+			return nil, err
+		}
+	}
+	return ordered, nil
+}
+
+func (s *Schedule) lastAlertError(name string) string {
+	history, err := s.DataAccess.Errors().GetFullErrorHistory()
+	if err != nil || len(history[name]) == 0 {
+		return "unknown error"
+	}
+	return history[name][0].Message
+}
+
+// replayClose closes an incident at time t without the wall clock times the
+// close action would record.
+func (s *Schedule) replayClose(incident *models.IncidentState, t time.Time) error {
+	end := t
+	incident.Open = false
+	incident.NeedAck = false
+	incident.End = &end
+	incident.Actions = append(incident.Actions, models.Action{
+		User:    "replay",
+		Message: "closed by replay because the alert returned to normal",
+		Time:    t,
+		Type:    models.ActionClose,
+	})
+	_, err := s.DataAccess.State().UpdateIncidentState(incident)
+	return err
+}
+
+// replayPending records the notifications runHistory queued at time t and
+// returns the chained notifications to send later.
+func (s *Schedule) replayPending(res *ReplayResult, t time.Time) []*replayQueued {
+	var queued []*replayQueued
+	var sent []*ReplayNotification
+	for n, states := range s.pendingNotifications {
+		for _, st := range states {
+			sent = append(sent, replayNotification(t, n, st.IncidentState, st.RenderedTemplates, false))
+			if n.Next != nil {
+				queued = append(queued, &replayQueued{at: t.Add(n.Timeout), ak: st.AlertKey, n: n.Next})
+			}
+		}
+	}
+	s.pendingNotifications = nil
+	sortReplayNotifications(sent)
+	res.Notifications = append(res.Notifications, sent...)
+	res.Summary.Notifications += len(sent)
+	return queued
+}
+
+// replayChained sends the queued chained notifications that are due at t
+// whose incidents still need an acknowledgement. It returns the notifications
+// that are still queued.
+func (s *Schedule) replayChained(res *ReplayResult, t time.Time, queued []*replayQueued) []*replayQueued {
+	var remaining []*replayQueued
+	var sent []*ReplayNotification
+	for len(queued) > 0 {
+		q := queued[0]
+		queued = queued[1:]
+		if q.at.After(t) {
+			remaining = append(remaining, q)
+			continue
+		}
+		st, err := s.DataAccess.State().GetLatestIncident(q.ak)
+		if err != nil || st == nil || !st.Open || !st.NeedAck {
+			continue
+		}
+		rt, err := s.DataAccess.State().GetRenderedTemplates(st.Id)
+		if err != nil {
+			rt = nil
+		}
+		sent = append(sent, replayNotification(q.at, q.n, st, rt, true))
+		if q.n.Next != nil {
+			queued = append(queued, &replayQueued{at: q.at.Add(q.n.Timeout), ak: q.ak, n: q.n.Next})
+		}
+	}
+	sortReplayNotifications(sent)
+	res.Notifications = append(res.Notifications, sent...)
+	res.Summary.Notifications += len(sent)
+	return remaining
+}
+
+// replayEscalations escalates the incidents whose queued escalation levels
+// are due at t and still need an acknowledgement, and records the
+// notifications of the levels.
+func (s *Schedule) replayEscalations(res *ReplayResult, t time.Time) error {
+	// The replayed times are in the past, so every queued level is returned.
+	queued, err := s.DataAccess.Notifications().GetDueNotifications()
+	if err != nil {
+		return err
+	}
+	type level struct {
+		at    time.Time
+		ak    models.AlertKey
+		e     *conf.Escalation
+		level int
+	}
+	var due []level
+	for ak, ns := range queued {
+		for name, at := range ns {
+			if e, l := s.queuedEscalation(name); e != nil && !at.After(t) {
+				due = append(due, level{at, ak, e, l})
+			}
+		}
+	}
+	if len(due) == 0 {
+		return nil
+	}
+	if err := s.DataAccess.Notifications().ClearNotificationsBefore(t); err != nil {
+		return err
+	}
+	sort.Slice(due, func(i, j int) bool {
+		if !due[i].at.Equal(due[j].at) {
+			return due[i].at.Before(due[j].at)
+		}
+		return due[i].ak < due[j].ak
+	})
+	var sent []*ReplayNotification
+	for _, l := range due {
+		st, err := s.DataAccess.State().GetLatestIncident(l.ak)
+		if err != nil || st == nil || !st.Open || !st.NeedAck {
+			continue
+		}
+		rt, err := s.DataAccess.State().GetRenderedTemplates(st.Id)
+		if err != nil {
+			rt = nil
+		}
+		if s.escalate(st, rt, l.e, l.level, l.at) {
+			if _, err := s.DataAccess.State().UpdateIncidentState(st); err != nil {
+				return err
+			}
+		}
+		for n, states := range s.pendingNotifications {
+			for _, it := range states {
+				sent = append(sent, replayNotification(l.at, n, it.IncidentState, it.RenderedTemplates, true))
+			}
+		}
+		s.pendingNotifications = nil
+	}
+	sortReplayNotifications(sent)
+	res.Notifications = append(res.Notifications, sent...)
+	res.Summary.Notifications += len(sent)
+	return nil
+}
+
+func replayNotification(t time.Time, n *conf.Notification, st *models.IncidentState, rt *models.RenderedTemplates, chained bool) *ReplayNotification {
+	rn := &ReplayNotification{
+		Time:         t,
+		Notification: n.Name,
+		AlertKey:     st.AlertKey,
+		IncidentId:   st.Id,
+		Status:       st.CurrentStatus,
+		Chained:      chained,
+	}
+	if rt != nil && st.CurrentStatus != models.StUnknown && st.CurrentStatus != models.StNoData {
+		rn.Subject = rt.Subject
+		rn.Prepared = n.PrepareAlert(rt, st, rt.Attachments...)
+	}
+	return rn
+}
+
+func sortReplayNotifications(ns []*ReplayNotification) {
+	sort.Slice(ns, func(i, j int) bool {
+		a, b := ns[i], ns[j]
+		if !a.Time.Equal(b.Time) {
+			return a.Time.Before(b.Time)
+		}
+		if a.AlertKey != b.AlertKey {
+			return a.AlertKey < b.AlertKey
+		}
+		return a.Notification < b.Notification
+	})
+}
+
+func (s *Schedule) replayIncidents(res *ReplayResult, keys map[models.AlertKey]models.Event) error {
+	for ak := range keys {
+		incidents, err := s.DataAccess.State().GetAllIncidentsByAlertKey(ak)
+		if err != nil {
+			return err
+		}
+		for _, is := range incidents {
+			res.Incidents = append(res.Incidents, &ReplayIncident{
+				Id:            is.Id,
+				AlertKey:      is.AlertKey,
+				Start:         is.Start,
+				End:           is.End,
+				Open:          is.Open,
+				CurrentStatus: is.CurrentStatus,
+				WorstStatus:   is.WorstStatus,
+				Subject:       is.Subject,
+			})
+			res.Summary.Opened++
+			if is.Open {
+				res.Summary.Open++
+			} else {
+				res.Summary.Closed++
+			}
+			res.Summary.WorstStatus[is.WorstStatus.String()]++
+		}
+	}
+	sort.Slice(res.Incidents, func(i, j int) bool {
+		return res.Incidents[i].Id < res.Incidents[j].Id
+	})
+	return nil
+}
@@ -0,0 +1,164 @@
+package sched
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"bosun.org/cmd/bosun/conf"
+	"bosun.org/cmd/bosun/conf/rule"
+	"bosun.org/models"
+	"bosun.org/opentsdb"
+)
+
+func TestReplay(t *testing.T) {
+	bosunStartupTime = time.Date(1900, 0, 0, 0, 0, 0, 0, time.UTC)
+	// value of os.cpu for host a at each 5 minute interval from queryTime
+	values := []float64{0, 1, 1, 0, 1, 0}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req opentsdb.Request
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Error(err)
+			return
+		}
+		end, err := strconv.ParseFloat(fmt.Sprint(req.End), 64)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		i := int((int64(end) - queryTime.Unix()) / 300)
+		resp := opentsdb.ResponseSet{{
+			Metric: "os.cpu",
+			Tags:   opentsdb.TagSet{"host": "a"},
+			DPS:    map[string]opentsdb.Point{"0": opentsdb.Point(values[i])},
+		}}
+		json.NewEncoder(w).Encode(&resp)
+	}))
+	defer ts.Close()
+	u, err := url.Parse(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := rule.NewConf("replay", conf.EnabledBackends{OpenTSDB: true}, nil, `
+		template t {
+			subject = {{.Alert.Name}} on {{.Group.host}}
+			body = {{.Alert.Name}}
+		}
+		notification n {
+			print = true
+		}
+		alert cpu {
+			template = t
+			crit = avg(q("avg:os.cpu{host=*}", "5m", "")) > 0
+			critNotification = n
+		}
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sysConf := &conf.SystemConf{CheckFrequency: conf.Duration{Duration: time.Minute * 5}, DefaultRunEvery: 1, UnknownThreshold: 5, MinGroupSize: 5, OpenTSDBConf: conf.OpenTSDBConf{Host: u.Host, ResponseLimit: 1 << 20}}
+	opts := ReplayOptions{
+		From:          queryTime,
+		To:            queryTime.Add(25 * time.Minute),
+		CloseOnNormal: true,
+	}
+	res, err := Replay(sysConf, c, nil, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Intervals != 6 {
+		t.Errorf("expected 6 intervals, got %d", res.Intervals)
+	}
+	expected := []models.Status{models.StCritical, models.StNormal, models.StCritical, models.StNormal}
+	if len(res.Timeline) != len(expected) {
+		t.Fatalf("expected %d timeline events, got %d", len(expected), len(res.Timeline))
+	}
+	for i, e := range res.Timeline {
+		if e.Status != expected[i] {
+			t.Errorf("timeline event %d: expected %v, got %v", i, expected[i], e.Status)
+		}
+	}
+	if res.Summary.Opened != 2 || res.Summary.Closed != 2 || res.Summary.Open != 0 {
+		t.Errorf("unexpected summary: %+v", res.Summary)
+	}
+	if len(res.Notifications) != 2 {
+		t.Fatalf("expected 2 notifications, got %d", len(res.Notifications))
+	}
+	n := res.Notifications[0]
+	if n.Subject != "cpu on a" || !n.Time.Equal(queryTime.Add(5*time.Minute)) {
+		t.Errorf("unexpected notification: %+v", n)
+	}
+
+	// Without closing, the second time the alert triggers is part of the
+	// first incident and doesn't notify again.
+	opts.CloseOnNormal = false
+	res2, err := Replay(sysConf, c, nil, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res2.Summary.Opened != 1 || res2.Summary.Open != 1 || len(res2.Notifications) != 1 {
+		t.Errorf("unexpected summary without close: %+v, %d notifications", res2.Summary, len(res2.Notifications))
+	}
+	if res2.Incidents[0].Start != queryTime.Add(5*time.Minute) {
+		t.Errorf("unexpected incident start: %v", res2.Incidents[0].Start)
+	}
+
+	// The levels of an escalation follow the replayed time while the
+	// incident needs an ack.
+	c, err = rule.NewConf("replay", conf.EnabledBackends{OpenTSDB: true}, nil, `
+		template t {
+			subject = {{.Alert.Name}} on {{.Group.host}}
+			body = {{.Alert.Name}}
+		}
+		notification n1 {
+			print = true
+		}
+		notification n2 {
+			print = true
+		}
+		notification n3 {
+			print = true
+		}
+		escalation e {
+			level1 = n1
+			level2 = n2
+			level2Delay = 10m
+			level3 = n3
+			level3Delay = 5m
+		}
+		alert cpu {
+			template = t
+			crit = avg(q("avg:os.cpu{host=*}", "5m", "")) > 0
+			critEscalation = e
+		}
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res3, err := Replay(sysConf, c, nil, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	levels := []struct {
+		name  string
+		after time.Duration
+	}{
+		{"n1", 5 * time.Minute},
+		{"n2", 15 * time.Minute},
+		{"n3", 20 * time.Minute},
+	}
+	if len(res3.Notifications) != len(levels) {
+		t.Fatalf("expected %d escalated notifications, got %d", len(levels), len(res3.Notifications))
+	}
+	for i, l := range levels {
+		n := res3.Notifications[i]
+		if n.Notification != l.name || !n.Time.Equal(queryTime.Add(l.after)) || n.Chained != (i > 0) {
+			t.Errorf("level %d: unexpected notification %+v", i+1, n)
+		}
+	}
+}
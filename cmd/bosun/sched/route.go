@@ -0,0 +1,162 @@
+package sched
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"bosun.org/cmd/bosun/conf"
+	"bosun.org/models"
+	"bosun.org/slog"
+)
+
+// Incidents routed to a receiver by a route with groupBy are queued with the
+// pending notifications of their alert key, under a name made of routePrefix,
+// the route and the receiver, due at the end of the current group wait. All
+// incidents queued in a period are due at the same time, so the incidents of
+// each group are sent together as one notification.
+const routePrefix = "route#"
+
+func routeQueueName(r *conf.Route, n *conf.Notification) string {
+	return fmt.Sprintf("%s%s#%s", routePrefix, r.Name, n.Name)
+}
+
+// routeGroup is a receiver of a route with groupBy.
+type routeGroup struct {
+	route        *conf.Route
+	notification *conf.Notification
+}
+
+// queuedRoute returns the route and receiver of a queued notification name,
+// or nil if it is not a route group or the route no longer groups to the
+// receiver.
+func (s *Schedule) queuedRoute(name string) (*conf.Route, *conf.Notification) {
+	if !strings.HasPrefix(name, routePrefix) {
+		return nil, nil
+	}
+	name = strings.TrimPrefix(name, routePrefix)
+	i := strings.LastIndex(name, "#")
+	root := s.RuleConf.GetRoute()
+	if i == -1 || root == nil {
+		return nil, nil
+	}
+	r := root.Find(name[:i])
+	if r == nil || len(r.GroupBy) == 0 {
+		return nil, nil
+	}
+	n := r.Receivers[name[i+1:]]
+	if n == nil {
+		return nil, nil
+	}
+	return r, n
+}
+
+// routedNotifications returns the receivers of the routes an incident of
+// alert a with status and severity level sev is routed to, or nil if a has
+// notifications of its own.
+func routedNotifications(c conf.RuleConfProvider, a *conf.Alert, ak models.AlertKey, status models.Status, sev *models.Severity) *conf.Notifications {
+	root := c.GetRoute()
+	if !a.Routed || root == nil {
+		return nil
+	}
+	return conf.RoutedNotifications(root.Match(a.Name, ak.Group(), status, sev))
+}
+
+// routeNotify notifies the receivers of the routes an incident of an alert
+// without notifications of its own is routed to. Alert notifications of
+// routes with groupBy are queued to be sent with their group; unknown and
+// nodata ones are grouped like any unknown notification. It returns true if
+// any receiver was notified.
+func (s *Schedule) routeNotify(st *models.IncidentState, rt *models.RenderedTemplates, a *conf.Alert, status models.Status, sev *models.Severity) bool {
+	root := s.RuleConf.GetRoute()
+	if root == nil {
+		return false
+	}
+	notified := false
+	for _, r := range root.Match(a.Name, st.AlertKey.Group(), status, sev) {
+		for _, name := range r.ReceiverNames {
+			n := r.Receivers[name]
+			if len(r.GroupBy) > 0 && (status == models.StWarning || status == models.StCritical) {
+				due := digestEnd(r.GroupWait, utcNow())
+				if err := s.DataAccess.Notifications().InsertNotification(st.AlertKey, routeQueueName(r, n), due); err != nil {
+					slog.Errorf("queueing %s for route %s to %s: %v", st.AlertKey, r.Name, n.Name, err)
+					continue
+				}
+				st.SetNotified(n.Name)
+			} else {
+				s.Notify(st, rt, n)
+			}
+			notified = true
+		}
+	}
+	return notified
+}
+
+// routeGroupKey returns the values of the groupBy tags of ak.
+func routeGroupKey(r *conf.Route, ak models.AlertKey) string {
+	tags := ak.Group()
+	values := make([]string, len(r.GroupBy))
+	for i, k := range r.GroupBy {
+		values[i] = k + "=" + tags[k]
+	}
+	return strings.Join(values, ",")
+}
+
+// sendRouteGroups sends the incidents of the alert keys queued for each
+// route group, one notification per group of incidents with the same values
+// of the groupBy tags. A group of a single incident is sent as an alert
+// notification, and larger groups like digests. Like other alert
+// notifications, the incidents are queued for the digest of a receiver with
+// one instead, and for the next notification of a receiver with a chain.
+func (s *Schedule) sendRouteGroups(groups map[routeGroup]map[models.AlertKey]bool, silenced NotificationSilenceTester) {
+	if s.quiet {
+		return
+	}
+	for rg, aks := range groups {
+		n := rg.notification
+		byKey := make(map[string][]*models.IncidentState)
+		for ak := range aks {
+			if silenced(ak, n.Name) != nil {
+				continue
+			}
+			st, err := s.DataAccess.State().GetLatestIncident(ak)
+			if err != nil {
+				slog.Error(err)
+				continue
+			}
+			if st == nil || !st.Open || !st.NeedAck {
+				continue
+			}
+			if n.Next != nil {
+				s.QueueNotification(ak, n.Next, utcNow().Add(n.Timeout))
+			}
+			if n.Digest > 0 {
+				s.queueDigest(ak, n, digestAlert)
+				continue
+			}
+			key := routeGroupKey(rg.route, ak)
+			byKey[key] = append(byKey[key], st)
+		}
+		for _, states := range byKey {
+			if len(states) == 1 {
+				rt, err := s.DataAccess.State().GetRenderedTemplates(states[0].Id)
+				if err != nil {
+					slog.Error(err)
+					continue
+				}
+				s.notify(states[0], rt, n)
+				continue
+			}
+			sort.Slice(states, func(i, j int) bool {
+				return states[i].Id < states[j].Id
+			})
+			incidents := make([]*conf.DigestIncident, len(states))
+			for i, st := range states {
+				incidents[i] = &conf.DigestIncident{IncidentState: st, Events: []string{digestAlert}}
+			}
+			end := utcNow().Truncate(time.Second)
+			s.deliver(n.PrepareDigest(s.SystemConf, end.Add(-rg.route.GroupWait), end, incidents))
+		}
+	}
+}
@@ -0,0 +1,259 @@
+package sched
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"bosun.org/cmd/bosun/conf"
+	"bosun.org/cmd/bosun/conf/rule"
+	"bosun.org/models"
+	"bosun.org/util"
+)
+
+func TestRoute(t *testing.T) {
+	defer setup()()
+	util.InitHostManager("", false) // for the collect metrics
+
+	var lock sync.Mutex
+	posts := make(map[string]int)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		posts[r.URL.Path]++
+		lock.Unlock()
+	}))
+	defer ts.Close()
+
+	c, err := rule.NewConf("", conf.EnabledBackends{}, nil, fmt.Sprintf(`
+		template t {
+			subject = {{.Alert.Name}}
+			body = 1
+		}
+		notification ops {
+			post = %[1]s/ops
+		}
+		notification dba {
+			post = %[1]s/dba
+		}
+		notification pages {
+			post = %[1]s/pages
+		}
+		route root {
+			receiver = ops
+			route db {
+				tags = host=db*
+				receiver = dba
+				groupBy = cluster
+				continue = true
+			}
+			route crit {
+				severity = critical
+				receiver = pages
+			}
+		}
+		alert a {
+			template = t
+			crit = 1
+		}
+	`, ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := initSched(&conf.SystemConf{OutboxConf: conf.OutboxConf{MaxAttempts: 1}}, c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := c.GetAlert("a")
+	if !a.Routed {
+		t.Fatal("expected alert a to be routed")
+	}
+
+	incident := func(ak models.AlertKey, status models.Status) *models.IncidentState {
+		st := &models.IncidentState{
+			AlertKey:      ak,
+			Alert:         ak.Name(),
+			Tags:          ak.Group().Tags(),
+			Start:         utcNow(),
+			Open:          true,
+			NeedAck:       true,
+			WorstStatus:   status,
+			CurrentStatus: status,
+			Events:        []models.Event{{Status: status, Time: utcNow()}},
+		}
+		if st.Id, err = s.DataAccess.State().UpdateIncidentState(st); err != nil {
+			t.Fatal(err)
+		}
+		rt := &models.RenderedTemplates{Subject: "a", Body: "1"}
+		if err := s.DataAccess.State().SetRenderedTemplates(st.Id, rt); err != nil {
+			t.Fatal(err)
+		}
+		s.routeNotify(st, rt, a, status, nil)
+		if _, err := s.DataAccess.State().UpdateIncidentState(st); err != nil {
+			t.Fatal(err)
+		}
+		return st
+	}
+	db1 := incident("a{cluster=c1,host=db1}", models.StCritical)
+	incident("a{cluster=c1,host=db2}", models.StCritical)
+	incident("a{cluster=c1,host=web1}", models.StWarning)
+
+	// The db incidents continue to the critical route, and are queued for
+	// their group.
+	if len(db1.Notifications) != 2 || db1.Notifications[0] != "dba" || db1.Notifications[1] != "pages" {
+		t.Fatalf("unexpected notifications %v", db1.Notifications)
+	}
+	if n := len(s.pendingNotifications[c.GetNotification("pages")]); n != 2 {
+		t.Fatalf("expected 2 pages, got %d", n)
+	}
+	if n := len(s.pendingNotifications[c.GetNotification("ops")]); n != 1 {
+		t.Fatalf("expected the warning to go to the root receiver, got %d", n)
+	}
+
+	// Make the group due now instead of at the end of the group wait.
+	r := c.GetRoute().Find("db")
+	for _, ak := range []models.AlertKey{"a{cluster=c1,host=db1}", "a{cluster=c1,host=db2}"} {
+		if err := s.DataAccess.Notifications().InsertNotification(ak, routeQueueName(r, c.GetNotification("dba")), utcNow().Add(-time.Second)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	s.CheckNotifications()
+	expected := map[string]int{"/ops": 1, "/dba": 1, "/pages": 2}
+	for i := 0; i < 100; i++ {
+		lock.Lock()
+		done := posts["/ops"]+posts["/dba"]+posts["/pages"] >= 4
+		lock.Unlock()
+		if done {
+			break
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	lock.Lock()
+	defer lock.Unlock()
+	for path, n := range expected {
+		if posts[path] != n {
+			t.Errorf("expected %d posts to %s, got %d", n, path, posts[path])
+		}
+	}
+}
+
+// TestRouteGroupChain tests that the receivers of routes with groupBy keep
+// their notification chains and digests.
+func TestRouteGroupChain(t *testing.T) {
+	defer setup()()
+	util.InitHostManager("", false) // for the collect metrics
+
+	var lock sync.Mutex
+	posts := make(map[string]int)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		posts[r.URL.Path]++
+		lock.Unlock()
+	}))
+	defer ts.Close()
+
+	c, err := rule.NewConf("", conf.EnabledBackends{}, nil, fmt.Sprintf(`
+		template t {
+			subject = {{.Alert.Name}}
+			body = 1
+		}
+		notification escalated {
+			post = %[1]s/escalated
+		}
+		notification dba {
+			post = %[1]s/dba
+			next = escalated
+			timeout = 1s
+		}
+		notification digested {
+			post = %[1]s/digested
+			digest = 1m
+		}
+		notification ops {
+			post = %[1]s/ops
+		}
+		route root {
+			receiver = ops
+			route db {
+				tags = host=db*
+				receiver = dba,digested
+				groupBy = cluster
+			}
+		}
+		alert a {
+			template = t
+			crit = 1
+		}
+	`, ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// A shorter digest than the configuration allows, to keep the test fast.
+	c.GetNotification("digested").Digest = time.Second
+	s, err := initSched(&conf.SystemConf{OutboxConf: conf.OutboxConf{MaxAttempts: 1}}, c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := c.GetAlert("a")
+	r := c.GetRoute().Find("db")
+	for _, ak := range []models.AlertKey{"a{cluster=c1,host=db1}", "a{cluster=c1,host=db2}"} {
+		st := &models.IncidentState{
+			AlertKey:      ak,
+			Alert:         ak.Name(),
+			Tags:          ak.Group().Tags(),
+			Start:         utcNow(),
+			Open:          true,
+			NeedAck:       true,
+			WorstStatus:   models.StCritical,
+			CurrentStatus: models.StCritical,
+			Events:        []models.Event{{Status: models.StCritical, Time: utcNow()}},
+		}
+		if st.Id, err = s.DataAccess.State().UpdateIncidentState(st); err != nil {
+			t.Fatal(err)
+		}
+		rt := &models.RenderedTemplates{Subject: "a", Body: "1"}
+		if err := s.DataAccess.State().SetRenderedTemplates(st.Id, rt); err != nil {
+			t.Fatal(err)
+		}
+		s.routeNotify(st, rt, a, models.StCritical, nil)
+		// Make the group due now instead of at the end of the group wait.
+		for _, n := range []string{"dba", "digested"} {
+			if err := s.DataAccess.Notifications().InsertNotification(ak, routeQueueName(r, c.GetNotification(n)), utcNow().Add(-time.Second)); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	waitFor := func(expected map[string]int) {
+		for i := 0; i < 100; i++ {
+			lock.Lock()
+			done := true
+			for path, n := range expected {
+				done = done && posts[path] >= n
+			}
+			lock.Unlock()
+			if done {
+				break
+			}
+			time.Sleep(20 * time.Millisecond)
+		}
+		lock.Lock()
+		defer lock.Unlock()
+		for path, n := range expected {
+			if posts[path] != n {
+				t.Errorf("expected %d posts to %s, got %d", n, path, posts[path])
+			}
+		}
+	}
+
+	// The group is sent to dba, and queued for the digest.
+	s.CheckNotifications()
+	waitFor(map[string]int{"/dba": 1, "/digested": 0, "/escalated": 0})
+
+	// Once the timeout of dba and the digest period are over, the incidents
+	// go on to the next notification of the chain, and the digest is sent.
+	time.Sleep(2 * time.Second)
+	s.CheckNotifications()
+	waitFor(map[string]int{"/dba": 1, "/digested": 1, "/escalated": 2})
+}
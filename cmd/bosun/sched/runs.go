@@ -0,0 +1,127 @@
+package sched
+
+import (
+	"sort"
+	"time"
+
+	"bosun.org/cmd/bosun/conf"
+	"bosun.org/models"
+	"bosun.org/slog"
+)
+
+// recordAlertRun stores the statistics of a check of a, so that expensive or
+// flaky alerts can be found.
+func (s *Schedule) recordAlertRun(a *conf.Alert, r *RunHistory, d time.Duration, unevaluated int, err error) {
+	run := &models.AlertRun{
+		Time:        r.Start,
+		Duration:    d,
+		Results:     r.results,
+		Queries:     r.Backends.Queries(),
+		Unevaluated: unevaluated,
+	}
+	for ak, ev := range r.Events {
+		if ak.Name() != a.Name {
+			continue
+		}
+		// The events of alert keys without data are still unknown here;
+		// count them as runHistory will store them.
+		status := ev.Status
+		if status == models.StUnknown {
+			if a.UnknownsNormal {
+				status = models.StNormal
+			} else if a.NoDataState != models.StNone {
+				status = a.NoDataState
+			}
+		}
+		switch status {
+		case models.StNormal:
+			run.Normal++
+		case models.StWarning:
+			run.Warning++
+		case models.StCritical:
+			run.Critical++
+		case models.StUnknown:
+			run.Unknown++
+		case models.StNoData:
+			run.NoData++
+		}
+	}
+	if err != nil {
+		run.Error = err.Error()
+	}
+	if err := s.DataAccess.Runs().AddAlertRun(a.Name, run); err != nil {
+		slog.Errorf("storing run of alert %s: %v", a.Name, err)
+	}
+}
+
+// AlertHealth summarizes the recent runs of an alert.
+type AlertHealth struct {
+	Alert   string
+	Runs    int
+	LastRun time.Time
+	// Errors is the number of runs that failed.
+	Errors int
+	// Changes is the number of runs where the number of warning or critical
+	// alert keys changed from the previous run. Alerts that change often
+	// may be flapping.
+	Changes                   int
+	AvgDuration, MaxDuration  time.Duration
+	AvgQueries                float64
+	AvgResults                float64
+	LastAbnormal, MaxAbnormal int
+	LastUnknown, LastNoData   int
+	LastUnevaluated           int
+}
+
+// AlertHealth returns the summary of the last count runs of each alert,
+// slowest first.
+func (s *Schedule) AlertHealth(count int) ([]*AlertHealth, error) {
+	var health []*AlertHealth
+	for name := range s.RuleConf.GetAlerts() {
+		runs, err := s.DataAccess.Runs().GetAlertRuns(name, count)
+		if err != nil {
+			return nil, err
+		}
+		h := &AlertHealth{Alert: name, Runs: len(runs)}
+		health = append(health, h)
+		if len(runs) == 0 {
+			continue
+		}
+		last := runs[0]
+		h.LastRun = last.Time
+		h.LastAbnormal = last.Warning + last.Critical
+		h.LastUnknown = last.Unknown
+		h.LastNoData = last.NoData
+		h.LastUnevaluated = last.Unevaluated
+		var total time.Duration
+		var queries, results int64
+		for i, run := range runs {
+			total += run.Duration
+			if run.Duration > h.MaxDuration {
+				h.MaxDuration = run.Duration
+			}
+			queries += run.Queries
+			results += int64(run.Results)
+			if run.Error != "" {
+				h.Errors++
+			}
+			abnormal := run.Warning + run.Critical
+			if abnormal > h.MaxAbnormal {
+				h.MaxAbnormal = abnormal
+			}
+			if i > 0 && abnormal != runs[i-1].Warning+runs[i-1].Critical {
+				h.Changes++
+			}
+		}
+		h.AvgDuration = total / time.Duration(len(runs))
+		h.AvgQueries = float64(queries) / float64(len(runs))
+		h.AvgResults = float64(results) / float64(len(runs))
+	}
+	sort.Slice(health, func(i, j int) bool {
+		if health[i].AvgDuration != health[j].AvgDuration {
+			return health[i].AvgDuration > health[j].AvgDuration
+		}
+		return health[i].Alert < health[j].Alert
+	})
+	return health, nil
+}
@@ -0,0 +1,86 @@
+package sched
+
+import (
+	"testing"
+	"time"
+
+	"bosun.org/models"
+	"bosun.org/opentsdb"
+)
+
+func TestAlertRuns(t *testing.T) {
+	defer setup()()
+	s := testSched(t, &schedTest{
+		conf: `alert a {
+			crit = avg(q("avg:m{a=*}", "5m", "")) > 0
+		}`,
+		queries: map[string]opentsdb.ResponseSet{
+			`q("avg:m{a=*}", ` + window5Min + `)`: {
+				{
+					Metric: "m",
+					Tags:   opentsdb.TagSet{"a": "b"},
+					DPS:    map[string]opentsdb.Point{"0": 1},
+				},
+				{
+					Metric: "m",
+					Tags:   opentsdb.TagSet{"a": "c"},
+					DPS:    map[string]opentsdb.Point{"0": 0},
+				},
+			},
+		},
+		state: map[schedState]bool{
+			{"a{a=b}", "critical"}: true,
+		},
+	})
+	runs, err := s.DataAccess.Runs().GetAlertRuns("a", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(runs) != 1 {
+		t.Fatalf("expected 1 run, got %d", len(runs))
+	}
+	run := runs[0]
+	if !run.Time.Equal(queryTime) || run.Results != 2 || run.Queries != 1 || run.Critical != 1 || run.Normal != 1 || run.Error != "" {
+		t.Errorf("unexpected run: %+v", run)
+	}
+	health, err := s.AlertHealth(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(health) != 1 || health[0].Runs != 1 || health[0].LastAbnormal != 1 || health[0].AvgResults != 2 {
+		t.Errorf("unexpected health: %+v", health)
+	}
+}
+
+func TestAlertRunsNoData(t *testing.T) {
+	defer setup()()
+	s := testSched(t, &schedTest{
+		conf: `alert a {
+			noDataState = nodata
+			crit = avg(q("avg:m{a=*}", "5m", "")) > 0
+		}`,
+		queries: map[string]opentsdb.ResponseSet{
+			`q("avg:m{a=*}", ` + window5Min + `)`: {},
+		},
+		state: map[schedState]bool{
+			{"a{a=b}", "nodata"}: true,
+		},
+		touched: map[models.AlertKey]time.Time{
+			"a{a=b}": queryTime.Add(-10 * time.Minute),
+		},
+	})
+	runs, err := s.DataAccess.Runs().GetAlertRuns("a", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(runs) != 1 || runs[0].NoData != 1 || runs[0].Unknown != 0 {
+		t.Fatalf("expected a run with 1 nodata alert key, got %+v", runs[0])
+	}
+	health, err := s.AlertHealth(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(health) != 1 || health[0].LastNoData != 1 {
+		t.Errorf("unexpected health: %+v", health)
+	}
+}
@@ -58,6 +58,11 @@ type Schedule struct {
 	lastLogTimes map[models.AlertKey]time.Time
 	LastCheck    time.Time
 
+	// suppressedNotes throttles the notes about suppressed notifications
+	// added to incidents.
+	suppressedNotes     map[string]time.Time
+	suppressedNotesLock sync.Mutex
+
 	ctx *checkContext
 
 	DataAccess database.DataAccess
@@ -85,6 +90,7 @@ func (s *Schedule) Init(name string, systemConf conf.SystemConfProvider, ruleCon
 	s.annotate = annotate
 	s.pendingUnknowns = make(map[notificationGroupKey][]*models.IncidentState)
 	s.lastLogTimes = make(map[models.AlertKey]time.Time)
+	s.suppressedNotes = make(map[string]time.Time)
 	s.LastCheck = utcNow()
 	s.ctx = &checkContext{utcNow(), cache.New(name, 0)}
 	s.DataAccess = dataAccess
@@ -225,9 +231,21 @@ type StateTuple struct {
 	Status        models.Status
 	CurrentStatus models.Status
 	Silenced      bool
+	// Severity is the severity level of Status for alerts that declare
+	// their own levels, the zero value otherwise.
+	Severity models.Severity
+}
+
+// severity returns the severity level of the tuple, nil if it has none.
+func (t StateTuple) severity() *models.Severity {
+	if t.Severity.Name == "" {
+		return nil
+	}
+	sev := t.Severity
+	return &sev
 }
 
-// GroupStates groups by NeedAck, Active, Status, and Silenced.
+// GroupStates groups by NeedAck, Active, Status, severity level and Silenced.
 func (states States) GroupStates(silenced SilenceTester) map[StateTuple]States {
 	r := make(map[StateTuple]States)
 	for ak, st := range states {
@@ -239,6 +257,9 @@ func (states States) GroupStates(silenced SilenceTester) map[StateTuple]States {
 			CurrentStatus: st.CurrentStatus,
 			Silenced:      sil,
 		}
+		if st.LastAbnormalSeverity != nil {
+			t.Severity = *st.LastAbnormalSeverity
+		}
 		if _, present := r[t]; !present {
 			r[t] = make(States)
 		}
@@ -335,6 +356,7 @@ type StateGroup struct {
 	Active        bool `json:",omitempty"`
 	Status        models.Status
 	CurrentStatus models.Status
+	Severity      *models.Severity `json:",omitempty"`
 	Silenced      bool
 	IsError       bool                  `json:",omitempty"`
 	Subject       string                `json:",omitempty"`
@@ -354,7 +376,9 @@ type StateGroups struct {
 	FailingAlerts, UnclosedErrors int
 }
 
-func (s *Schedule) MarshalGroups(T miniprofiler.Timer, filter string) (*StateGroups, error) {
+// MarshalGroups groups the open incidents matching filter. user is the user
+// the filter is evaluated for, which owner:me matches.
+func (s *Schedule) MarshalGroups(T miniprofiler.Timer, filter, user string) (*StateGroups, error) {
 	var silenced SilenceTester
 	T.Step("Silenced", func(miniprofiler.Timer) {
 		silenced = s.Silenced()
@@ -392,6 +416,7 @@ func (s *Schedule) MarshalGroups(T miniprofiler.Timer, filter string) (*StateGro
 				err = err2
 				return
 			}
+			is.Viewer = user
 			match := false
 			match, err2 = boolq.AskParsedExpr(parsedExpr, is)
 			if err2 != nil {
@@ -413,24 +438,27 @@ func (s *Schedule) MarshalGroups(T miniprofiler.Timer, filter string) (*StateGro
 		for tuple, states := range groups {
 			var grouped []*StateGroup
 			switch tuple.Status {
-			case models.StWarning, models.StCritical, models.StUnknown:
+			case models.StWarning, models.StCritical, models.StUnknown, models.StNoData:
 				var sets map[string]models.AlertKeys
 				T.Step(fmt.Sprintf("GroupSets (%d): %v", len(states), tuple), func(T miniprofiler.Timer) {
 					sets = states.GroupSets(s.SystemConf.GetMinGroupSize())
 				})
+				sev := tuple.severity()
 				for name, group := range sets {
 					g := StateGroup{
 						Active:        tuple.Active,
 						Status:        tuple.Status,
 						CurrentStatus: tuple.CurrentStatus,
+						Severity:      sev,
 						Silenced:      tuple.Silenced,
-						Subject:       fmt.Sprintf("%s - %s", tuple.Status, name),
+						Subject:       fmt.Sprintf("%s - %s", tuple.Status.Label(sev), name),
 					}
 					for _, ak := range group {
 						st := status[ak]
 						g.Children = append(g.Children, &StateGroup{
 							Active:   tuple.Active,
 							Status:   tuple.Status,
+							Severity: sev,
 							Silenced: tuple.Silenced,
 							AlertKey: ak,
 							Alert:    ak.Name(),
@@ -465,8 +493,8 @@ func (s *Schedule) MarshalGroups(T miniprofiler.Timer, filter string) (*StateGro
 				} else if !a.Active && b.Active {
 					return false
 				}
-				if a.Status != b.Status {
-					return a.Status > b.Status
+				if c := models.CompareLevels(a.Status, a.Severity, b.Status, b.Severity); c != 0 {
+					return c > 0
 				}
 				if a.AlertKey != b.AlertKey {
 					return a.AlertKey < b.AlertKey
@@ -531,6 +559,10 @@ func init() {
 		"The number of seconds it took Bosun to check each alert rule.")
 	metadata.AddMetricMeta("bosun.check.err", metadata.Gauge, metadata.Error,
 		"The running count of the number of errors Bosun has received while trying to evaluate an alert expression.")
+	metadata.AddMetricMeta("bosun.check.timeouts", metadata.Counter, metadata.Count,
+		"The number of alert checks abandoned because they ran longer than their timeout.")
+	metadata.AddMetricMeta("bosun.check.max_alert_keys", metadata.Counter, metadata.Count,
+		"The number of alert expressions rejected because they returned more alert keys than maxAlertKeys.")
 
 	metadata.AddMetricMeta("bosun.actions", metadata.Gauge, metadata.Count,
 		"The running count of actions performed by individual users (Closed alert, Acknowledged alert, etc).")
@@ -560,7 +592,7 @@ func (s *Schedule) ActionByIncidentId(user, message string, t models.ActionType,
 }
 
 func (s *Schedule) action(user, message string, t models.ActionType, at *time.Time, st *models.IncidentState) (models.AlertKey, error) {
-	isUnknown := st.LastAbnormalStatus == models.StUnknown
+	isUnknown := st.LastAbnormalStatus == models.StUnknown || st.LastAbnormalStatus == models.StNoData
 	timestamp := utcNow()
 	action := models.Action{
 		Message: message,
@@ -581,6 +613,32 @@ func (s *Schedule) action(user, message string, t models.ActionType, at *time.Ti
 		if err := s.DataAccess.Notifications().ClearNotifications(st.AlertKey); err != nil {
 			return "", err
 		}
+		cancelAckExpiry(st)
+		if at != nil {
+			if !at.After(timestamp) {
+				return "", fmt.Errorf("acknowledgement expiry must be in the future")
+			}
+			action.Deadline = at
+		} else if a := s.RuleConf.GetAlert(st.AlertKey.Name()); a != nil && a.AckFor > 0 {
+			dl := timestamp.Add(a.AckFor)
+			action.Deadline = &dl
+		}
+		if action.Deadline != nil {
+			if err := s.DataAccess.Notifications().InsertNotification(st.AlertKey, ackExpiryName, *action.Deadline); err != nil {
+				return "", err
+			}
+			s.wakeNotifications()
+		}
+	case models.ActionAckExpired:
+		i := pendingAckExpiry(st)
+		if i == -1 {
+			return "", fmt.Errorf("no expiring acknowledgement for incident %v (%v) found", st.Id, st.AlertKey)
+		}
+		if !st.Open {
+			return "", fmt.Errorf("cannot expire acknowledgement of closed alert")
+		}
+		st.Actions[i].Fullfilled = true
+		st.NeedAck = true
 	case models.ActionCancelClose:
 		found := false
 		for i, a := range st.Actions {
@@ -596,6 +654,7 @@ func (s *Schedule) action(user, message string, t models.ActionType, at *time.Ti
 	case models.ActionClose:
 		// closing effectively acks the incident
 		st.NeedAck = false
+		cancelAckExpiry(st)
 		if st.IsActive() { // Closing an active incident results in delayed close
 			var dl time.Time
 			if at != nil {
@@ -634,7 +693,7 @@ func (s *Schedule) action(user, message string, t models.ActionType, at *time.Ti
 		}
 	case models.ActionForget:
 		if !isUnknown {
-			return "", fmt.Errorf("can only forget unknowns")
+			return "", fmt.Errorf("can only forget unknowns and nodata")
 		}
 		if err := s.DataAccess.Notifications().ClearNotifications(st.AlertKey); err != nil {
 			return "", err
@@ -662,6 +721,54 @@ func (s *Schedule) action(user, message string, t models.ActionType, at *time.Ti
 	return st.AlertKey, nil
 }
 
+// EditByIncidentId assigns the incident, for an ActionAssign, or changes its
+// labels and custom fields, for an ActionLabel. The change is recorded with
+// the action.
+func (s *Schedule) EditByIncidentId(user, message string, t models.ActionType, e models.IncidentEdit, id int64) (models.AlertKey, error) {
+	st, err := s.DataAccess.State().GetIncidentState(id)
+	if err != nil {
+		return "", err
+	}
+	if st == nil {
+		return "", fmt.Errorf("no incident with id: %v", id)
+	}
+	if err := e.Apply(t, st); err != nil {
+		return "", err
+	}
+	st.Actions = append(st.Actions, models.Action{
+		Message: message,
+		Time:    utcNow(),
+		Type:    t,
+		User:    user,
+		Edit:    &e,
+	})
+	if _, err := s.DataAccess.State().UpdateIncidentState(st); err != nil {
+		return "", err
+	}
+	if err := collect.Add("actions", opentsdb.TagSet{"user": user, "alert": st.AlertKey.Name(), "type": t.String()}, 1); err != nil {
+		slog.Errorln(err)
+	}
+	return st.AlertKey, nil
+}
+
+// pendingAckExpiry returns the index of the acknowledgement of st that has
+// an expiry which has not passed yet, or -1.
+func pendingAckExpiry(st *models.IncidentState) int {
+	for i, a := range st.Actions {
+		if a.Type == models.ActionAcknowledge && a.Deadline != nil && !(a.Fullfilled || a.Cancelled) {
+			return i
+		}
+	}
+	return -1
+}
+
+// cancelAckExpiry cancels the expiry of the acknowledgement of st, if any.
+func cancelAckExpiry(st *models.IncidentState) {
+	if i := pendingAckExpiry(st); i != -1 {
+		st.Actions[i].Cancelled = true
+	}
+}
+
 type IncidentStatus struct {
 	IncidentID         int64
 	Active             bool
@@ -9,6 +9,7 @@ import (
 	"net/http/httptest"
 	"net/url"
 	"sort"
+	"strings"
 	"testing"
 	"time"
 
@@ -19,6 +20,7 @@ import (
 	"bosun.org/models"
 	"bosun.org/opentsdb"
 	"bosun.org/slog"
+	"bosun.org/util"
 	"github.com/MiniProfiler/go/miniprofiler"
 )
 
@@ -112,7 +114,7 @@ func testSched(t *testing.T, st *schedTest) (s *Schedule) {
 		s.DataAccess.State().TouchAlertKey(ak, time)
 	}
 	check(s, queryTime)
-	groups, err := s.MarshalGroups(new(miniprofiler.Profile), "")
+	groups, err := s.MarshalGroups(new(miniprofiler.Profile), "", "")
 	if err != nil {
 		t.Error(err)
 		return
@@ -286,6 +288,40 @@ func TestUnknown_WithError(t *testing.T) {
 	}
 }
 
+func TestMaxAlertKeys(t *testing.T) {
+	defer setup()()
+	util.InitHostManager("", false) // for the collect metrics
+
+	var rs opentsdb.ResponseSet
+	for _, id := range []string{"1", "2", "3"} {
+		rs = append(rs, &opentsdb.Response{
+			Metric: "m",
+			Tags:   opentsdb.TagSet{"a": "b", "request_id": id},
+			DPS:    map[string]opentsdb.Point{"0": 1},
+		})
+	}
+	s := testSched(t, &schedTest{
+		conf: `alert a {
+			maxAlertKeys = 2
+			crit = avg(q("avg:m{a=*,request_id=*}", "5m", "")) > 0
+		}`,
+		queries: map[string]opentsdb.ResponseSet{
+			`q("avg:m{a=*,request_id=*}", ` + window5Min + `)`: rs,
+		},
+		state: map[schedState]bool{},
+	})
+	if s.AlertSuccessful("a") {
+		t.Fatal("Expected alert a to be in a failed state")
+	}
+	failures, err := s.DataAccess.Errors().GetFullErrorHistory()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(failures["a"]) == 0 || !strings.Contains(failures["a"][0].Message, "request_id=3, a=1") {
+		t.Errorf("expected the error to summarize the tag keys, got %+v", failures["a"])
+	}
+}
+
 func TestRename(t *testing.T) {
 	defer setup()()
 	testSched(t, &schedTest{
@@ -357,3 +393,33 @@ func TestUnknownsAreNormal(t *testing.T) {
 		},
 	})
 }
+
+func TestNoDataState(t *testing.T) {
+	for _, tc := range []struct {
+		state, status string
+	}{
+		{"nodata", "nodata"},
+		{"critical", "critical"},
+		{"warning", "warning"},
+	} {
+		func() {
+			defer setup()()
+			testSched(t, &schedTest{
+				conf: `alert a {
+					noDataState = ` + tc.state + `
+					crit = avg(q("avg:m{a=*}", "5m", "")) > 0
+				}`,
+				queries: map[string]opentsdb.ResponseSet{
+					`q("avg:m{a=*}", ` + window5Min + `)`: {},
+				},
+				state: map[schedState]bool{
+					{"a{a=b}", tc.status}: true,
+				},
+				touched: map[models.AlertKey]time.Time{
+					"a{a=b}": queryTime.Add(-10 * time.Minute),
+					"a{a=c}": queryTime.Add(-9 * time.Minute),
+				},
+			})
+		}()
+	}
+}
@@ -2,6 +2,8 @@ package sched
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"bosun.org/models"
@@ -11,24 +13,56 @@ import (
 
 type SilenceTester func(models.AlertKey) *models.Silence
 
+// NotificationSilenceTester returns the silence that silences the given
+// notification of the alert key, if any.
+type NotificationSilenceTester func(ak models.AlertKey, notification string) *models.Silence
+
 // Silenced returns a function that will determine if the given alert key is silenced at the current time.
 // A function is returned to avoid needing to enumerate all alert keys unneccesarily.
+// Silences that only apply to some notifications are not considered.
 func (s *Schedule) Silenced() SilenceTester {
+	silenced := s.NotificationSilenced()
+	if silenced == nil {
+		return nil
+	}
+	return func(ak models.AlertKey) *models.Silence {
+		return silenced(ak, "")
+	}
+}
+
+// NotificationSilenced is like Silenced but also considers silences that only
+// apply to some notifications. An empty notification name only matches
+// silences that apply to all notifications.
+func (s *Schedule) NotificationSilenced() NotificationSilenceTester {
 	now := utcNow()
 	silences, err := s.DataAccess.Silence().GetActiveSilences()
 	if err != nil {
 		slog.Error("Error fetching silences.", err)
 		return nil
 	}
-	return func(ak models.AlertKey) *models.Silence {
+	// Recurring silences are only active during their windows, so find the
+	// active windows once rather than for every alert key.
+	active := make([]*models.Silence, 0, len(silences))
+	ends := make([]time.Time, 0, len(silences))
+	for _, si := range silences {
+		w, ok := si.WindowAt(now)
+		if !ok {
+			continue
+		}
+		active = append(active, si)
+		ends = append(ends, w.End)
+	}
+	return func(ak models.AlertKey, notification string) *models.Silence {
 		var lastEnding *models.Silence
-		for _, si := range silences {
-			if !si.ActiveAt(now) {
+		var lastEnd time.Time
+		for i, si := range active {
+			if !si.SilencesNotification(notification) {
 				continue
 			}
-			if si.Silenced(now, ak.Name(), ak.Group()) {
-				if lastEnding == nil || lastEnding.End.Before(si.End) {
+			if si.Matches(ak.Name(), ak.Group()) {
+				if lastEnding == nil || lastEnd.Before(ends[i]) {
 					lastEnding = si
+					lastEnd = ends[i]
 				}
 			}
 		}
@@ -36,35 +70,94 @@ func (s *Schedule) Silenced() SilenceTester {
 	}
 }
 
-func (s *Schedule) AddSilence(start, end time.Time, alert, tagList string, forget, confirm bool, edit, user, message string) (map[models.AlertKey]bool, error) {
-	if start.IsZero() || end.IsZero() {
+// UpcomingSilenceWindow is an occurrence of a silence.
+type UpcomingSilenceWindow struct {
+	Id string
+	models.SilenceWindow
+	Silence *models.Silence
+}
+
+// UpcomingSilenceWindows returns the windows of all silences that are active
+// now or start within the given duration, ordered by start time.
+// Recurring silences contribute up to perSilence windows each.
+func (s *Schedule) UpcomingSilenceWindows(within time.Duration, perSilence int) ([]*UpcomingSilenceWindow, error) {
+	now := utcNow()
+	silences, err := s.DataAccess.Silence().ListSilences(now.Unix())
+	if err != nil {
+		return nil, err
+	}
+	windows := []*UpcomingSilenceWindow{}
+	for id, si := range silences {
+		for _, w := range si.Windows(now, perSilence) {
+			if w.Start.After(now.Add(within)) {
+				break
+			}
+			windows = append(windows, &UpcomingSilenceWindow{Id: id, SilenceWindow: w, Silence: si})
+		}
+	}
+	sort.Slice(windows, func(i, j int) bool {
+		if windows[i].Start.Equal(windows[j].Start) {
+			return windows[i].Id < windows[j].Id
+		}
+		return windows[i].Start.Before(windows[j].Start)
+	})
+	return windows, nil
+}
+
+// AddSilence validates and, if confirm is set, stores the silence with the
+// matches from tagList, replacing the silence with the id in edit. Without
+// confirm the alert keys of the open incidents that would be silenced are
+// returned.
+func (s *Schedule) AddSilence(si *models.Silence, tagList string, confirm bool, edit string) (map[models.AlertKey]bool, error) {
+	if si.Start.IsZero() || si.End.IsZero() {
 		return nil, fmt.Errorf("both start and end must be specified")
 	}
-	if start.After(end) {
+	if si.Start.After(si.End) {
 		return nil, fmt.Errorf("start time must be before end time")
 	}
-	if time.Since(end) > 0 {
+	if time.Since(si.End) > 0 {
 		return nil, fmt.Errorf("end time must be in the future")
 	}
-	if alert == "" && tagList == "" {
+	if si.Alert == "" && si.AlertRegex == "" && tagList == "" {
 		return nil, fmt.Errorf("must specify either alert or tags")
 	}
-	si := &models.Silence{
-		Start:   start,
-		End:     end,
-		Alert:   alert,
-		Tags:    make(opentsdb.TagSet),
-		Forget:  forget,
-		User:    user,
-		Message: message,
+	if si.Alert != "" && si.AlertRegex != "" {
+		return nil, fmt.Errorf("alert and alert regex are mutually exclusive")
+	}
+	if si.Recurrence != "" {
+		if _, _, err := si.ParseRecurrence(); err != nil {
+			return nil, err
+		}
+		if si.Length <= 0 {
+			return nil, fmt.Errorf("recurring silences require a window length")
+		}
+	}
+	for _, name := range si.Notifications {
+		if s.RuleConf.GetNotification(name) == nil {
+			return nil, fmt.Errorf("unknown notification %s", name)
+		}
 	}
+	si.Tags = make(opentsdb.TagSet)
+	si.TagMatchers = nil
+	si.TagString = ""
 	if tagList != "" {
-		tags, err := opentsdb.ParseTags(tagList)
-		if err != nil && tags == nil {
+		tags, matchers, err := models.ParseSilenceTags(tagList)
+		if err != nil {
 			return nil, err
 		}
 		si.Tags = tags
-		si.TagString = tags.Tags()
+		si.TagMatchers = matchers
+		parts := []string{}
+		if len(tags) > 0 {
+			parts = append(parts, tags.Tags())
+		}
+		for _, m := range matchers {
+			parts = append(parts, m.String())
+		}
+		si.TagString = strings.Join(parts, ",")
+	}
+	if err := si.CompileRegexps(); err != nil {
+		return nil, err
 	}
 	if confirm {
 		if edit != "" {
@@ -0,0 +1,133 @@
+package sched
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"bosun.org/cmd/bosun/conf"
+	"bosun.org/cmd/bosun/conf/rule"
+	"bosun.org/models"
+)
+
+func TestRecurringSilence(t *testing.T) {
+	defer setup()()
+	c, err := rule.NewConf("", conf.EnabledBackends{}, nil, `
+		alert a {
+			warn = 1
+		}
+		alert b {
+			warn = 1
+		}
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := initSched(&conf.SystemConf{}, c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := utcNow()
+	// a window that started this minute and one twelve hours away
+	active := fmt.Sprintf("%d %d * * *", now.Minute(), now.Hour())
+	inactive := fmt.Sprintf("%d %d * * *", now.Minute(), (now.Hour()+12)%24)
+	silence := func(alert, recurrence string) *models.Silence {
+		return &models.Silence{
+			Start:      now.Add(-time.Hour),
+			End:        now.Add(24 * time.Hour),
+			Alert:      alert,
+			User:       "user",
+			Message:    "message",
+			Recurrence: recurrence,
+			TimeZone:   "UTC",
+			Length:     time.Hour,
+		}
+	}
+	if _, err := s.AddSilence(silence("a", active), "", true, ""); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.AddSilence(silence("b", inactive), "", true, ""); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.AddSilence(silence("b", "not a recurrence"), "", true, ""); err == nil {
+		t.Error("expected error for bad recurrence")
+	}
+	silenced := s.Silenced()
+	if silenced(models.AlertKey("a{}")) == nil {
+		t.Error("expected a to be silenced")
+	}
+	if silenced(models.AlertKey("b{}")) != nil {
+		t.Error("expected b not to be silenced")
+	}
+	windows, err := s.UpcomingSilenceWindows(20*time.Hour, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(windows) != 2 || windows[0].Silence.Alert != "a" || windows[1].Silence.Alert != "b" {
+		t.Errorf("unexpected upcoming windows: %v", windows)
+	}
+}
+
+func TestSilenceMatchers(t *testing.T) {
+	defer setup()()
+	c, err := rule.NewConf("", conf.EnabledBackends{}, nil, `
+		alert os.cpu {
+			warn = 1
+		}
+		notification pager {
+			print = true
+		}
+		notification chat {
+			print = true
+		}
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := initSched(&conf.SystemConf{}, c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := utcNow()
+	add := func(si *models.Silence, tags string) {
+		si.Start = now.Add(-time.Hour)
+		si.End = now.Add(time.Hour)
+		if _, err := s.AddSilence(si, tags, true, ""); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// all hosts except the databases, on any os alert
+	add(&models.Silence{AlertRegex: "^os\\."}, "host!=db*,dc=~^ny")
+	// only mute the pager for the databases
+	add(&models.Silence{Alert: "os.cpu", Notifications: []string{"pager"}}, "host=db*")
+
+	silenced := s.Silenced()
+	nsilenced := s.NotificationSilenced()
+	tests := []struct {
+		ak           models.AlertKey
+		silenced     bool
+		notification string
+	}{
+		{"os.cpu{dc=ny1,host=web01}", true, ""},
+		{"os.cpu{dc=lon,host=web01}", false, ""},
+		{"os.cpu{dc=ny1,host=db01}", false, "pager"},
+		{"os.mem{dc=ny1,host=db01}", false, ""},
+	}
+	for _, test := range tests {
+		if got := silenced(test.ak) != nil; got != test.silenced {
+			t.Errorf("%s: expected silenced %v, got %v", test.ak, test.silenced, got)
+		}
+		if test.notification == "" {
+			continue
+		}
+		if nsilenced(test.ak, test.notification) == nil {
+			t.Errorf("%s: expected notification %s to be silenced", test.ak, test.notification)
+		}
+		if nsilenced(test.ak, "chat") != nil {
+			t.Errorf("%s: expected notification chat not to be silenced", test.ak)
+		}
+	}
+	if _, err := s.AddSilence(&models.Silence{Start: now, End: now.Add(time.Hour), Alert: "os.cpu", Notifications: []string{"nope"}}, "", true, ""); err == nil {
+		t.Error("expected error for unknown notification")
+	}
+}
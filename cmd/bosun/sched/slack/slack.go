@@ -24,6 +24,8 @@ func StatusColor(status models.Status) string {
 		return "danger"
 	case models.StUnknown:
 		return "#439FE0"
+	case models.StNoData:
+		return "#9E9E9E"
 	}
 	return ""
 }
@@ -50,6 +52,7 @@ type Attachment struct {
 
 	Fields  []interface{} `json:"fields,omitempty"`
 	Actions []interface{} `json:"actions,omitempty"`
+	Blocks  []interface{} `json:"blocks,omitempty"`
 
 	Footer     string `json:"footer,omitempty"`
 	FooterIcon string `json:"footer_icon,omitempty"`
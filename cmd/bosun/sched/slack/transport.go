@@ -0,0 +1,356 @@
+package slack
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"bosun.org/cmd/bosun/conf"
+	"bosun.org/models"
+	"bosun.org/slog"
+)
+
+func init() {
+	conf.RegisterTransport("slack", transport{})
+}
+
+// The action_id of the buttons of alert messages.
+const (
+	ActionAck     = "bosun_ack"
+	ActionClose   = "bosun_close"
+	ActionSilence = "bosun_silence_1h"
+)
+
+// Threads stores the thread of the messages posted about an incident in a
+// channel.
+type Threads interface {
+	GetThread(incidentId int64, transport, dst string) (string, error)
+	SetThread(incidentId int64, transport, dst, ref string) error
+}
+
+var threads Threads
+
+// SetThreads sets where slack notifications keep the thread of each incident.
+// Without it every notification is posted as a new message.
+func SetThreads(t Threads) {
+	threads = t
+}
+
+// transport posts notifications with the Slack Web API. The first message
+// about an incident in a channel starts a thread. Later alert notifications
+// of the incident update that message and are posted in its thread, as are
+// action notifications. Alert messages have Ack, Close and Silence 1h buttons,
+// which Slack sends to /api/slack/action.
+type transport struct{}
+
+var channelRE = regexp.MustCompile(`^#?[\w.-]{1,80}$`)
+
+func (transport) Validate(dst string) error {
+	if !channelRE.MatchString(dst) {
+		return fmt.Errorf("invalid Slack channel %q", dst)
+	}
+	return nil
+}
+
+// message is the request of chat.postMessage and chat.update.
+type message struct {
+	Channel     string        `json:"channel"`
+	Ts          string        `json:"ts,omitempty"`
+	ThreadTs    string        `json:"thread_ts,omitempty"`
+	Text        string        `json:"text"`
+	Attachments []*Attachment `json:"attachments,omitempty"`
+}
+
+type textObject struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+type block struct {
+	Type     string        `json:"type"`
+	Text     *textObject   `json:"text,omitempty"`
+	Elements []interface{} `json:"elements,omitempty"`
+}
+
+type button struct {
+	Type     string     `json:"type"`
+	Text     textObject `json:"text"`
+	ActionID string     `json:"action_id"`
+	Value    string     `json:"value"`
+	Style    string     `json:"style,omitempty"`
+}
+
+func newButton(text, actionID string, id int64, style string) button {
+	return button{
+		Type:     "button",
+		Text:     textObject{"plain_text", text},
+		ActionID: actionID,
+		Value:    strconv.FormatInt(id, 10),
+		Style:    style,
+	}
+}
+
+var mrkdwnEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+// attachment returns the attachment of a message about p. The body is only
+// added when the notification sets a body template, since the default body
+// is HTML that Slack can not show.
+func attachment(p *conf.PreparedTransport, c conf.SystemConfProvider, id int64, buttons bool) *Attachment {
+	a := &Attachment{
+		Color:    StatusColor(p.Status),
+		Fallback: p.Subject,
+	}
+	if p.Kind() == "unknown" || p.Kind() == "multiunknown" {
+		a.Color = StatusColor(models.StUnknown)
+	}
+	text := mrkdwnEscaper.Replace(p.Subject)
+	if id != 0 {
+		link := c.MakeLink("/incident", &url.Values{"id": []string{fmt.Sprint(id)}})
+		text = fmt.Sprintf("<%s|#%d> %s", link, id, text)
+	}
+	a.Blocks = append(a.Blocks, block{Type: "section", Text: &textObject{"mrkdwn", text}})
+	if p.Details.TemplateKey != "" && p.Body != "" {
+		a.Blocks = append(a.Blocks, block{Type: "section", Text: &textObject{"mrkdwn", p.Body}})
+	}
+	if buttons {
+		a.Blocks = append(a.Blocks, block{Type: "actions", Elements: []interface{}{
+			newButton("Ack", ActionAck, id, "primary"),
+			newButton("Close", ActionClose, id, ""),
+			newButton("Silence 1h", ActionSilence, id, "danger"),
+		}})
+	}
+	return a
+}
+
+func (transport) Send(p *conf.PreparedTransport, c conf.SystemConfProvider) error {
+	sc := c.GetSlackConf()
+	if sc.Token == "" {
+		return fmt.Errorf("SlackConf.Token is not set in the system configuration")
+	}
+	if len(p.Incidents) == 0 {
+		_, err := call(sc, "chat.postMessage", &message{
+			Channel:     p.Destination,
+			Text:        p.Subject,
+			Attachments: []*Attachment{attachment(p, c, 0, false)},
+		})
+		return err
+	}
+	isAlert := p.Kind() == "alert"
+	for _, id := range p.Incidents {
+		channel, ts := threadOf(id, p.Destination)
+		if ts == "" {
+			resp, err := call(sc, "chat.postMessage", &message{
+				Channel:     p.Destination,
+				Text:        p.Subject,
+				Attachments: []*Attachment{attachment(p, c, id, isAlert)},
+			})
+			if err != nil {
+				return err
+			}
+			setThreadOf(id, p.Destination, resp.Channel, resp.Ts)
+			continue
+		}
+		if isAlert {
+			// Show the current status on the first message of the thread.
+			if _, err := call(sc, "chat.update", &message{
+				Channel:     channel,
+				Ts:          ts,
+				Text:        p.Subject,
+				Attachments: []*Attachment{attachment(p, c, id, true)},
+			}); err != nil {
+				return err
+			}
+		}
+		if _, err := call(sc, "chat.postMessage", &message{
+			Channel:     channel,
+			ThreadTs:    ts,
+			Text:        p.Subject,
+			Attachments: []*Attachment{attachment(p, c, 0, false)},
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// threadOf returns the channel id and ts of the thread of an incident in a
+// channel, or "" if there is none.
+func threadOf(id int64, dst string) (channel, ts string) {
+	if threads == nil {
+		return "", ""
+	}
+	ref, err := threads.GetThread(id, "slack", dst)
+	if err != nil {
+		slog.Errorf("getting slack thread of incident %d in %s: %v", id, dst, err)
+		return "", ""
+	}
+	if i := strings.Index(ref, "/"); i > 0 {
+		return ref[:i], ref[i+1:]
+	}
+	return "", ""
+}
+
+func setThreadOf(id int64, dst, channel, ts string) {
+	if threads == nil || channel == "" || ts == "" {
+		return
+	}
+	if err := threads.SetThread(id, "slack", dst, channel+"/"+ts); err != nil {
+		slog.Errorf("setting slack thread of incident %d in %s: %v", id, dst, err)
+	}
+}
+
+// apiResponse is the response of the Web API methods bosun calls.
+type apiResponse struct {
+	OK      bool   `json:"ok"`
+	Error   string `json:"error"`
+	Channel string `json:"channel"`
+	Ts      string `json:"ts"`
+}
+
+func call(sc conf.SlackConf, method string, m *message) (*apiResponse, error) {
+	b, err := json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("POST", strings.TrimSuffix(sc.APIURL, "/")+"/"+method, bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	req.Header.Set("Authorization", "Bearer "+sc.Token)
+	resp, err := conf.NotifyClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %s", method, resp.Status)
+	}
+	r := new(apiResponse)
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(r); err != nil {
+		return nil, fmt.Errorf("%s: %v", method, err)
+	}
+	if !r.OK {
+		return nil, fmt.Errorf("%s: %s", method, r.Error)
+	}
+	return r, nil
+}
+
+// maxRequestAge is how old the timestamp of a callback may be, to prevent
+// replays.
+const maxRequestAge = 5 * time.Minute
+
+// VerifyRequest checks the signature of a request Slack sent with the signing
+// secret of the app.
+func VerifyRequest(secret string, h http.Header, body []byte, now time.Time) error {
+	if secret == "" {
+		return fmt.Errorf("SlackConf.SigningSecret is not set in the system configuration")
+	}
+	ts := h.Get("X-Slack-Request-Timestamp")
+	sec, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil {
+		return fmt.Errorf("bad X-Slack-Request-Timestamp %q", ts)
+	}
+	if d := now.Sub(time.Unix(sec, 0)); d > maxRequestAge || d < -maxRequestAge {
+		return fmt.Errorf("request timestamp is too old")
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(mac, "v0:%s:", ts)
+	mac.Write(body)
+	expected := "v0=" + hex.EncodeToString(mac.Sum(nil))
+	if !hmac.Equal([]byte(expected), []byte(h.Get("X-Slack-Signature"))) {
+		return fmt.Errorf("bad X-Slack-Signature")
+	}
+	return nil
+}
+
+// Callback is a click on a button of an alert message.
+type Callback struct {
+	Action      string // ActionAck, ActionClose or ActionSilence
+	IncidentId  int64
+	User        string
+	ResponseURL string
+}
+
+// ParseCallback parses the body of a block_actions request.
+func ParseCallback(body []byte) (*Callback, error) {
+	v, err := url.ParseQuery(string(body))
+	if err != nil {
+		return nil, err
+	}
+	var payload struct {
+		Type string `json:"type"`
+		User struct {
+			ID       string `json:"id"`
+			Username string `json:"username"`
+			Name     string `json:"name"`
+		} `json:"user"`
+		ResponseURL string `json:"response_url"`
+		Actions     []struct {
+			ActionID string `json:"action_id"`
+			Value    string `json:"value"`
+		} `json:"actions"`
+	}
+	if err := json.Unmarshal([]byte(v.Get("payload")), &payload); err != nil {
+		return nil, fmt.Errorf("bad payload: %v", err)
+	}
+	if payload.Type != "block_actions" || len(payload.Actions) == 0 {
+		return nil, fmt.Errorf("unsupported interaction %q", payload.Type)
+	}
+	a := payload.Actions[0]
+	switch a.ActionID {
+	case ActionAck, ActionClose, ActionSilence:
+	default:
+		return nil, fmt.Errorf("unknown action %q", a.ActionID)
+	}
+	id, err := strconv.ParseInt(a.Value, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("bad incident id %q", a.Value)
+	}
+	user := payload.User.Username
+	if user == "" {
+		user = payload.User.Name
+	}
+	if user == "" {
+		user = payload.User.ID
+	}
+	return &Callback{
+		Action:      a.ActionID,
+		IncidentId:  id,
+		User:        user,
+		ResponseURL: payload.ResponseURL,
+	}, nil
+}
+
+// Respond shows text to the user who clicked the button of a callback.
+func (cb *Callback) Respond(text string) error {
+	b, err := json.Marshal(map[string]interface{}{
+		"response_type":    "ephemeral",
+		"replace_original": false,
+		"text":             text,
+	})
+	if err != nil {
+		return err
+	}
+	resp, err := conf.NotifyClient.Post(cb.ResponseURL, "application/json", bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	io.Copy(ioutil.Discard, io.LimitReader(resp.Body, 512))
+	resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("response_url: %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+package slack
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"bosun.org/cmd/bosun/conf"
+	"bosun.org/models"
+)
+
+type memThreads map[string]string
+
+func (m memThreads) GetThread(id int64, transport, dst string) (string, error) {
+	return m[fmt.Sprint(id, transport, dst)], nil
+}
+
+func (m memThreads) SetThread(id int64, transport, dst, ref string) error {
+	m[fmt.Sprint(id, transport, dst)] = ref
+	return nil
+}
+
+func TestTransportThreads(t *testing.T) {
+	type call struct {
+		method string
+		msg    message
+	}
+	var calls []call
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer xoxb-test" {
+			t.Fatalf("bad authorization %q", r.Header.Get("Authorization"))
+		}
+		var m message
+		if err := json.NewDecoder(r.Body).Decode(&m); err != nil {
+			t.Fatal(err)
+		}
+		calls = append(calls, call{strings.TrimPrefix(r.URL.Path, "/"), m})
+		fmt.Fprintf(w, `{"ok":true,"channel":"C0123","ts":"%d.000100"}`, len(calls))
+	}))
+	defer ts.Close()
+	defer SetThreads(nil)
+	SetThreads(memThreads{})
+
+	c := &conf.SystemConf{Hostname: "bosun.example.com", Scheme: "https", SlackConf: conf.SlackConf{Token: "xoxb-test", APIURL: ts.URL}}
+	send := func(p *conf.PreparedTransport) {
+		if err := conf.GetTransport("slack").Send(p, c); err != nil {
+			t.Fatal(err)
+		}
+	}
+	alert := func(st models.Status) *conf.PreparedTransport {
+		return &conf.PreparedTransport{
+			Transport:   "slack",
+			Destination: "#ops",
+			Subject:     "cpu " + st.String(),
+			Status:      st,
+			Incidents:   []int64{7},
+			Details:     &conf.NotificationDetails{Ak: []string{"cpu{host=web01}"}, NotifyType: 1},
+		}
+	}
+
+	// The first alert starts a thread with buttons.
+	send(alert(models.StWarning))
+	if len(calls) != 1 || calls[0].method != "chat.postMessage" || calls[0].msg.ThreadTs != "" {
+		t.Fatalf("unexpected calls %+v", calls)
+	}
+	if blocks := calls[0].msg.Attachments[0].Blocks; len(blocks) != 2 {
+		t.Fatalf("expected a section and buttons, got %+v", blocks)
+	}
+
+	// Status changes update the first message and reply in its thread.
+	calls = nil
+	send(alert(models.StCritical))
+	if len(calls) != 2 {
+		t.Fatalf("expected an update and a reply, got %+v", calls)
+	}
+	if u := calls[0]; u.method != "chat.update" || u.msg.Channel != "C0123" || u.msg.Ts != "1.000100" || u.msg.Attachments[0].Color != "danger" {
+		t.Fatalf("unexpected update %+v", u)
+	}
+	if r := calls[1]; r.method != "chat.postMessage" || r.msg.ThreadTs != "1.000100" {
+		t.Fatalf("unexpected reply %+v", r)
+	}
+
+	// Actions reply in the thread.
+	calls = nil
+	send(&conf.PreparedTransport{
+		Transport:   "slack",
+		Destination: "#ops",
+		Subject:     "admin Acknowledged",
+		Action:      models.ActionAcknowledge,
+		Incidents:   []int64{7},
+		Details:     &conf.NotificationDetails{Ak: []string{"cpu{host=web01}"}, At: "Acknowledged"},
+	})
+	if len(calls) != 1 || calls[0].msg.ThreadTs != "1.000100" {
+		t.Fatalf("expected a reply, got %+v", calls)
+	}
+}
+
+func TestCallback(t *testing.T) {
+	payload := `{"type":"block_actions","user":{"id":"U1","username":"jdoe"},"response_url":"https://hooks.slack.com/actions/x","actions":[{"action_id":"bosun_ack","value":"42"}]}`
+	body := []byte(url.Values{"payload": {payload}}.Encode())
+	now := time.Unix(1531420618, 0)
+	h := http.Header{}
+	h.Set("X-Slack-Request-Timestamp", "1531420618")
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write([]byte("v0:1531420618:" + string(body)))
+	h.Set("X-Slack-Signature", "v0="+hex.EncodeToString(mac.Sum(nil)))
+
+	if err := VerifyRequest("secret", h, body, now); err != nil {
+		t.Fatal(err)
+	}
+	if err := VerifyRequest("other", h, body, now); err == nil {
+		t.Fatal("expected an error for a bad signature")
+	}
+	if err := VerifyRequest("secret", h, body, now.Add(time.Hour)); err == nil {
+		t.Fatal("expected an error for an old request")
+	}
+	cb, err := ParseCallback(body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cb.Action != ActionAck || cb.IncidentId != 42 || cb.User != "jdoe" || cb.ResponseURL != "https://hooks.slack.com/actions/x" {
+		t.Fatalf("unexpected callback %+v", cb)
+	}
+}
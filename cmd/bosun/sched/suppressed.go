@@ -0,0 +1,48 @@
+package sched
+
+import (
+	"fmt"
+	"time"
+
+	"bosun.org/cmd/bosun/conf"
+	"bosun.org/models"
+	"bosun.org/slog"
+)
+
+// suppressedNoteInterval is how often a note about the suppressed sends of a
+// notification is added to an incident.
+const suppressedNoteInterval = time.Minute
+
+// recordSuppressed adds a note to the timeline of the incidents of the sends
+// of pn that were dropped by a rate limit or merged into an identical send.
+func (s *Schedule) recordSuppressed(pn *conf.PreparedNotifications) {
+	for _, ss := range pn.Suppressed {
+		for _, id := range s.sendIncidents(ss.Incidents, ss.Ak) {
+			if !s.noteSuppressed(fmt.Sprintf("%d:%s:%s", id, pn.Name, ss.Reason)) {
+				continue
+			}
+			msg := fmt.Sprintf("notification %s: %s", pn.Name, ss)
+			if _, err := s.ActionByIncidentId("bosun", msg, models.ActionNote, nil, id); err != nil {
+				slog.Errorf("adding a note to incident %d: %v", id, err)
+			}
+		}
+	}
+}
+
+// noteSuppressed returns whether a note with key should be added now, so a
+// flood of suppressed sends adds a note every suppressedNoteInterval at most.
+func (s *Schedule) noteSuppressed(key string) bool {
+	s.suppressedNotesLock.Lock()
+	defer s.suppressedNotesLock.Unlock()
+	now := utcNow()
+	if last, ok := s.suppressedNotes[key]; ok && now.Sub(last) < suppressedNoteInterval {
+		return false
+	}
+	for k, last := range s.suppressedNotes {
+		if now.Sub(last) >= suppressedNoteInterval {
+			delete(s.suppressedNotes, k)
+		}
+	}
+	s.suppressedNotes[key] = now
+	return true
+}
@@ -0,0 +1,70 @@
+package sched
+
+import (
+	"testing"
+
+	"bosun.org/cmd/bosun/conf"
+	"bosun.org/cmd/bosun/conf/rule"
+	"bosun.org/models"
+	"bosun.org/util"
+)
+
+func TestRecordSuppressed(t *testing.T) {
+	defer setup()()
+	util.InitHostManager("", false) // for the collect metrics
+
+	c, err := rule.NewConf("", conf.EnabledBackends{}, nil, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := initSched(&conf.SystemConf{}, c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ak := models.AlertKey("a{host=x}")
+	id, err := s.DataAccess.State().UpdateIncidentState(&models.IncidentState{
+		AlertKey:      ak,
+		Alert:         ak.Name(),
+		Tags:          ak.Group().Tags(),
+		Start:         utcNow(),
+		Open:          true,
+		WorstStatus:   models.StCritical,
+		CurrentStatus: models.StCritical,
+		Events:        []models.Event{{Status: models.StCritical, Time: utcNow()}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	notes := func() []string {
+		t.Helper()
+		st, err := s.DataAccess.State().GetIncidentState(id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var msgs []string
+		for _, a := range st.Actions {
+			if a.Type == models.ActionNote && a.User == "bosun" {
+				msgs = append(msgs, a.Message)
+			}
+		}
+		return msgs
+	}
+
+	// The incident of a send without incidents is found by its alert key.
+	pn := &conf.PreparedNotifications{Name: "n", Suppressed: []*conf.SuppressedSend{
+		{Transport: "http", Destination: "POST http://example.com/", Reason: conf.SuppressedRateLimit, Ak: []string{string(ak)}},
+	}}
+	s.recordSuppressed(pn)
+	if n := notes(); len(n) != 1 || n[0] != "notification n: http notification to POST http://example.com/ dropped by its rate limit" {
+		t.Fatalf("unexpected notes %q", n)
+	}
+
+	// More drops within a minute add no notes, but merges do.
+	s.recordSuppressed(pn)
+	s.recordSuppressed(&conf.PreparedNotifications{Name: "n", Suppressed: []*conf.SuppressedSend{
+		{Transport: "slack", Destination: "#ops", Reason: conf.SuppressedDuplicate, Incidents: []int64{id}},
+	}})
+	if n := notes(); len(n) != 2 || n[1] != "notification n: slack notification to #ops merged into an identical notification" {
+		t.Fatalf("unexpected notes %q", n)
+	}
+}
@@ -14,15 +14,17 @@ import (
 // Views
 
 type EventSummary struct {
-	Status models.Status
-	Time   int64
+	Status   models.Status
+	Severity *models.Severity `json:",omitempty"`
+	Time     int64
 }
 
 // EventSummary is like a models.Event but strips the Results and Unevaluated
 func MakeEventSummary(e models.Event) (EventSummary, bool) {
 	return EventSummary{
-		Status: e.Status,
-		Time:   e.Time.Unix(),
+		Status:   e.Status,
+		Severity: e.Severity,
+		Time:     e.Time.Unix(),
 	}, e.Unevaluated
 }
 
@@ -52,15 +54,28 @@ type IncidentSummaryView struct {
 	CurrentStatus          models.Status
 	WorstStatus            models.Status
 	LastAbnormalStatus     models.Status
+	CurrentSeverity        *models.Severity `json:",omitempty"`
+	WorstSeverity          *models.Severity `json:",omitempty"`
+	LastAbnormalSeverity   *models.Severity `json:",omitempty"`
 	LastAbnormalTime       models.Epoch
 	Unevaluated            bool
 	NeedAck                bool
 	Silenced               bool
+	Owner                  string            `json:",omitempty"`
+	Team                   string            `json:",omitempty"`
+	Labels                 []string          `json:",omitempty"`
+	Fields                 map[string]string `json:",omitempty"`
 	Actions                []EpochAction
 	Events                 []EventSummary
 	WarnNotificationChains [][]string
 	CritNotificationChains [][]string
-	LastStatusTime         int64
+	// The notification chains of each level of an alert that declares its
+	// own severity levels.
+	SeverityNotificationChains map[string][][]string `json:",omitempty"`
+	LastStatusTime             int64
+
+	// Viewer is the user the summary is filtered for, matched by owner:me.
+	Viewer string `json:"-"`
 }
 
 func MakeIncidentSummary(c conf.RuleConfProvider, s SilenceTester, is *models.IncidentState) (*IncidentSummaryView, error) {
@@ -70,6 +85,29 @@ func MakeIncidentSummary(c conf.RuleConfProvider, s SilenceTester, is *models.In
 	}
 	warnNotifications := alert.WarnNotification.Get(c, is.AlertKey.Group())
 	critNotifications := alert.CritNotification.Get(c, is.AlertKey.Group())
+	if alert.Routed {
+		warnNotifications = routedNotifications(c, alert, is.AlertKey, models.StWarning, nil).Get(c, is.AlertKey.Group())
+		critNotifications = routedNotifications(c, alert, is.AlertKey, models.StCritical, nil).Get(c, is.AlertKey.Group())
+	}
+	warnChains := conf.GetNotificationChains(warnNotifications)
+	critChains := conf.GetNotificationChains(critNotifications)
+	if alert.WarnEscalation != nil {
+		warnChains = append(warnChains, escalationChain(alert.WarnEscalation, utcNow()))
+	}
+	if alert.CritEscalation != nil {
+		critChains = append(critChains, escalationChain(alert.CritEscalation, utcNow()))
+	}
+	var sevChains map[string][][]string
+	if len(alert.Severities) > 0 {
+		sevChains = make(map[string][][]string)
+		for _, l := range alert.Severities {
+			ns := l.Notification
+			if alert.Routed {
+				ns = routedNotifications(c, alert, is.AlertKey, models.StNone, &l.Severity)
+			}
+			sevChains[l.Name] = conf.GetNotificationChains(ns.Get(c, is.AlertKey.Group()))
+		}
+	}
 	eventSummaries := []EventSummary{}
 	nonNormalNonUnknownCount := 0
 	for _, event := range is.Events {
@@ -88,7 +126,7 @@ func MakeIncidentSummary(c conf.RuleConfProvider, s SilenceTester, is *models.In
 	// There is no rendered subject when the state is unknown and
 	// there is no other non-normal status in the history.
 	if subject == "" && nonNormalNonUnknownCount == 0 {
-		subject = fmt.Sprintf("%s: %v", is.CurrentStatus, is.AlertKey)
+		subject = fmt.Sprintf("%s: %v", is.Level(), is.AlertKey)
 	}
 	return &IncidentSummaryView{
 		Id:                     is.Id,
@@ -100,15 +138,24 @@ func MakeIncidentSummary(c conf.RuleConfProvider, s SilenceTester, is *models.In
 		CurrentStatus:          is.CurrentStatus,
 		WorstStatus:            is.WorstStatus,
 		LastAbnormalStatus:     is.LastAbnormalStatus,
+		CurrentSeverity:        is.CurrentSeverity,
+		WorstSeverity:          is.WorstSeverity,
+		LastAbnormalSeverity:   is.LastAbnormalSeverity,
 		LastAbnormalTime:       is.LastAbnormalTime,
 		Unevaluated:            is.Unevaluated,
 		NeedAck:                is.NeedAck,
 		Silenced:               s(is.AlertKey) != nil,
+		Owner:                  is.Owner,
+		Team:                   is.Team,
+		Labels:                 is.Labels,
+		Fields:                 is.Fields,
 		Actions:                actions,
 		Events:                 eventSummaries,
-		WarnNotificationChains: conf.GetNotificationChains(warnNotifications),
-		CritNotificationChains: conf.GetNotificationChains(critNotifications),
+		WarnNotificationChains: warnChains,
+		CritNotificationChains: critChains,
 		LastStatusTime:         is.Last().Time.Unix(),
+
+		SeverityNotificationChains: sevChains,
 	}, nil
 }
 
@@ -188,6 +235,33 @@ func (is IncidentSummaryView) Ask(filter string) (bool, error) {
 		}
 	case "name":
 		return glob.Glob(value, is.AlertName), nil
+	case "owner":
+		switch value {
+		case "me":
+			return is.Viewer != "" && is.Owner == is.Viewer, nil
+		case "none":
+			return is.Owner == "" && is.Team == "", nil
+		}
+		return is.Owner != "" && glob.Glob(value, is.Owner), nil
+	case "team":
+		if value == "none" {
+			return is.Team == "", nil
+		}
+		return is.Team != "" && glob.Glob(value, is.Team), nil
+	case "label":
+		for _, l := range is.Labels {
+			if glob.Glob(value, l) {
+				return true, nil
+			}
+		}
+		return false, nil
+	case "field":
+		sp := strings.SplitN(value, "=", 2)
+		v, ok := is.Fields[sp[0]]
+		if len(sp) == 1 || !ok {
+			return ok, nil
+		}
+		return glob.Glob(sp[1], v), nil
 	case "user":
 		for _, action := range is.Actions {
 			if glob.Glob(value, action.User) {
@@ -210,6 +284,15 @@ func (is IncidentSummaryView) Ask(filter string) (bool, error) {
 				}
 			}
 		}
+		for _, chains := range is.SeverityNotificationChains {
+			for _, chain := range chains {
+				for _, sn := range chain {
+					if glob.Glob(value, sn) {
+						return true, nil
+					}
+				}
+			}
+		}
 		return false, nil
 	case "silenced":
 		switch value {
@@ -231,12 +314,12 @@ func (is IncidentSummaryView) Ask(filter string) (bool, error) {
 		default:
 			return false, fmt.Errorf("unknown %s value: %s", key, value)
 		}
-	case "status": // CurrentStatus
-		return is.CurrentStatus.String() == value, nil
+	case "status": // CurrentStatus, or the name of its severity level
+		return is.CurrentStatus.String() == value || is.CurrentStatus.Label(is.CurrentSeverity) == value, nil
 	case "worstStatus":
-		return is.WorstStatus.String() == value, nil
+		return is.WorstStatus.String() == value || is.WorstStatus.Label(is.WorstSeverity) == value, nil
 	case "lastAbnormalStatus":
-		return is.LastAbnormalStatus.String() == value, nil
+		return is.LastAbnormalStatus.String() == value || is.LastAbnormalStatus.Label(is.LastAbnormalSeverity) == value, nil
 	case "subject":
 		return glob.Glob(value, is.Subject), nil
 	case "since":
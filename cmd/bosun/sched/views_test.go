@@ -0,0 +1,105 @@
+package sched
+
+import (
+	"testing"
+
+	"bosun.org/cmd/bosun/conf"
+	"bosun.org/cmd/bosun/conf/rule"
+	"bosun.org/models"
+
+	"github.com/kylebrandt/boolq"
+)
+
+func TestIncidentEdit(t *testing.T) {
+	defer setup()()
+	c, err := rule.NewConf("", conf.EnabledBackends{}, nil, `
+		alert a {
+			crit = 1
+		}
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := initSched(&conf.SystemConf{}, c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ak := models.AlertKey("a{host=x}")
+	id, err := s.DataAccess.State().UpdateIncidentState(&models.IncidentState{
+		AlertKey:      ak,
+		Alert:         ak.Name(),
+		Tags:          ak.Group().Tags(),
+		Start:         utcNow(),
+		Open:          true,
+		NeedAck:       true,
+		WorstStatus:   models.StCritical,
+		CurrentStatus: models.StCritical,
+		Events:        []models.Event{{Status: models.StCritical, Time: utcNow()}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	edit := func(at models.ActionType, e models.IncidentEdit) {
+		t.Helper()
+		if _, err := s.EditByIncidentId("u", "", at, e, id); err != nil {
+			t.Fatal(err)
+		}
+	}
+	edit(models.ActionAssign, models.IncidentEdit{Owner: "alice", Team: "dba"})
+	edit(models.ActionLabel, models.IncidentEdit{Labels: []string{"db", "network"}, Fields: map[string]string{"ticket": "OPS-1"}})
+	edit(models.ActionLabel, models.IncidentEdit{Labels: []string{"-network"}})
+	if _, err := s.EditByIncidentId("u", "", models.ActionLabel, models.IncidentEdit{Labels: []string{"bad label"}}, id); err == nil {
+		t.Fatal("expected an error for an invalid label")
+	}
+	if _, err := s.EditByIncidentId("u", "", models.ActionNote, models.IncidentEdit{}, id); err == nil {
+		t.Fatal("expected an error for an action that does not edit incidents")
+	}
+
+	st, err := s.DataAccess.State().GetIncidentState(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(st.Actions) != 3 || st.Actions[0].Type != models.ActionAssign || st.Actions[0].Edit.Owner != "alice" {
+		t.Fatalf("expected the edits to be recorded as actions, got %+v", st.Actions)
+	}
+	is, err := MakeIncidentSummary(c, func(models.AlertKey) *models.Silence { return nil }, st)
+	if err != nil {
+		t.Fatal(err)
+	}
+	is.Viewer = "alice"
+	for filter, expected := range map[string]bool{
+		"owner:me":                  true,
+		"owner:al*":                 true,
+		"owner:none":                false,
+		"team:dba":                  true,
+		"label:db":                  true,
+		"label:network":             false,
+		"field:ticket":              true,
+		"field:ticket=OPS-*":        true,
+		"field:ticket=X":            false,
+		"owner:me AND !label:db":    false,
+		"team:none OR label:d*":     true,
+		"field:missing OR ack:true": false,
+	} {
+		match, err := boolq.AskExpr(filter, is)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if match != expected {
+			t.Errorf("%s: expected %v, got %v", filter, expected, match)
+		}
+	}
+	is.Viewer = "bob"
+	if match, _ := is.Ask("owner:me"); match {
+		t.Error("owner:me matched another user")
+	}
+
+	edit(models.ActionAssign, models.IncidentEdit{})
+	st, err = s.DataAccess.State().GetIncidentState(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if st.Owner != "" || st.Team != "" || len(st.Labels) != 1 || st.Fields["ticket"] != "OPS-1" {
+		t.Fatalf("expected the incident to be unassigned and keep its labels, got %q %q %v %v", st.Owner, st.Team, st.Labels, st.Fields)
+	}
+}
@@ -246,6 +246,7 @@ func ExprGraph(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (in
 		ElasticHosts:      schedule.SystemConf.GetElasticContext(),
 		AzureMonitor:      schedule.SystemConf.GetAzureMonitorContext(),
 		PromConfig:        schedule.SystemConf.GetPromContext(),
+		Guard:             schedule.SystemConf.GetBackendGuard(),
 		CloudWatchContext: schedule.SystemConf.GetCloudWatchContext(),
 	}
 	providers := &expr.BosunProviders{
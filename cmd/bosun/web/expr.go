@@ -88,6 +88,7 @@ func Expr(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (v inter
 		ElasticHosts:      schedule.SystemConf.GetElasticContext(),
 		AzureMonitor:      schedule.SystemConf.GetAzureMonitorContext(),
 		PromConfig:        schedule.SystemConf.GetPromContext(),
+		Guard:             schedule.SystemConf.GetBackendGuard(),
 		CloudWatchContext: schedule.SystemConf.GetCloudWatchContext(),
 	}
 	providers := &expr.BosunProviders{
@@ -151,11 +152,17 @@ func procRule(t miniprofiler.Timer, ruleConf conf.RuleConfProvider, a *conf.Aler
 		return nil, err
 	}
 	rh := s.NewRunHistory(now, cacheObj)
-	if _, err, _ := s.CheckExpr(t, rh, a, a.Warn, models.StWarning, nil); err != nil {
-		return nil, err
-	}
-	if _, err, _ := s.CheckExpr(t, rh, a, a.Crit, models.StCritical, nil); err != nil {
-		return nil, err
+	if len(a.Severities) > 0 {
+		if _, _, err, _ := s.CheckSeverities(t, rh, a); err != nil {
+			return nil, err
+		}
+	} else {
+		if _, err, _ := s.CheckExpr(t, rh, a, a.Warn, models.StWarning, nil); err != nil {
+			return nil, err
+		}
+		if _, err, _ := s.CheckExpr(t, rh, a, a.Crit, models.StCritical, nil); err != nil {
+			return nil, err
+		}
 	}
 	keys := make(models.AlertKeys, len(rh.Events))
 	criticals, warnings, normals := make([]models.AlertKey, 0), make([]models.AlertKey, 0), make([]models.AlertKey, 0)
@@ -221,6 +228,8 @@ func procRule(t miniprofiler.Timer, ruleConf conf.RuleConfProvider, a *conf.Aler
 		primaryIncident.Start = time.Now().UTC()
 		primaryIncident.CurrentStatus = e.Status
 		primaryIncident.LastAbnormalStatus = e.Status
+		primaryIncident.CurrentSeverity = e.Severity
+		primaryIncident.LastAbnormalSeverity = e.Severity
 		primaryIncident.LastAbnormalTime = models.Epoch{Time: time.Now().UTC()}
 		func() {
 			defer func() {
@@ -253,7 +262,7 @@ func procRule(t miniprofiler.Timer, ruleConf conf.RuleConfProvider, a *conf.Aler
 			n := conf.Notification{
 				Email: []*mail.Address{m},
 			}
-			n.PrepareAlert(rt, string(primaryIncident.AlertKey), rt.Attachments...).Send(s.SystemConf)
+			n.PrepareAlert(rt, primaryIncident, rt.Attachments...).Send(s.SystemConf)
 		}
 		nots, aNots = buildNotificationPreviews(a, rt, primaryIncident, s.SystemConf, ruleConf)
 		data = s.Data(rh, primaryIncident, a, false)
@@ -284,9 +293,14 @@ func buildNotificationPreviews(a *conf.Alert, rt *models.RenderedTemplates, inci
 	for name, not := range a.WarnNotification.GetAllChained() {
 		nots[name] = not
 	}
+	for _, l := range a.Severities {
+		for name, not := range l.Notification.GetAllChained() {
+			nots[name] = not
+		}
+	}
 
 	for name, not := range nots {
-		previews[name] = not.PrepareAlert(rt, string(incident.AlertKey), attachments...)
+		previews[name] = not.PrepareAlert(rt, incident, attachments...)
 		actions := map[string]*conf.PreparedNotifications{}
 		actionPreviews[name] = actions
 		// for all action types. just loop through known range. Update this if any get added
@@ -320,6 +334,9 @@ func TestHTTPNotification(t miniprofiler.Timer, w http.ResponseWriter, r *http.R
 	if err := dec.Decode(prep); err != nil {
 		return nil, err
 	}
+	if err := testHTTPAuth(prep); err != nil {
+		return nil, err
+	}
 	code, err := prep.Send()
 	dat := &struct {
 		Error  string
@@ -331,6 +348,38 @@ func TestHTTPNotification(t miniprofiler.Timer, w http.ResponseWriter, r *http.R
 	return dat, nil
 }
 
+// testHTTPAuth replaces the credentials of prep, which come from the client,
+// with those and the headers of the running notification it was prepared for.
+// They are only used for the host of the configured url, so a test can not
+// send them elsewhere.
+func testHTTPAuth(prep *conf.PreparedHttp) error {
+	prep.Auth = nil
+	if prep.Details == nil {
+		return nil
+	}
+	n := schedule.RuleConf.GetNotification(prep.Details.NotifyName)
+	if n == nil || (n.HTTPAuth == nil && len(n.Headers) == 0) {
+		return nil
+	}
+	configured := n.Post
+	if prep.Method == http.MethodGet {
+		configured = n.Get
+	}
+	if configured == nil {
+		return fmt.Errorf("notification %s has credentials or headers and a templated url, which can not be tested", n.Name)
+	}
+	u, err := url.Parse(prep.URL)
+	if err != nil {
+		return err
+	}
+	if u.Scheme != configured.Scheme || u.Host != configured.Host {
+		return fmt.Errorf("notification %s only sends its credentials to %s://%s", n.Name, configured.Scheme, configured.Host)
+	}
+	prep.Auth = n.HTTPAuth
+	n.SetHeaders(prep)
+	return nil
+}
+
 func Rule(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
 	var from, to time.Time
 	var err error
@@ -508,6 +557,42 @@ func Rule(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interfa
 	return &ret, nil
 }
 
+// ReplayRule replays alerts of the posted rule configuration, or of the
+// running configuration when the body is empty, over a historical range.
+func ReplayRule(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	var c conf.RuleConfProvider = schedule.RuleConf
+	if strings.TrimSpace(string(body)) != "" {
+		c, err = rule.NewConf("Replay Config", schedule.SystemConf.EnabledBackends(), schedule.SystemConf.GetRuleVars(), string(body))
+		if err != nil {
+			return nil, err
+		}
+	}
+	opts := sched.ReplayOptions{
+		CloseOnNormal: r.FormValue("closeOnNormal") == "true",
+	}
+	if a := r.FormValue("alert"); a != "" {
+		opts.Alerts = strings.Split(a, ",")
+	}
+	if opts.From, err = time.Parse(tsdbFormatSecs, r.FormValue("from")); err != nil {
+		return nil, fmt.Errorf("bad from: %v", err)
+	}
+	if opts.To, err = time.Parse(tsdbFormatSecs, r.FormValue("to")); err != nil {
+		return nil, fmt.Errorf("bad to: %v", err)
+	}
+	if st := r.FormValue("step"); st != "" {
+		d, err := opentsdb.ParseDuration(st)
+		if err != nil {
+			return nil, err
+		}
+		opts.Step = time.Duration(d)
+	}
+	return sched.Replay(schedule.SystemConf, c, schedule.Search, opts)
+}
+
 func buildConfig(r *http.Request) (c conf.RuleConfProvider, a *conf.Alert, hash string, err error) {
 	config, err := ioutil.ReadAll(r.Body)
 	if err != nil {
@@ -7,6 +7,7 @@ import (
 	"bosun.org/cmd/bosun/sched"
 
 	"github.com/MiniProfiler/go/miniprofiler"
+	"github.com/gorilla/mux"
 	"github.com/kylebrandt/boolq"
 )
 
@@ -32,6 +33,7 @@ func ListOpenIncidents(t miniprofiler.Timer, w http.ResponseWriter, r *http.Requ
 		if err != nil {
 			return nil, err
 		}
+		is.Viewer = filterUser(r)
 		match, err := boolq.AskParsedExpr(parsedExpr, is)
 		if err != nil {
 			return nil, err
@@ -42,3 +44,11 @@ func ListOpenIncidents(t miniprofiler.Timer, w http.ResponseWriter, r *http.Requ
 	}
 	return summaries, nil
 }
+
+func ListProblems(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	return schedule.Problems()
+}
+
+func GetProblem(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	return schedule.GetProblem(mux.Vars(r)["id"])
+}
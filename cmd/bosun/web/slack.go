@@ -0,0 +1,99 @@
+package web
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"bosun.org/cmd/bosun/sched/slack"
+	"bosun.org/models"
+	"bosun.org/slog"
+)
+
+// SlackAction handles the buttons of slack notifications. The request must be
+// signed with the signing secret of the Slack app.
+func SlackAction(w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(io.LimitReader(r.Body, 1<<20))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err := slack.VerifyRequest(schedule.SystemConf.GetSlackConf().SigningSecret, r.Header, body, time.Now()); err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+	cb, err := slack.ParseCallback(body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	// Slack only waits three seconds for the response, and the result is
+	// posted to the response_url of the callback.
+	w.WriteHeader(http.StatusOK)
+	go func() {
+		text, err := slackAction(cb)
+		if err != nil {
+			text = fmt.Sprintf("Could not update incident #%d: %v", cb.IncidentId, err)
+		}
+		if err := cb.Respond(text); err != nil {
+			slog.Errorf("responding to slack action %s by %s: %v", cb.Action, cb.User, err)
+		}
+	}()
+}
+
+func slackAction(cb *slack.Callback) (string, error) {
+	switch cb.Action {
+	case slack.ActionAck:
+		if err := slackIncidentAction(cb, models.ActionAcknowledge, "Acknowledged from Slack"); err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("Acknowledged incident #%d", cb.IncidentId), nil
+	case slack.ActionClose:
+		if err := slackIncidentAction(cb, models.ActionClose, "Closed from Slack"); err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("Closed incident #%d", cb.IncidentId), nil
+	case slack.ActionSilence:
+		st, err := schedule.DataAccess.State().GetIncidentState(cb.IncidentId)
+		if err != nil {
+			return "", err
+		}
+		if st == nil {
+			return "", fmt.Errorf("no incident with id: %v", cb.IncidentId)
+		}
+		now := time.Now().UTC()
+		si := &models.Silence{
+			Start:   now,
+			End:     now.Add(time.Hour),
+			Alert:   st.Alert,
+			User:    cb.User,
+			Message: fmt.Sprintf("Silenced from Slack for incident #%d", cb.IncidentId),
+		}
+		if _, err := schedule.AddSilence(si, st.AlertKey.Group().Tags(), true, ""); err != nil {
+			return "", err
+		}
+		// Leave a note, so the silence shows on the timeline of the incident.
+		if _, err := schedule.ActionByIncidentId(cb.User, "Silenced for 1h from Slack", models.ActionNote, nil, cb.IncidentId); err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("Silenced %s for 1h", st.AlertKey), nil
+	}
+	return "", fmt.Errorf("unknown action %s", cb.Action)
+}
+
+// slackIncidentAction performs an action on the incident of cb and sends the
+// action notifications, like actions taken in bosun do, so PagerDuty and the
+// other notifications of the incident learn about it.
+func slackIncidentAction(cb *slack.Callback, at models.ActionType, msg string) error {
+	ak, err := schedule.ActionByIncidentId(cb.User, msg, at, nil, cb.IncidentId)
+	if err != nil {
+		return err
+	}
+	if err := schedule.ActionNotify(at, cb.User, msg, []models.AlertKey{ak}); err != nil {
+		// the action itself succeeded
+		slog.Errorf("sending %v notifications for incident #%d: %v", at, cb.IncidentId, err)
+	}
+	return nil
+}
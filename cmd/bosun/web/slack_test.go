@@ -0,0 +1,79 @@
+package web
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"bosun.org/cmd/bosun/conf"
+	"bosun.org/cmd/bosun/conf/rule"
+	"bosun.org/cmd/bosun/sched/slack"
+	"bosun.org/models"
+	"bosun.org/util"
+)
+
+func TestSlackActionNotifies(t *testing.T) {
+	util.InitHostManager("", false) // for the collect metrics
+	posts := make(chan string, 1)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		posts <- string(b)
+	}))
+	defer ts.Close()
+	c, err := rule.NewConf("", conf.EnabledBackends{}, nil, fmt.Sprintf(`
+		template t {
+			subject = s
+			body = b
+		}
+		notification n {
+			post = %s
+			runOnActions = Ack
+		}
+		alert slack_action {
+			template = t
+			crit = 1
+			critNotification = n
+		}
+	`, ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := schedule.Init("slack_test", &conf.SystemConf{}, c, testData, nil, false, false); err != nil {
+		t.Fatal(err)
+	}
+	ak := models.AlertKey("slack_action{host=a}")
+	id, err := testData.State().UpdateIncidentState(&models.IncidentState{
+		AlertKey:      ak,
+		Alert:         ak.Name(),
+		Tags:          ak.Group().Tags(),
+		Start:         time.Now().UTC(),
+		Open:          true,
+		NeedAck:       true,
+		WorstStatus:   models.StCritical,
+		CurrentStatus: models.StCritical,
+		Notifications: []string{"n"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	text, err := slackAction(&slack.Callback{Action: slack.ActionAck, IncidentId: id, User: "slacker"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if text != fmt.Sprintf("Acknowledged incident #%d", id) {
+		t.Errorf("unexpected response %q", text)
+	}
+	select {
+	case body := <-posts:
+		if !strings.Contains(body, "slacker Acknowledged") {
+			t.Errorf("expected the action notification of the ack, got %q", body)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected an action notification for the ack")
+	}
+}
@@ -21572,492 +21572,512 @@ rW84lvhBpLIYXuBt0AANun2s92afrt6bj7Slx5SZFYhY/78AAAD//zo639oEkAAA
 
 	"/js/bosun.js": {
 		local:   "web/static/js/bosun.js",
-		size:    153746,
+		size:    160483,
 		modtime: 0,
 		compressed: `
-H4sIAAAAAAAC/+x9f3fbNpbo35tPgXAyIVXLlJ02nY4dpS9N0kl2m043Pzrb5/h5IRKSWFMEA0Cy1cTf
-/R1cgCRIAiRlu53snsk5rUUSuLgALi4u7i9MJhP0iJE5YSSLCMqxWE69FV2RTIRxKLiHJo/vdBTaj9cM
-i4Rm+3PKVrhW6TXJGeEkExzhDOG1WCJBz0l2Z4MZeit/oSmafPEF+j9RijlHX0xQMF9nkQSHghH6eAch
-hMo3UKN8Lf+JZcLDZ4RHLMmhyBR53nH982uaEjRFB43X7zhhRvEr+D8jYs10Q8d3roLR6PjOZLIiAsdY
-YIRndC0QRjzJFilBTEKmDOWErRLOE6o69l0iXhGBd+qarlN+qKGjP1YI4TSFxvmkapqjOWVoRvlaYSG7
-/YzM+U5oFJXseBRfK0TQG0LQisYk5ZMki5JYUs2CoucbkgkURDjzBZoRROB5SRhBMxLhNSfo39+gNScc
-iSUWI8D4pQagKu+Cdq1mkJAWifyM07UkgoSonw1aeH6ZM/VV/mp8fCOwWHP1Wf1uFHibrDRs+atJZhnZ
-4HSNBYlVGeOFhfJqPSnGWQ7OkyyjAmsaHz4yVbUAj9GCCHNoJIli9OkT+njVwPqlRBbLP58+tVfUK8I5
-XhAoUvy2lXsjMBPPsFAlqydb2edZXJYsftvKPWUEuqOXL66/sNV4x1IoKP9asaRrFmkU1U9ZChbS/jpp
-ln5BuYCy8MMG7+8XmUZN/fr0Cd1dEIHu35fjD++Ckb1vWJAFZVvVr+LBKKlIpZrTMGdUULHNSciJkNT3
-7u1TNEVtgpD/JBll9AJNkeLcwShciygYhYpxByJZke/h56hjIjN64Zy68pumpy5Md0Hzd0NQr7oKT3PJ
-vSZ8ne7GiFSVgDkZEOviP8xgP3XGC2Br7CDanRVEig20cCuXkvzRv9Tt3A/bmN/bba6/bfPmt2cEx2mS
-qe/FQ2tBZBFJUxLrFaGfGqW+X6fpPCmLVY+WkVSD0LuB1fYjyfTJtfYjqBkkvDXkwF/hr42Q1Tf42aZk
-9fF51qz5JCVM/AfZqu/Fk62QUeLYsU3yzm2S27ZJ2LHkNpmRC/SEMbwNjFWazFFQFjKHA8aNMhTIET9L
-pKA2RmdYNwPFj+X7R+gMhynJFmIpn/f2mkAK1iHxP8MnZ8npceu7gWiYr/kykLjWhQcyGtXrXd1p/1Jj
-CXPt7LFaAHjBVVfkr9ZwaBDu8Zjp8Yj0nKnyx/LDI3QWVQMycw+IHMuz6ORs5hoQDbUakZJTDB2KN+vZ
-ryQq6FY9NEbiR0LiJ9G5KqIfmhtnDucB/atbjuJtOariGmvG9OJb69GvvWoU/wdlvFbYeNEo+gPm4sks
-k1tQatZov++oWIiL7beNSj8xsknomr+M+5aVUdJNS7GmJd24UedYfnyEzkhFT7GbnhIJ6IycnMUugjJA
-K6JK4qGU9CO5FAVzVL9NJl7jq4ZI8ZL/RLI4yRZPU8rdkoWdz5hrYBirgVVVbMA2XiOnRRWotrm7U7TO
-YjJPMhJLWfBuUcLYvj590nCrvW5kmwa9mQlmsmjXuOrCc5xyYhN+aoNqyhjw4m+MrvOdNr+qWsAXrZ1P
-DvRGThFf6N+u4xZf2I9bzeXNF53L+02SkiwCriEh6qfmeYc/Z4wyVUY/HLt4nARj5XHF7iq7ZtldjR26
-KGHZoRdUf1/Q+jrX40GaBFGOGtFcoi59lNWOXWvu6TJJYwa818lk5CDrYsN2b6PCbvt31Ld/F2Cr/cqg
-uKhvz6pJg1VFk+7hxW56E1UlaJN7te05h3ZRFBo2sGXx3YaVL/rGVYO1Ditf7CAWnWf0IiXxAtZcR7fN
-ksNEoHqd3cQgNQDdclAF+5qjUKMuRRR2jsqvyVJ5wBft40QJUiJcldPvR5YT25PySCzLGW+aZyucpEm2
-AGbFdenau5aYFsktOAYOWlSovzzuWoeGbtGiccbZYp1i1qOX1qX2GV0LMrAsx1kikt/6is8oFVwwnPeU
-+/XDmrBtTyEpDjAeUUZ6Fe3yfFoUkYQE2qknudyYizFZ0XidksAvPvljdAJj7GeL13Ik/LF6hAJPaSYY
-TVPCePF+tYgYwWG2eCM7aH8bCkpTkZRfs8UbPXDFm3US4ohU36M0yWcUs9gf3zkdHd8p0Asjms2TRXDi
-34N5+onRTRIT5o+Rfy+lEWhjai+XQuTGi2q51AGMUav6GNUqm8unVTZcilX68BWNSVDnIyTDs5TERyB1
-je/UxbEP64SR7zAnR0rOqtiCsfjkxF0sYZ+tkF+PEWuyrHqHQllHlTO28OonfPYn/rgpFyQiJUfIf4b5
-spiB2neyylMsyDuWHiE/x0wkOOWTuCgOI9GoE5VkYwJ+KphZ0OyzRi4RZMWdGL5UX4dgB4B6MQOA/ViR
-y5w5kXp+mTMClpVhmElgvYhJoAPwAj7pxkx/HoQVlO3HSxbrR2zBcL504vU39XUIWgCoFysA2I/VknLh
-RArU9D8n5GIYYhJWL14SZgMtxQZSiuO/Z28IZtFSc4JOzLk6jTiRf1N8H4K6BtaLvQbaP66KQzuRewqf
-tfF3GIoKYi+GCvKtjLA6Tjv78CQajrw+mfchr0AOoNqEC8q2btTgMPmiKDWIeFXhfvpV5fpxzNeiY1sR
-GD3PxFDk8nX/wvppLfqRwqWxxj12RpFBU1vZqXqntyw6YNfTB3H3xlcWGLT3FfaJ3u1PF+zHELwxeMfy
-iAjnyh3CteEcIe8RgNlPEy4eP5oYD96AxieZ5M0OBH4EbXSFRAcOGbnYB4iPH02q3w4MGhIWFUvCLhLe
-lPkYiRNGIvGWHiEpYdlhmbJlmGSCsIjkQm67cIg0BNUPTUlPn3/aJ1ZfypSEC//IkBUV+7QdcDUJAHMl
-WB4rTvz/2n+VZEnO6DxJCfNP0RT5Unb1j63VNSoKSrvIVX3kDfmzGIurmnjP1pmU7QvxGiR4Rql4E9Gc
-1MX3oswYVSVqMnr5NrxHs0AfGJ4ucbYgb9ZAHTWA4GwyRpFSCo5RrtXRFjG7gAv0hqZFnfCeakO9P3bV
-4kvKRJpk51Kir9SrrUEpT2zGqct1cqsdzNBJOabG+7Ba7IH/HXyEhY5O/HtcD68epPLgBD8+tGbBx2ux
-fEPYJimlDGNiANhYLxZ9iBqjex/MiRqjJxWI2qzx32HGFEwuaB5IUh4d21ekKoYLVXPV0KYJMZmj4K6e
-bN18h8I9W6epSwFUQKsDC6XAQuK3FUdH06nB0320hzZoD/mKqXe0/RGp/qgDqLkA23hY0b1qDVCSJaI2
-PJwIkWQL57jjDXmujsFoiorC4Zvq9bGtmt5dbVWf1D9Zq39YJ0SYlf5TvrAW3RDGlVWmLPyzemUtTnOS
-CR7PKsTqcMJX+FfK0N0pOkD377c+Jpn+aAVOLvEqT0l1jDSxet78aAWhNkjLsL01P9jHfC2WaGquzXox
-40P4MktEUE3JWiw15HHV4jtOWIZXxHj1mqaEG88/Va6Ioy6q+5XTrHtRavL99zd//zHkgiXZIplvg80Y
-CHqMfIT8zhZmguJBLZAsojF59/rlU7rKaUYyEci6wWbUCV9Vu24Lm07YjHw4mzO6OlvV4K9sNkhWanxx
-vnytBIagoaGW5T7ocv+5JmwbwE7VKPUhXBHBkghN0ar+hYUf1oQlRAszHxoVdTdZV5dynJH0Kei5a8wG
-rHXA7OfJpY0xqy9oOp2iDU1idDBCH1HxEnkAd987bjA/fpGIaFnAt3HUCHOCvIglIolw6h0VvdCg95AX
-y52KeceOquvsPKMXma1mks2ps94FZlmSLWz1ik+uqspLwFaTqw3VWRNUQDt1MiZzvE6FrYr64jmtIK3J
-3+B0TeTEf2x/40SbQyuiOCfbMYI6NoLYKP+kqWFKt81vTFIiSB2Dk3OyPe3aM0nKiQVWGwiaKgSHj8HC
-2k8Hx+jC+qquTObRkkjR8fskFabnXslK5ozwZa3dORS1MRPY/j6EMZkT1mQi9YYkwEaLtd0+WWFBbHwo
-l01IIVKOiDzJ58kEgxXpWwVwKsUhC8tUX5Un7GjUmqNQLwHjqBVjgUfu6Sw6pBmjaV+DmseuiiJZEZzF
-sbKdybJ241lJiiEjnKab1nhcWboBK9XoBGHMSt4hI7+SSMD3JtT6cx7Okwyn6TYwRGc7C4/DnNFVUjvE
-VD8nE/Qm+Y0gOkdiSVBKFxQlGQouklgsEc5itCTJYilGRQk4l5Tl5JsMb2aY1Qn4NzRFDx7WqZqyZIGm
-6C8HB/X3qYQvD7F/+mqGH8R/9eufY8zO4evh/OGDv37d+LoCAcr/05cPvyaz1kfl5st/QxNovf51tmA4
-BjzRF1C0/jlKWJQChzupDevJ4cODMYL/SdRO6+fnk4edX+EDFIFeWytbP582OMRGDmX8ZchJKinG/5Oc
-Eb9OeyHOc5LFgc83i9YnIVjgq7n1x4j/Zv0OVKA+V+3zzUI3+yRNA5+RSISzVgNyCQUnJ1VX0AnMwwM9
-MKeN8iQTkj/ZOyDbsPcAzOzywDtzdNHoQs8ISOSsZdilP1bUYv+87fw8T9K0diiWW1u5OE8OT4+bPENX
-3HbUOoBazjnJsViGcYJXNIvtE1MQ4k7TIMHaRzlu4draJORK8l8huRPEesHJ8zG8OHx40FqDpWVVLtED
-+3eO9qbIRykAuSjBXXSU2t+5mP75f30re+WmqqJj/lfETh5cMHoOKpuLZSKI31Fov6Dlw4Jj9axKK0Rj
-/r+8DhEMWIsdPSm6ED7sXo7+4cHBn/2uAe1q5dK9dAo66lo+ivVbB07vCrsMmB2aHrLLzkVuIGutve1k
-LD21mT9GXz8IS1q6BvN64GRe1yHrBxaylhxAMJzxRLb/TFslpRjxsCFGaPn0KV1DkOFBW22oCji9iCFy
-zgCyt2dx/q01MkWHVjmOPrHLytazRImMUc2mzDSbdqsTu05CUlxYrufzlJRk3OCAA5ZBaynUqGOMEoNA
-kmOrOFzNZ2CDrec4aE+7pfSNltHNl9KuS6Ux3ipijq5FUE7+2ELuVhdfQ/CvkTROUxv94DRtKFzgjbZv
-WPTdFjjNFYJaOlLL0bu1bB5D852A9/cHLBwQKpYUPLPvBf6fSquNP5LyUWug5OeGk1ZLH944xOo6/miH
-k6jsL5wfk9hl0QOwoVZ6IF3Ybr1zWKXaEQIWmnL3s40MJ+INrPyEZq9xtiABnGAAM+2GO7I3eGV5f+U8
-vF41zGeCxzN5wlbhn1JE/OWXX36ZvHo1efZs/8WLo9XqiHP/+E6RuEDpqcrS9eplsZRGOCWBTypXAkZS
-LJINkYNzZMaOrMWakSPkJRn6MzfMyjnm4gh5f+b7eEGN91y+jM2SK3izMt+0Xy3hzdJ8034Vw5vYfNN+
-9QreZOab9qstvNmab4pXd7TZV05LSSJsnZJ3LA3w+RjNGV3JYSqoBg7vOcm+Yxg83vF5mGQxufz7PPA+
-enpy4cicUk5spa7MUqAZ+hGr6NLzkK9nXDBJbmUbRuHCE8Asm2SLoMJnDx2OjZaNumsIEFcrWfbvWx/t
-lcPhAxouxVSJ48iscl+OjFOXpUetCGoe1asWHTlbMLrOXUCKUqNaJM+apcd3rqrJUrb8/03TNZkgyXqP
-JhMwimuHsm/VHC1xzujlNuSEbQgLY3qRCTnQ2RYmRK7/6YODw6/3D/6yf3hwvxiP6YPDP3/55ODLFj1o
-4LdCDdD4QIrwJGvbf/Vq/9kzb9QGBTgPBQWs0Rv10AkjsKPS84QEysoHm47k7Ftu0gu5zBNG9FlW7WBb
-3gzej6tQpGcN6VZ+KhIABPCw0A8jtKegoS/Qg6/QF+jrg+J/hwcHB6ZBTiOBpsg7Lh6mHtpT0AV99/bp
-G0VOIzPWoKHgN6DUsivENFrD5hDBeKApIjzCuRoYiaUHbemX2lSxV4Lbk0iB2/7Eqw0yIzg2htgcVfn8
-/D+tLRmrEEsZoI5cyPM0EYF/XNhDy7AZiBg6Rgl6hKIqQKgRH1SEW0X4xIwKulgmKUFBFEZLzJ6I4GAE
-EqGPGiI+VDUWr1ywbRlAUklU8gzVVQXwYGRTlKwzPQomaFVNAzeaGVmCSZTXgzHyhGFOLENvIXvPG6P9
-w1GtupG046PZjjGhvvIN3aeynF+vzovqzqbrtcdIoQJU30TkzZJeVB6IvBOlqtg+X9KLNlpNYFvCHfg1
-QY3RVh7oShQnE9hdjgrmzAWOzumGsHlKL8KIriZ4cvjwwdd/+cvDrybffP3Vgy+/rjzFlHEn8BnZEMbr
-vmGN/lUfIA7ApGV99lUeVQlXIWeqlMPQdnJ67I6WVXEGPE0iEoxCjVrJT45BKIKNrExJUsikinG/LWTS
-/+s7YpkO9mEEiqieTievKmyg7uNVenY13OyU55fFk0t7cNU87tb1kFI4LoF/tTwvFSVD9SpoqFy0l5Ps
-u6H2FGzbGPKqHBZ0FihgIbnMmfXMGmGw4LdswPbmrupEUBSqPHZarjit81yzm74ODQFvECcUqyGt4wyu
-N0bd+1inODK7YlgZq4LwZC9IVEYO3WWLBxx5pTxVlHOhbyvxdkmgNT9aMroi1jI/gBdb7UxM4kRQ5vAU
-Ux/ljgY/6uOk3oVzGq15U/Wlv0lWpwY5GEl54R0n/2A4h7CshuNfRy1LFJc6sYnlEfJxRCYrGhPwwasP
-2LgdQXoEskyY0YuGMurKjsu9WUqj8zeRXMJJtkBT9DKbJ1kitl2uMspbcpOQC8kKSCbUwFt54s4neRDg
-iompTdSxtSyDJEy6P1wNrBzjH2DvDUZoHx3aa0Y0Xa8ye+UkIwGjF6NCKGkBKOtoNUO4ohvylspKYw25
-w+pt8QMVeGYsJjyDtcQgpRL364KKsawclM3WWabm0yi7iyOE0h3llBfKIwmgdsgoxf2449BgsJSRre59
-0XFMMNjMqHS7vQ2/ClakzAKVkEpbxZ3OFNqzrCj+n+rxuBv4mVBJpZTvRS2vVEu1pvJPTZHPAbLv0m8V
-qr/N4sxUBhAIUwP+ABtB6SC8h3xZ+NuMXsAQv8JiGc5TSllQcQk0KQSkjiahBTRFgj5dYiYCc9x6FWb2
-vmbr1UxKoY6+FjLSnLLnOFrWWuw0zjYXeabO+v5HR0CBtTEVkm62I/BiM0YCL867Giy6KhvVnAM9tptV
-mv8Az70p8scdiNoH1wZGYioJACZeIu8GejVyfyuxuurAKg71IMs/w9WqpissZr2r8cq54Epe5/vX8l6i
-rINXEJ1xhdSTrdwIh8LbqXsjNMMIurYSi79it1mgJUZK9uyPa/Lfp09wQm3uCq2qkjlXVQsZ0FK1Z9/a
-Qb7N2cjm+aYCGlTspdtU2e9DWUy4J1s6QmSVi6133LuVtIMurFtIV2yGzYxacFxGuM0NpWDMJw2n0SZH
-Y23LZnzpkr4giXAbpp1T/qxUcIaPOU7HSHAXz4NFDk7aJ3uSlW9wemrbQkYdG6bmrKCccTRjsx/buUjf
-JnErm8OOm0LvZmDfBHZg/rbx7WT26sCtqdiO+TMs8BFM8Nj6/Ue8IkdKb9TGp90i0PZJEl+eglzcFrqu
-7C6iUK+DP56TbUwv6qbTexDbZbM0qy8hXyZzcI+W53X16pxsn8KxdYoOv+xi4KQV7gBfFZScwd9nyhTY
-62MBpkarpgYyOwxR05ih35+RoqbYQpTcXJyDapJzLWKuCB/mVekilG6KPHn89lo1MyqS+bZlcdZfV3zx
-M04hcaD9e5ll1muDjitnHsvXjYSLBXkF+Z069uYWJsHdGu6QkjloIHRXttkZr1Ng19m/AknDL6kX0ybg
-1mhI1CTOOWa8hBw0io1CzF8laZpwEtEs5sGoEbF21cjzpubbEp4gye2cbA2iODeT1zl1sAZE21LWIE+q
-Yp1RGm1J5pxs/bFVpDKDTEolu2zPH0OzVlHPIsJU3I1X87CwgO2UHdezVSKGTLxB0YG9S01yCiwS4d0m
-wRvUvYaEz0DakJ3cRnHXcx0zhJx2dXlCPTK5UXuz0immjxqMoV3wP8iWH5kz0y7yIyzrozqHckaxNyTp
-cpkpDmDz3sMCn/hvpZR+WuYq1wnVcRwH3cuyU4xuqYjKLCagfrmRnqZcP2uWBv7EH93iwQ7s3/rz8SDX
-nhtbRprpOD6/bTeJK45pem8ZxJbEhrEiie001wqcKntuKrCT+DbUeNi8bAIyOFbXSIAUiixKeNtJz9ud
-unpQK0HPcZKSGAmKFkQgA+OLRCxREh8hzxwWtIe8sdI1qC+ynSFnf/eG0DVKo+PuGsZ9DYF923BPtwoN
-nPxdGZvvXGOqi/h3CaFQvx67k5t0olHcU3EjTCIFpFIGXxebF5SLG2GypFz0IGFu6LVrWXq3dpdJoEUd
-jVtOjKD+8G9gDYNg/F7TQi1VUquVz5lTtAZapw1x+rQWGyyE/laFG2lZblt3aXAh5TJhMCLFZqBk2K3j
-bPZwINK3q+xsn2xgKG+HwIEwFcDO7ctYAi9vZScbQF9NkumkrwFT1bU6gmo4RwNqOXaJ35GKdeh8z3Za
-m6bWzjqE5K+5Tn8Pkh8siMK9O1XelN0u36nqNe5WMzOxGDcaNFPzYDMty7rMxsJUEhb54ifCVu3U0FAA
-TVXBRrLmAgyalhAtJQCuLgK/G2UMzNAUGU+NclFKcAZJY5qpwO8alWxqh4jS859xKntRObh5+uQt0fIs
-B2BdyUYfzc7roj2pvY87pusF5lX6m3qWk4EXUMD8mDl0hl9FkXfcQpGHyiV7inhHbqkgD79LBEf3G7M+
-sqQ36rhiouKcnYNldPJ74D/VaM0S0fLqk+9kB0DF0+iD+tbA2iZOwzhpQjbNL/+MGdFD3exr6Z4k0VSG
-HDV7o8ETkFfd75wBuQo//6EHXjF80FnHoDM16NOpc9QLD1oY8cEDXhkdO8f7b0S81nzYvnMVC6js/ACg
-7yoGZqQTb/nEtnj0/fuo0tE+U1l0gvXIeq2IuUPUB6XmIVzjxWO0HqO/How6vGtrsIeNn7W3riHcAXS1
-c/WDbe1tnZCr/a7zqsMzCVoTvplvZZ5cIhyvkkxdMyuWWKAl5ohcCobV6osoY4TnFK4+ktKbClIz7qPl
-oQER7l3lsipaYbiKFy3kuQX/toUMLqXDNjIqaSsaRwTzJN0itMLnqrVzkqnbW9GC4UwgnRTLRIIjQWmF
-wlnQXN2jkODITJEqv9kW9wrz83YY6VlwZmfVLbi5KwSz4scSCPxWu57LigyYfJoiVW+XmEYgp6IRNAVI
-loyh9ZspzQR+6maM0nGea3nSM5J4evV8nFKw5ckqTwmKCu8/Oe98SS8QRo+KhbKfZPlaPNaTDJJuseBe
-yi+V+nUnqdcBA4TZlqSqUxbKP2ZUhwNGeC/J9H1MJ7Uspqe18XPUbo1lOTqBtzareEVcqJlg2QF03Cj4
-hB8hLxI6trLKVew/gtFGkktMPUEuhQejOfXmlK32NQAPoWyxHycc+M3Ui4TS/2gGFIw8+R2uBqk+FphV
-3/YpXIzNp95HtCBCEPYG/l+kEfUe+yrE88aqeDNr+y5qeP+eUO7L8JtHu6nmx6ioDhquG6nqi0C9SmFf
-vrG73xfhdvUKb93++oLW4etnV+E6bP1sLwx5pzc4lYxlrzA3lO8+fUIPuwzrRY3ylXY1a+eUU+54LzDk
-ldO1zJc9FVUO4LhVt3j/6VNTFVBoiIG6zuRqQVPk/0AxbHo66XUY+pbsgimJBInPcHEtm/ZlgEdHPMUK
-J2lVVD06ZqcWqGvMUv29Y7YEAXkYjIRwW0lwbb/1nSI55OS+3ebkLX2zBPd+z7z1yrOQlfIFgTsZv3pw
-jQgTcpnjLH6WzOdtNU8xt2su6KrIkexIFlknlBcklWPuvV0SVLiIKloAIWlGSIYiVTREb6WEtSI442hL
-1wgzgpIMqfSXiM5B8LlgiZBAOF0RmhEw+/hcw+AhekvRJiEXkMlOv4TIZnjhv8Ebgp4lOKWLNfFBmpIt
-XSRpijghCKN1lswTEqM4mc8lRgTRLN2iC7wtTFgsiYvkeUoxB/lNUSKFKNUUBh1iknGBJYvWhdk6JUVc
-h2w4ovlWts5KPJNMUJSIEP2ie8+FRAzERCGU4o/LHlwkYknXAsUURLxlwsdoJneqJcmgQ6s1h/v8N4Rt
-UYQZma9TlFGQKYpRJAhnW8sQepYVquiRPqNR2/nNg4XqHSFPsn5exHyHlC0mkPwTcp/wP0GxfeONVzfy
-e8WK7AdVlGyBSCk9X+f9AFS5fSG36BYQ8EBI1CbUD8os3QK1whGj/TCgGPdcWVF09JgRjWfxk52tkzSG
-y0i+Z3T1/DJn9rxD4D48yGUE/ELJxRMjYYAnCG9mlVV3zV6iaTPKSJ6NslitqQ9rotwOwPuxVkyHMZsc
-90TT1GkVj2zgAXL//qFks/VKJQF11rMdGKzdRHuyXxYv3PiymUuq7V1TbIDvs/dZmUXBR3v1pvaQjz6+
-z6xRRf/Gy5tMP36EO4j1PatXV0fyDUABNcjVFaKZfAV+uWAavbpyQZ3ReIum6L8f5Y+VO2oDlKveo/zx
-W7zgR87vsJgeuz7/28ePTPIXdO98jO5t0NEUKXTdLf7bvz0S7PEjET/++PHe+dXVo4mIi8dN8TgRrKtN
-ksUdXZoonP/bUeBKTp7fpnZi5oqHQNlGhCwqU6FmsE1WFXQmAO995o3CFc6NU1lq5JdKQ8GSVTBq55gC
-kCfw/8KVeh8dnkqijRjYIiTdOko1IiDNbqiyv9Ikk8gB82q0DBS9N9W5NXakZSORiK2iq5qvMjYUaLpL
-XrUmyiKP7k2hE9Zy3ZIeFBSDojcnkwhnvkAcQkqLDX+diSRFeC4IK06eUmJY5zEWUvR5Jg90cu93Z0KW
-4N7SQLPGcW0MB0XHlHibfbSaceSQvVUMrD2MbTJnYGP7f+/5Fyq1yqditj+Zm+Mntel+gs1u9J7vBSfv
-L97vvw/f3zvdG73nX7z/OFmsji0qJREtLVuOnrCPTefA2gZiieJobRbuMlqc6ChRkxU6yilBwFZAb3/Q
-S7CcheSSREE1CSNXlIp2FIeaJ4enx12BHarQA0ehNOFyrhWuEuypPfbkrizoUrhpILagmWogADiwGy6G
-xKbIcvqO3y4LSzMjxBBPLZ02aIn50hV/qyWupT4w+/7oRs5TtaNx269tMCeyiXpuz+LG+drwYKyLWjpV
-S1cYR+OgboVTy9Vn8Zn9zLiotW+dcet3dnaLrLuDJzR7XcTMeeo6+zkR0bI6GtscHy2ebYwAiQzx9+mm
-qeZxo5FroDO9xJk9v0SZv+DMlrngn5p9gmaBN0vXzOtK1mDmesB53uvKstP6tRkcrtzzwbeZwJeumBUc
-kbd0sUjJi2SxLHLvu5GF4BEAaOtGV4IOSydKzBzuVf2BCI6+fU7JQiz3oWguUhtoCJRAZvKotnr7NVmQ
-S+1i9posnl/mgff/3r/nX8j1Dtv5HvLev+d78llnulp4djKWZ+vAADu2TOcMR+cXmMW8dQVrKX0wnNuu
-ykb6Wpo3BHLXbogbwpKm5B+Uxc4SDHpqa+XKsm0JnR9Bc+je/Qj2wW7pwD2DL7XmvTaT7aCaMp3bgojn
-KZE/v9u+jFXw974PioKRBvoyE/TnhFw4nIf5kl4EVcgTP0ni025iU/rf2uVvltQyRTk/VRr3uqodDbr3
-pTPBZnnU6kytaN9OrYlPupJvyg5BDkyn4/SapWOLYHUjX9Sc0UhnX3BdLyMR00WKfA1v5CwenNrzNtya
-+yd45cCv0z/A/RJVtzMFBXHt5JS8IOJ1zQTVvSPdbd9ceL3AtqrLIonO7d22ngMm2jpxJoV9S470HUnJ
-boor5T7fEdVeSscSe30xREdyGiM2yWilK66+zCAA03J3inar3mUodOew7ktJ0B3TfzVwcIv1KH8f39kF
-c4f0cuWggqGRUD00oGX/hbqX0WIdW7oCoOzyY7DDgrRYMTkR1t3QJs1I1l2PpWxa5ovLVhoGeMumI6gV
-kra4N+Ao07rtsCtbCBMOMbGBCpcVtHpxgzBZbRGV8OXvQFBb+/LT9RupeQU0PQXarVXFH6EH17w8QnZr
-MgXuAgl8j603zUHGLDzjAfxgdJ3Fgapa4TyyjEeMHjku02jbia66wurlqu4Uj4gYFK3/L7L9HcjW9Exp
-zJuFJIrCDsrob9LiQtNBn2V7Kr8bmqCvD7rvizUOFI0rOECItd+13FSKwd+epFGl9smmbZpM0BMhyCoH
-az2YUP/bMJ/MKf1vlGSIspgAGXIi0DpHH9ZJdI5+Xa9yNCPigpCsyt6Os1g1teu5FCoVB1J4sJ1ITVNX
-WyQ3TV6ui6Oj839fr/K3mC2IPZWULWG2ad5q5cxuJ8uAToaCcBEo81hyOnJt22Vzv6IpStAeOjxGv7aa
-/NXepDGRT1PKCZoxHJ0TgbBAXGAmEJ0DJO3rQjJwKIHhDTslN7CxvL+arMxu/Oruxs0Er5JtylVVWHgK
-mnrPv5i+51/UbDiTlbJdlHgdd/YG4A6UNts0UhpwQEgCWFYjyK7C5dVgJUKJU+/Gk2MmjMXR6EyxQJAt
-PAqqFjbcu9PrbvdN/TeAPTk4HSvcTg5PXW2fHJyqfEKFvcywIDSP+R/vDJ8+xS+NYMU6sB10OUQULml/
-0y5+1WyAc5/dUV3Z26BACE/B5GMQfjG6mliGAgp0dLDlZWi3yvX040fDmlh6/lWdyRx7UGarljn1yXJ5
-nimbrWQjR8H7eG80cSZDGnDDkhHKXjJ+LvxbV9AUp9ciKU7nmctmbgGxyus5ew+7Z6mjFUcDV2P0wH6W
-b3MhR4bG7obtJ8WS4osDsiJ2TQUdmoVmhqjVSC79YGWm8OsaoUKBTwWFbNKrNpcZzIZvQY1mGTBfHcGL
-L9niCKR2S0h0t7ZLUvsQM1ylyTs97k5a3Vam6CL6/nkbjFbKreJYUy1E009+UHbT4gRUB/HWneXUkiCV
-1nGofOkHVq+3X3nXD6peHhb8MdK3zTSPEaPBwIojRQtWmS9qMChwWa96VXqwDxuT2m5jjE3Lp30QOEM7
-VcEyNYIDAWiNWguGfj/a1R7x2Z/XbdxGIy1olzimIj57DvqAsgTXC6mhRbh/v1+LUKLZyIl2c71CTZ9l
-UQMAAEfgnnm0Pxx0FWWpAFCajEfGVVToozyCHaJVkq0F6WzrwTWyJe+gs7v6jE1tMJO2O+6q6oI677ej
-PVXLAXFBMLiwrT7wxZ4RgDKu5ouwmKmZfK0KlrF2d9DVflaW+8faKw0zsmmMdCeskRRytky4oGxb1AB1
-1wv1riPjdJdlqL0hLNVWUtUcIvP2mF//B5hSK/mtO9/Q7kmefruoR6dvHJdlbUJG8hRHJJgEJ+OPV8Ho
-dDRZjJF/7/D9+sHBwczvbCalOJZbnzwc/ARhTbWrhDLBtmO0sVlwN2FMM1KE+8kdaBM6Z2OAdrlMetTW
-Bdabso41XHR5jqYIUG5nwB10p2bNJ7r3bs1a00Pv2KxHjFzvrs1b3lasl2MO20oMOWKj3Df6NpbrXKL6
-BzHXTVjF3yjWqh4tHtShjqm5xyMSCrbm4gl/IVap4pzf0Xh7m6xs03Ujx/VZWHNVtU+gDg5VhRU0+bfD
-YjJgWItbUXYc13K3ei5FAg3ZlRS4VqgI5GnXHqBVJXVALRhdSEIvOjH8Tg1CAz0bVTlx+65rHF3gBgX9
-1owl5ypcV+DwKVTq2CkbUE/OT4uBU1VPzk/7ROiMChs2Z6pXpiq1nWYErujIXCrGDU7VqLgEHtn0iQdF
-QIOdSezLWkOvg5IVfmJJJtzJq6vGVMGysY8IXhyhCsjVkHbPoNUXb9/+1BqTZd6JwTIPXxGxpJBHsEJk
-me/m2+xQW8PNMLQpu8Je+mPnPKu7HLpnW3DHZHfpzz3oouU2FInPiZoEy5CfBcJBblh03Y3SS3Nm2wXt
-BWCWFXJGRl4PDbptYNeiiRo6ddpoYWWjEeS4gmUg7eD2tEse+WOLhKornTCaon9/8/cfQyVXJfOtoqBn
-kP5Wiplj5CPk2xssTw6KZ8JTj4IWSv5DPfKu60bpRSYFb5WYpdeYOEvpTNvqv0vpLDhpCz6nY/QR/MmP
-EOStmeQpTrLjaIkZJ2K6FvP9b7zW0HK8IU94IOGPkaci1SXQnos1kvl8AOYOQ9FEVvdtvuueAuodWQS7
-to+5p28k8JpXEvRKRzsft7UGDCjq0yfk/UjRs2Q+txh9JhP0mnAiSt8+CCRLIB0EIyjhKKOgUFO5fL69
-9eOuRtX7vswbK+kMmjRSwO5yDpU0MmC2b9Wn14BT2dje4E2SLY7RTynBnKB/4KSZl8BFcRLObVAcTPqR
-OdT/VLKsD4+eozd4Q+Jj9JroqACv7/4+7Ui7zmKVcPD2VTA1PK9Hf5DSrI/8LM05yKYjzaTyUtjXPN3r
-U8Tb23TNRX+7mgi8AWGvuoZKluNZh1CXVEgaOZXlpmLupNdMImcHEcAyHJZMEdLLQXl5WJF/G9967awq
-zWCMRbiCe6Y8TjIL3Z9VLZkMgq1TMjGFwYl2YyjBDlqo7Jld1IMEp8+wUNKDS7BqdeF5lbG7qn1DH4JW
-IzrD912kUoygpzQmRpvq7fHvaaXv6vcwNqGQsZNhLQ2hSnRXT0Bor9aRf9A3CaXcT2YJ0Bs/MnoYY3GE
-vEc6N9DVuJWn0N62LU2hHwm/nqbwHUuPkD/hAoskmuSYiQSnvEbF4VKsUp09sDMz4DPMlzOKWTw0OWB/
-+r+bpPlLS11UkUbOkrVMq8JsSf+SVBAjb5J6bmQ0r5V1KKxKQGZWc/XSs98J03ajgNKGE4UC2TbQt29v
-Lce4+OS475zMGeHLoN6hUCxJNsx2YYy233sNSOuOX5MWCGO31s47EBzBEZqIaKk067x009nhUuBrXXrZ
-e8NlPb2yJgrLNHve2BXIdQNqQbtdkGnLEiqZWpwwAhdHBr7gWoPr26LjNa80EsLWuVDJfnT2w4LzFMXT
-JDs/MkNZFdMgKVmNERaCtVJ+a4Mtf1NcvNphAjMwlGdfOocy0+kU+RT0sE2iNahlXOT0vTK5fW1gntJV
-vla3aQwcHUD+qIFlZIA5Qv60CbiecylZEVmo8XpJcCw3jtqHq3HvtJht33xuwLW+vPXalaWm4cFjVLBE
-15Yff8IMy5re/RgLMvUcBqfCvuT98ssvv+y/erX/7Jk3GqE95N2XUPrrvXhxtFp53df8aVuEoHgw/Vna
-hCu8N62WXDRYtjNflQFOVZOGJ05hdYvLQmPkr4yrRP2iTXCpUj9rnjMb5TajXGaapwXIg6lHy+f8hJ8W
-CrKrO65i8Ul8ulyeLE9Xq5PVaVnpqtapt8mK1DtUUUqwGZneSErbf1F9bn1dcT0aGb1Qbkkr4yteUGO7
-Uf5JmfEGHLwVhMcNxyRdVf71zW43ZGsNL8mQbxmcyr8GHM3evX2KAkgakGVorzbB5ZwodICUJQp7yB/5
-tRGsX1tZG8ccC0GYRGgCrt1B/Gn7Kfu0/LT6xEfBPl7Q0beT49qw6yoqfGMzMobFQhJNglOJTuSyOjk8
-HY3R6uTBaemG4EMS6VclEdYHpgHpAKjEwn49wSW9eIPY7nV3mXsX4BgNJULV4LhvvXfSbdvCD5mfKuK3
-+9sUCDzPwBfDdVA0eIBuXXeDbHDaAjKqLwsrMCOO0qBJIER7rSKrngc2QAhWtob/uW1gCs2M/pBkzqvj
-5cSFsqkAjPo3PPpChqMUTaukHkqG03k9Ah/7jt6SNNQz2E6DZ5RZMiLnxJcnoaPJ5OLiAnY0nMVyK5Mn
-yMkFZWkcpTQ6n0Q02xAmSAz78bcJp1O/G/TetGIovtz3Xr169uztixerVQfiRU3/fn44PXC00Lzov9qO
-NfK15TBG553e/7VGJbsLztEeejAq7+XfDLcKlWQgBykg6WiX+xx6hTvNXd5lyeUfzmFkoztzmcLDdQdm
-s/oXs/kXs/kXs/ksmM2bJIv+WFkGWrw9YaZaJCuIufiRXgSjLn/W/kGhNBVJ/nsNSkFsRK87+ffk4HQU
-6naDjwjEVfnxCHkzKgRdebv1wRf8LZ75v1MPgKXLA6hGvZETTk6I1nCZyvaN0xBBNiKMBEv/g2xdC8sV
-NX5lM3TD3Q0JR5wivkzmYp9kkKUTZ2hGUITXiyXkM2DrDGF1F8LFkmQIBk1WjHCakhgCS2zwyxsU8qb1
-1OxRTV+H7t9H8iXgcyv95BeJiJa1plxAI8wJ+usRoI5nbsa1EaG+uusZmeN1KoKOqHlJBBu5JeAQ7tHu
-LqlyDUBpFSSS0OyNfOeuVgBGU+X/qt2WARKoVd5DWvzym/rQCa/edAOd55BYQCG614z4GTJH5VAffglj
-DVTXmXWgjdLdFk6/Xz6Fz2y+odp6Zp3u7lopBlMsX89C+fNl4Q//PvNG3VNZS+Uh66psHgBJBRbfv48m
-J+i9OJ2oPBd8PTtJTkcqx0fnxHTjDDEUsh3dVdn4GCVoH9AY3WRZZHJZXPDfcW0A/CL1yG0KJL7gjPDk
-NzxLybDdixEuWBKJI+Q/MTTIdnU3TtMZjs6PkH8fIq+T34hv01k3tsScciHl9AFbI2x+ZRdauYLlV5oF
-PpQgXNC81kuyEWO0Tpy5Y5QnkO6FizHUSwW7SYyD5IqUvKFMKKNsdQ+bYcrSLy36uo/NCyR2FD3QbskR
-7oF0NQrV5TqUCcICNzuVBX5IuDhCtgNl2fHR+PoepGOHhQwVl/IsEi7CRSKW6xmcmFbpNouWkzj+6uAv
-s79+SeIH33wTf/XXv/7lL99YJwivBYU81bcwPY61ZZu50uaupdqbzpsGc3Jwas+6bR1c69g6qDhZkR+S
-zM5kQL7l8ex7OGvKE/OXcBjUh084iXh//mXy59Xkz/H+n/+rsLw3FOJYkPpV3nVNM1igRjrqO6ippFVS
-GLZIstoNV4LmR+jwoJoJliyWov5KHReO0JfGu5TMxRF68PBgbLkt9ObnO75UtuymC3rhuJimOG+khk/G
-yBXA14B7kpyiKbpbf3PcwR7bYYNS9JaNyR91ON0stAWpCjrs5anH7nOwXwt99cf6yoAGzHKs1NdAF3dt
-DXc7vu92rgA1USa0iTv+MtQPJQaO6zh0sY6rG3bDo4AnmbLBMfAYzbqBIyzPQ2FKI5ySp3SVY0aCmXw3
-gIdUs1WNgf5lV22CLGaUalyktDEuUtpowdnaKMQske1QSOdk64Yzw2xJdPb9h5BtUGHplNjMCmD2WyVZ
-UL4co68ejoZUwpdmpcOHDvT4ZvGiqFhDDH1hAN3THDAUNK8eFHuzwy2xqRrYN4HsDwHCN4t/JDHcKgzS
-2oV8cOm1L3TJslLZhGS51RPwaTuIyzeSTI09hstnpewK4Zay4ORgrFo6daBx+eQy0YuVbxYhvkx44Moa
-LaEHqlFHEcoSUAerUfJdKjeyysU2cE+xxgdOEKWOy94iznOSxYHPNwtXtmu5/QQ+jII/Lse7s7AiB1Va
-kUNP8z2NC4YzLiUAf4zUQyoZs18RJ0z6HvLHfpN6/ZFtHGGyhjUOXs+y4UskpxeJdF/+vRbGB4Bfuczs
-uCkSCWO6wkkWnFibib8ERqHWsClIxQav0oXiUOd1MMtFRrlKZop0un3J39CVQ9qWYPHlgLbx5W5tF3Yj
-d/OuZZiSBYFz8q50HyebgbMv0n3Viu+ytCUrclYion5cu3HQqqsRloK4HkT5863dZ6rMN3C7KMh9UY6i
-fQXpofbDy7BrSQD9wx2nLt4oj8wB8FJH16Kl0ow01q0lLrNhT9I7+ridvyJx6mxlW2UW4DTwwxlmrs4B
-+jEWWIFVckYxXx1VQEMZdBQoeilPTl1t18nUvhgl8UrGExce/K6k9gbESyc0xZ+CauXGiihHQ8BKqTsx
-pY3eGuVmMrxKsVnV8e/RKtv6VVqy0X5Htx06CTeWNAv8FV1zsqIbEsqRLp/OLgfX2w7vockZ1MIuM6Jc
-E/0oTaLzOgJj9GsXDuqCYDRFPtyUDZ5vcg8EyvzVrSA1z42+ynQnq5723jVRO3DG/eU7DrJ9loCeU2s8
-uo6RoHbvWAcETsC3Y3jO0vKsiaboXuD9SV1xPOq+tUOdNPvA6lgVTlM5HIvAz6hc8PEYkR74Qwwq3eOl
-tI7+UqzSMZrReOuPykzHeUBCOp9zIoKRFAy7JmR0/TB1+/aR4hlJnbsjbB5yn3XdM9KxU5S7hFzTPRIp
-uRT7OIuWlElxBgSZOz38/6CzRCyL+PvhQ7LqBhVLTuWHD3oLbpsMJTG2nUByi/DhyNw8nDuOYnIO0fR4
-4LxxkndOmpLPbjJtHZv74CE5HDgijd308IazX+yxFx2nwe59rj0FZUeNYkGXL9olmhbHpQR8mgJ57pCV
-A7FM+MguuhYhFWcdEnJFRJU6Orgc9asf79gNkXX7G/jgr8S7LIGkJie+XCDnykV6jPy/yf+9lf/7Sf7v
-uX9qRAJk85UI+Bit1qkYI76ez5PLMaK5KHXE8jeaqj+fPpXKYfCfL26k/D6lWATc8PFO+I/4xyCDvKE6
-cIarsBmVKcO36NO5qTqXQGSb6v6NiiSyQkul7uXIjDbvZqMWSOgQ+hb5BxDepZ+PkH/gW5D99AndTfj3
-SZYIEmSjFjh/3/D3x+ZVISYeGD2e1q8jgcFar2YQ8Qd15imlTLnmy50Nj9AElU9yMkziwGiiq+X0IlBT
-ZUBRkM0KcBOCpogT9bmlI9dDMUXNguUwtcyJZddlN3Ao6PfJJYmDh7W+P0KHZP9hbXp1aZ3euGUhycgC
-TVGGHqEDOVP7vpwfv2bckEX2ULDHRgZ2hle/imYLfEnOXfZmwyhiIcBiMRweHByMkVxGHwve3jSlFg3O
-toLw22jxwVdj5H8nm0RA2epqS9TbfiJur/nZ4OYNGxxJMRdJ5I+RUi4Ztsq6Me13tVd2mSuLBK2JSHBa
-aqytrz99QobFkottSkK9FVo1Ccr+35k+wFS2WuA6MDneEQZkljI/g6j6otANevmlJU3HlV0lTDIBFxon
-Wb4WUpLJFsQb677agk0Le7AqIff6PoPuHbdF9zvMlMn7IslieiG3LEmm3xfxq8bcqxJjycHawdYO22tp
-f31wUKcsbYNtvi7ssI3X2hR7cDDuyIphMbIeOdLyWQIyEQRlKqxaH6/Gt+CA0TTi7ClCXJZr4fDhwR9g
-oumwyfTbWpSZRR6SMXNZMbat8pTFSYZTZ5wDzX8Ps8dwS0ZNKPYPDw7+7HdaaATNe20itlxSv5tJ5Hcz
-Z3mC5p5rnndtcDukQdl1z2leLpwB5CJ2+QCUJC0FoYbXuGZiffD79xfNcy7cls6rgoePkeUme/nvIpwl
-6iQJLnXjAdtan17pqutkptoJunIY3q1y7fVrXXfJUDjYQFxq84oKjxwXDVwfF3ORTQtrV9Vxp1kszPCK
-VEb3b46d+qYbWrj1gJU7RYVb1frhQ3u9G+8VhhlzgCFd/tsapV9LcfY7nMUc6ilsTscoPHRVllykziCc
-A/K78c86/Ja9vK9CudNoG4dDbUFz987kmAcYHJFE5zxQNAVXYNoLxwxfBIPC3ZpeUptOltC8RGoDJ/dN
-QYrTW1+fBZ/aqSuq9/8M1rat2fyNtVr3RGqzEsk/Rp0LsPAjOBjvwqZ+LvylTjso11CWLrQFOGSgt3Nx
-5cnkv8CLwr0YlPTjLbw+K58HNk9vjDzlmNFVodO+fJOmt8OaBgbVUagaSEjU2gUPTpGqmFbnSzxIFnsd
-YbYzDElr63PmhTPMvJHSZRuEbQcjQQy2WnvydLjDMAIifaW3notc1eoJ1IoYYob2FPf0xrV9R245weia
-1uWGFVmvoJF9f28zgUHO6vbj998Yzpd/yAH80H4AP3QcwL+0nsC/+Z0P4DjLqJFYaZcjuvy3IBlhWFDm
-+D5ja76EQB1ZYAaBOa5iz7MYCpEsthQhkNTsO1nwCPn/x1JihS8dWKySzPElo2yF0+Q30js83QWKZL2O
-UnxJL550jnRD31EkozpC/qM42SBY+1OP0Qvv8aNJnGweSzlrMlF2uOcpWYn2VSGNmiii6X662D980IAh
-khUE13dBUE3rCsoS9CSDpIg4ySzRg7XGdcNfN9pVYPpadlbHWeaoq0rtqkEao3sRXeVJ6kzLVQ52S3kS
-RsskjRnJAocVrfB566192O21Zw665bTdhPagG1oLm3YjvT2rHEEaa6HLFKnnboe2reNy5XZ1fxlftu/D
-q+NbLn43piWgB0Mb/5+ob9w69Y3FgbTwlXfSdpd7+I21Z/2e3r+bdsyX27DTVROOiWUgwmF5/EYT9OBg
-1FFLm8grucKxSpPM4oJVRNQr6iq34M6oeh8zgv0jp7QGV+tXY8cI7nKimjGCzx1nYhWhPbQl+RQMXtkg
-J1SV4dE1x5c96tYs8KG+P1ZgSdxXEgSTcsf/Tlfq0rCXG8b/aMX6HxtsAImOyJw3/JflK7/HQBGlSf4T
-FstulBM5i1DWv7HDUb8eqsMfuwtwTuGSz33IegAu9ThN+5zvk3w/x2rm1ywN/iTf3HJAx+8XyHEdnLYa
-J/uwy7HgxSrsthS1igwcEsVBblEqGobu1R2rqnMA2SqFiE9zHCVi2+u31u/Z1g+juUaGcC6Dd3R3JFoz
-rnw09YrxO7h44Ya9lVTzli4WqcuSdZnSSHJHLbHXI0Ca+pjyfGILT0ppVOA6TymW46BlimP3FvdWZzHb
-sfmyXN4uBSowUEF4fWFB1dnAETnzQ1FSi/EOrkdTyEfeNkoXUqV1pvw/ka8O8WHkjx2fv/zLX8jsG+fn
-r2I8/wo7P//1m68I/tL5eT7/y/zgwPkZf/3w6wfutud/+eZwNne3Df/84ZFaWB6G/jWKNxxFcHGVh7iD
-ju9b93eaxh21l3Sjbky4xv4FdXtYdVsSyGhGeirFCc9TvK1Kd+D+k2wATdWDKZMeRQmLUtLdF8l7H3aB
-f61uEGlD75eu5kmayi5cLBPR3QfNMNuNdLn6F2yZZmJf+wP4hw/yS1dLkODjmjOtUp1cb6YtDgYSWomD
-PEM5D8Vyq/u/hFH3/dqlTszYFp12PRPe3fLhpuZRkPkZvniSZeXe0u+a0ZKxGnentHS1CrZKUen7Pcm6
-cOkGUEF315hMVLLBGShBipbKgBPIE9Xek9U9HfL/+xHNYpJxEneYdjqa8OJk4/X0iEE6eABQr9bEi9GL
-LliMXnTX1xLJA2+kggK8p4zA+L3j9etfrgv58KAAjUMTdjfof1Ln/36R3X6vAehn2d13LL21zhbrB/vd
-Kew0jEtQ8i8ZmXtjhMN3LC3HC37TTLaTROfe4FhQ5VuUxfQipDnJFKQx8s5mKc7OvWtExv1zZ+cpFmRB
-2fbWV6GG+1l2+gXl4rY7LGF+lp0tbs+85f5qsK5wTzSZDHG/Lzb6cpc/C8WSUSFSYjjulIaZl/Fll74k
-wytIaaRDtevRiCwh/WkIhjj3/Kgdh+w9BxyGJyhwp5CwSY1FLzqbvkxEV8vdLkag7EhagYHqyORylUpp
-pMjCf6vuWlI3skDO/Mtk1NGUFNIvk3ChgrCDEZpAMJK7wirJniWQNVV54xVqxM4acsbG8gccnjuL/heU
-+6VjgDuyUtTv/Y774/nlMXKWcBKJINZ3aHcxEYjdiy/R4ylSxXuSlpX1oKFaFbTflV72qhNvUjPTQqho
-t6CZi7L5kyS+PO3uYS76+kMK9XIiIN1qLk5MFnHaEyxPFLGqlYz2kF9SrI4yy8XJ4emoB4rqWBVDK2sd
-nBa3Sgypu0XTwvlqxx6o+nERXMk/MBGU8ZISqX2t5BhD+v7e1AFG3W1RdyvrDkhrIPF4VKzLIRkOqiWc
-i/h4SPH/grKXg8r+AmW3g8rC/E9RkxBg+gcBKJRxoNrUBDW6bgaGq51TiRQ6b9m606Wujptz3wJVSd9G
-1GXdk9NUmvXkPHS5eleKplLronQ7xaB21XtLLkVP2Lme3P6xG9YscGv+WvtkQFcfo8Id/EFXne/ARUFV
-+gU9NvwBrt3ByhJToPQt2n+IjtDDYfmDCpy+RfvfoCN02F+tnv2iahXyYKAj5Cs/vo7By+BCgap3oXzR
-JYPMZnARXUykbPDdd/Qy6KKI1yQaNGCzWSh54+GggZrNwu2gwlWOpVlYGjUfDPaEnc3CQpZ50CWVwaUi
-kqm7Q3YuC7Ls4sMO3bWbBSntYpGuTGkXe0f70B+jy/5iDwYV2x66rYpmsQd9ESBwx8WlIJl4o/LOd0to
-kNW0Kt4tt6iCz7MY3a3V6k1/BDfuoH11H28B5HhInaCq8iyZz4srDnffe0oro2KfLoHuyhZj9/CgJ5jv
-KU11Ov7AP1FnK8PNeNz0hj3tCytslK/phuW3Tv1wx3ek/Hf14XeT8GSWpInYyhMwPKXdp+id41ZcjS2T
-OCaZq61+tfXVv8Ixu7RuQ8MxP6eIyVuIZ9wtpPDSiAq87IoKhMgurR/o6HJpdC32usMdg1d3Cw2MyZxb
-cy39oWF+/dZidfpXB2r1mzJXyNrJwamU32HGu6A9GQROX1AGWS2ZeCbZbbjOEilouRv5zOMWS62EitC6
-VB6h3kjFkcFDGKXgydy1jHfx00LtcI6gyp1ovB39Lw7A3MGHHvX60XcIoq0MZ0GfBJcXAeZVWrPjfh4F
-qpyecltVrktTUGm2g6LLO1GBO5IVhKikr/MrfFmF1wOYZ12abdmXTjNVCaKJTk/yUqgb5mu+DGQjaAKY
-gaZMMoSDW8onibQ3ebgNuiMdT4rZOO1QiACky6A7TtHU+DkhwelNBRKjKTpxD6/KOD7ABlGkJm9Op5kb
-PNLbhfxP4zjubHpYbHORmfxWmj7tECnkvtlFVHpf1QPrXIdXA6K7NYyO6duuYO5ue4YOy2Hqatpcxbc2
-Q+US6MdA31kcACb7MBYjNEEPDzpmD+4g7pg9DXMnIRAmYX8KdR2pLCSGe10lJHKlm1IXglBQNvi4W0gp
-EXMqVbpP3nDxsWoMX6JHQxrDl9dp7MpNYRVvkj0ZQxMdS7MS636EDIqaKCVZdCGvm5EsswyFWyU7yxmu
-1vHloNZLujeQwJfXzzax7eQdlWBUhmVJsVdFX3Whq/axAx2TFhyMRrsel4ZdL4AGXTGABqeBKFvd3mar
-22HJJ7oSOSNb/gnILd2fO6E0eygHDXkqCPb/ejAalnVhvzd4wXRZkhWCykrQm1DBi0FldEhWXdiAVu9M
-nu8+uPOj1G9qepclkA95FP5KkyzwjpF3q4cm7YH4MlY3sOkIbn1aQi9jtP9Yfe+D8DyL5em1AqOg7j9G
-+kufjPwafHMOBt62eqCvWm24gpaXVMEFq30G+TkKkt7zr+Ge8zI+aTV4kpyGLyEz/8HxEBh6NBT/bUM7
-OA11ieMhSepFkq3JTZLNl4PK9PDDj0fTYkb20CG86h/NYkQBkFl/SMVBA8zoxfFQSMUwM3phH+hkh4FG
-Ko8F9Gfa5bFRV+Y7o3GHT48xqLUePbL3qFIi/a8Y81sZwqtr+tfgmm0DZ1nNk6362ucEa3i01ewtLse2
-l3H38d9kdn0ObsjMZ8Q3iyPsDSvcd2WPYe7bJDxJk5mKWxx+eUe1WzU1dEN4sRqs0h50Ew5YwNLmni4l
-yBBvZ3eGJ7XWYlhYX6CgEC++QOHBw5EyOw9so8z8VAMxpGYpdFVU5A2qyAWj58TZtyIkLpDdG9wPBXRf
-h716YxQO68U8SdNbRkWCNBA5CA936QEYK7wxGlbp0utJvGU1CsCVjso0YCq7hjRYoLeDd315C/9bcHgY
-hE+/txzJ4ho8vRtcE1qE06gwDeqRkw0YF12pHvT4gk0m6EeyIQwxksWEoRm9JBxdJGKJUsI5EkucoW9Q
-nlySlCPMCBJLsoUfcTKfJ9E6FUhQBDEMvTyvQvoR+mYHXvfNLfC4su3rMzmaBR4o3mHrqdEUzrIhTP/u
-Dbn+TcZBaS6yIY01d2wlA/QLI7WAuWB0E2yHXp41ZM7aATaf+1w1fST8P61ojNM3S3pBmD8KBUsWC8KK
-/AHXjPmpSVPgs9/jmu9W4H1YE33lM6S4qF+f1eOudUthDzvmIEJD8xDJf7p7g2TOmiiZu3O/WMRJtaMO
-96MdCreM3uiZirJa3TX1Rl69A88zvUma/vdNwy5rrOz5jUJrNJQ+J8bY2XXIjaXsOoNuCzW9tbN1mt5Y
-F0swJ8oBFDN/dANnce1RFCgbXyk0Vba80ajTfdy8bQ403DrvTo8aGUoNSiQ88OLYQalsUO0yN8jwMHQ3
-VPkL2jkcZil1bzyD7k6F+4puiIUrGwHa5QJX81q7nWYbhcrx1e938i9Ziv+n+XzuD4sK2K9yIvnh4YOH
-Q9pZ4pzsK2E+yRaQVowlPH8eL9xRewPt2MN8SfpSI5fHoS6n5qqA1Q+5+vzMamYtiUqn0eu8g9Chj9Mh
-ZpD5IuR0zSLyXP7u8OoO+TKZi/8g29t1bap6i6aqR5ruXGzeGFrZCSzIfCW0c7j7RsXKebxV57C7zjNl
-SZ+vxLM1A3myOMVX9UN5XGy8PjgdjUY3obW6Ls3Ix4zu30fXcYZXgKoU0UOd7416agAHONEPOeFc7eJ8
-10gDOcQPbwdj2TXp9rYW193PZnVZDscZuUDVGXFoxUqjVKmFqpWh1UJzlZZVJCuiMrQOBV7ZJNtr8Tqg
-S0JFkwl6u0w4SumCI1xcFw13jiLCGGVjNFsLhFNO0QVl5xyFIaJxHN75fY66dqfn1XwlZ9T/5Zdffpm8
-ejV59mz/xYuj1eqIc79jxyg4X9wTZVCo8RqDKVvtv2O243aApzQTjKYpYTyMyt+B/1wO7FPBUnVRAMyJ
-3N3vLYXI4UdKI2WTkQ+MrkX9BFNc2wgVxqgsPkaqsNnfe9V96Em2aF28DiDCBRGBP8F5MoFJbzpahHwd
-RYTzhs9oc1h1UwoEmqKThvBxpmrJ8X1eD24njI0hHt42U4SxUMfWwi0r1gJv1iu76Ro+LulF5rxz/kw2
-H8KkcBty8oTivJZSN703VT42T+naxfng+/cJ45CcoFzLQHP1b10upD9gZ/3i08C4ldpsKXdawpohq2bF
-hhCuau5AEmiKPBhlNCciWkpqVFdHeGgPfrmbmicZTtNtPXCoTdoqBVkNaV0GGE4ttAtIDjatJs56JxM0
-/4nRHC9aG8FVC7ygAqc/JBnhnanFNL+pj7x29OiArs4qJO5voEiCcdBceLUmHSvQRuMgCzMWRksSnbuF
-CrG318soG5Slx0JYOy77sSDiqWq1t8vnZGvnN79zt2W75cpxhesPGgYJyTkSEGNSGwRZvLXUgJPnlDdY
-+RiAt4+bA5k6gIZdJWRELrWgxSLaoIewBjd7eCr7K1e04sk2BtHiTZYVTzB7kqadxKOid048nKbeaT+4
-N3ohDiXIioSbg6YahonpapWtU/JDktU5l2T2Y2ShXNnymskee5OIZvNk8S1OCRNTuN1YU+hxq8qc0VVN
-vOzfkmQre1Pk3S/qQhPFQyFAeVJe23/1av/ZM68LgGzADmC5PFqtmnVVAnwHxo5NsGxPVYTW1M9dkBW0
-RLVR2YqoXttrlh5bhcTJZIIeMTInjGQRAWvL1DvYB9kxFNxDk8d3ZGff4sUbItn65Isv0P8BzTP6YoIs
-YbTlG1WlfH9lXmquvh3fuYKwNd3Az7uC/9kJ/GcT9GssyN/zwvtoeAtGPXtDRgGzPXXP1k5NqSpBLcBA
-LBMewiloiubo/n00V0+fPiEPrwU13GtUUbw4N4rKJ1m0WWxeYKcL6mdb0QWj6/y7bVW2ePHpk5lqtTYm
-qift4XiF82uMyCuc24e+/Gy29J9rwrY7tQI1AjUEb9Z5TpkYow+tWcCLBSML5euOPsix+GC++/QJ+Xy9
-8hvDtyKCJVFVQz/L0s2iTHESXRCe6mNcK1kRs1GhePnpE+gParRpyhRQ5e6HMCYs2WCRbFoHi8kEzXB0
-jiK6yteCoKokcEf04U5Lm1KiZtth4aMBZIr8BV4viO+6Yx6ZUSTNXoeRPN8QNrAlXbq/rUHQJB5OUNUv
-C8A2MD13xjtJGXJlGy1c1Wc/NiY95lZSirlQZ7OyGDzbigq8MODBk6aegj/X2cFMrTqjTvVKVzTWZa1u
-9rd2ZeOduzbstEsMlwUsE4OWJxP0lOZbBGiDhxFodjkSFAGfQrMtmmv4nCKaxnBFGgclUm1J1NZ/k67O
-VBa8csBMJchmjM5dsvsGTadT5Hndip+h6qe5VgsG37tuU5pXnH1j+1oxc7sKYl5sIBarupyAaq5Pzk/l
-lnDceaiYTNAPFMflDADnYPgCjMZbhLMYqcPXkqxQkslJm8HbiirCJkBQE67wOeF6JgEoFUvCUI4XRE0t
-CpKQhBIwIpe5+jJqsayzcIl58GGMfI2hbw220rP/QQ9ubfbbF2Y2G1EliqHvUjQ3RlhXlDMCY6031dtT
-U0N71VAPbbA5y1cuRsWJvm6Um8eO4tMz+9uSExZfFVDYpMOcUUGlAGTAdh6CDEmneSp3MhRzoZcz0Z7v
-seQ4rVVvzLZehlJg0oO6zR37IhYFkuoIb70qzRjjkR3bah4/S3T1ecMyjc/cM1hu/Xr/un+/2N5G1p2V
-XmQcr3LIHG/W20P+vi8PS+rd8S67tQnTb23KHd0yt3l796pkBi755pUZw2kKif1SYUP+M9da4dXWEEda
-SgnMCfIkVO/ILhdpZOx7icU5S0HUfbsWUNdA7VzhFYy7NUa2VYURTgRc3WyP7Xb2FCTcnn46rqtowKxR
-mz7+ANGZRx+49Pk1+bAmfLdTulmxzUK5tlr7h8t9vKBNAbJy0yw4rELWBGosjZyts+4lcSbBtjizLVrR
-bL8rULGwBxZ6V7PeSXI6RJoDh1TZ9FmjsvviStldOkebzhsrPS5Yki28o86Q/bub3kQkJCWCoA/Jyfnp
-9VLlOV0pFZ4zSlOCs88fUTr7lUSiB8+/Q6HwnGx5sBkNTd30O+Lv1s7blr65tkwOsFDv54zwpXrzM2Fc
-ORQMZwe6jl3poj8WrXYamAHN3Q3M/j25aytfPnkWXr4hbJNEu9mex6iAMkYShsUWXWlvgHt5fA2h8Ksk
-gz/4EgK7Ngv5JyYb+ee3ZFWWWhUFk5Use9rSmse82YIsftutGBKuUgmOkZekiSAMp2eUweNFksYRZrF8
-qH/KqDhL2q/qbxhZkMtc/ioBndYVShqXjSKO8BX+lTL0eIoeSJmt+THJ9EeHlbZ2FG/t7Fct4wQW5IyW
-gk81Cmr7HVcSx1iLM+1BjHD2ZC2oirZv2T1bmTmDBRFvGunRRpJ9eBJXb2QxnTbLd9pujCEdECvSBh60
-8Og6JdZ5jw0aCCneyGkZ5ASzaImm1TIM1StT7JQFf0VTXTj8lZs3TYHLnfow+/qrZi9lNSzozCzSQRED
-HakgTL8Ul35F36J/f/P3H8McM06CX0foCOrWeW2jpSSLVWo1WedlJoJqAJaYL4MR5AY8aBvq8WLTPJQW
-E06ZIPGZPLE5SoD25CxvfGwKObpnhahi8s4PlixrddgnyakeOqUdt63MMfpQ88po90QLjgUmvO7wWBhd
-wbmxKENM98ZiOteCgtJTz75+vjtFPhCm37ZXqi2wqlK+mCJfLo12lTLFX1XJeNWuZhAsZI6yjqbKoLRX
-lbMRrQkJX7ogwXllrypngwRprbS77NkKjC11YB73juT/6nnLvJV8u2q+Xcq3y+bbWL6Nm28v5NuL5ttM
-vn3VfLuVb7fe2MVMEv6apFJg+X/B+3hvFLy/GMlzx72JcRgozXMkfUufzHiwcvi4aJ+6wqWOr2eC4UgE
-sGC/TykWwerk8HQ0rg3cyerkwelp6YFn5TUlDk9m/C19TdKAt40oP1KBpIQfCWARSbZAdA5qSVBMKPgh
-+p4yRC5BzTBGv665QN6Dg8OvPHSRpCmaEbTBaRJbXWwMwzMfF0868km7YIZzRlc/0lZ2V4vrS7t3by5w
-DrfYcNsmdde6IdnHvj2Y7c0HVCJoqmggJJckamXtls2uOlo1SKKrJV3amLyODYWvZ6tEPDG3Fffm3dqE
-apf3yZ1sLZbh34iQjxletUKrWh40RnKMcRv8jVxqJpOYzNaLhU3Ra0ghRmdexiDoeIZkZ3yFG/ska3Yb
-PTgRUCpw1B7kjjtgp4cuH/f6+lgyVBRmBk7ICmxJK3xOig0W6b1kjC6WhBGEUb7mSxRTwjNf9CMqN7L2
-S3luirBoj8k1vJzgeYCbE/zdxZdJnX3dy6B0LAQCVqVbJDzx0R6ykdWNfX0ts2kd/bPwIhFLuraMNh+j
-s3CeZPE/5Nxav39EL+MjawfQ1WgHN1XrLHXPEKS0bM3KG9A3SR7Nh/ClQiorGbv5fmQdPiWiNSuQLO6k
-lidx/BbPhqBUSNF1GbTlkdoWU5UJoltMHY26fVrFS916hWbSg2fiPAjhNF/iGThJeXgWxWS+WCa/nqer
-jOYfGBfrzcXl9jcv5HmaiMAzj1RtbusKoWn6yhtrS5m/J8BH/Zv5WipUqITEVfgW7rb29qNVXJ95K5hF
-CphSPd8Gdi8oF7eC2ZJy0Y9US8j4GxFv8eI/vtu+KnyGDIqUlOegSjhLnkCJ4tCmvN9aglUBt3nWg6ra
-NaktbN1VHzqnArQnJ6rgqdMY0698aM6SPAXDhkGyiMbk3euXT+kqp5mUKzVaN5oxUOu7RsQeJKodZmwe
-MsW/ws3rTJf9eGUXru4azjMuaelDzeXG4SDjlpN0M5V12N1OzT1n54Zs9pm4upmxM4EknFv1grGaVBr8
-sdNXp2uQT+LTIYmaSieaahS6E1EYnhOyG4MK9/niOIjgJB7iDHLVNySG28fnMCYNhK7dQ3eCZeXb1ul+
-gxe6bVX2JD7dNYvzXV1vWDO+v1O6DpOpKABuwlF7yc88iMdI7R1D1nBThfih7eyGU4jj6HJ42uC0t//n
-ZCs7sMHp4CDlkY3PagYr/1iPca/kwY2vGUFyU0YJRzi9wFsOKph5wriQdUPXxmbqYqsd1jQeAlEd71Af
-3hk7Ex6jWddoYlA+LkE26Q1SRvs7pZ4v/WtnOzVyuNudLyqLYJjSCKdEbt+YkWA2IDrwNs+6/juQpeVh
-HqL+tDDE1d2oPSdhm2SyIgLL/WqiAX2r/k5/Z2GlMlTJUuj+fbXJvrbmgHWKZ/rNtYZ7mGJhkFrB2NLr
-Zz6rJb52rLTrZC2TxJJITon3LU+ySMXMuHTCD8bIi/GWe0XmxJtoJDRZtI4Bt6EW2ImYzbGvPH6K/eG8
-2B96zpjykOESxM8hrZGp17GeLG5E+0DlwDrd3NY8CCn/ZNsJ7DPhKRZV+4IImxOW4qKFmdJtkjTKlUoe
-U7djL6l0O5VK57jTc6o+v6ZkkLt84O/mofsAubsT/IcBdsl81Hl0U2JDhzjRdbaDLu1yDmk5nw2MH6jH
-Edy/312qwl9R/mZnyXmQhNgwKiv924fR8ZDYYtYk2JYG5JxsY5UhwfDzscbGw86hA+R1ChewR6hX52T7
-FK5mnqLDLzsWsqIht+9yS1tYBJd1R90yFXJbruXrLqkPDt5sGspqpwIHta5uvvIADqvcHoHH3hxs/4Hj
-nGzBe71LRF6dCLw4veU7F5WbX7PLaoXJ5oYlt9hVaaE9+lvN2iJP+pjG6kSF8/Qe7/tyy17d6XGGbKFr
-BpUoHI53hrEYDsI1XDVbj3tMTYuPu9RHCKI4KmKkrkY73OHXk3tBOUW3zMBNXyp/9vVX/hjNBMUBeCop
-3+Fkvg1YK+tZu7ZymzEsyMqN5lu0zmIyTzISo6PCo6YXmDaCVtAKD5tvtacMOqrg9kIrPW0qeJXzzQCI
-wA2TzLgJurxHq/LEGaFvDb+cUNA3MHwBOHqt09QCEpxunCDxpQkSX/aBbPd7lWT+WGLeO0IrfClL4qYi
-x5kSw9i8KuHRvisp5t7Y2dVBzO5V4/I9Kh21mhTm31c/4XR8L/D/BMkv/VFx+bQkPP/YKhErY8grInBg
-FyM/g4N5deTDxiH7lg8fOidamNJFoBOULIgQSbZARZdBCa+tPHDQVX3b9RASZPT1OsuSrLXrFi7VYYSz
-iKSB6W9ucdO56wRk+uqpEvI8pQv7fR5Ckph0+ExTh2pMhcXwYjNS2Anfaa+A24PqVU6S01pU91RHcDtP
-BAX6e1O9MuQTEGgyRGqqrwoLMvZ5t0U0dzuJfnjpEj+bjaqS9Y13hfPrxMyakc5FSgxXvHMZxmhGMDqD
-F+s1VFaNqc6jMSR7f9GK8gP4+zzwvvBG6DHaH3Ql17zM41EGaU+R94WHvq0+Vf716Mh0279J3n9HYgMn
-emaAwPGt3gTVqSCeD9EHX3PX9++vksy1AVhFguaOtJNE4N9f4cu+5mrigq250hckWWHRFg6NU6POw9cE
-YHIqksWQ8sPwqzQ+jVwnzIaDqVHF4Wrq1M3VEL0fF/xOFF6hfpX2yB8CBVin3I0aYCAfpz/axccANrFv
-JRgpYLvmzSp1q/EYQV53LezsGewdqHMPpLZbEC8Y4etUZ1/G4RtgvEOcNvsy8Tp85Dhl4rutumD9yZCL
-1srUs8cDlUm64QvMCgHA79a3qRHo6YcB7keKXkMVW3pWu5IDuvsPBaKQBbouS240uqdnp4SgbhlF3i4D
-UkXUAqz/XDumulDaXubsLbkUDmdWvdPXYfdFg7ia2Jsi754ULQtHt5PkFO0hT7aN9tAH+fu969K38n7Q
-Oi7FKO93X21pQwVvFoENnZF3E7NyWzD1e92IbUUgD14tKArP+DuW2kwYstw65OsZFyw4GKN1KWT43/rq
-3ohvfVs1yQgLtlVFR/XxI2d/VN6+9R/qdDxozG04WNOxOm3xguZBt4pO6zBcVGieduS06sNQIxus3FgE
-W8M1LmP0EO6V2/lGA/shrXonGwFNzaiZ7PlJnodxwkgEOVR8wX+i+Tq33oqhOfdHQ1GgYlWOkP/cr+Jz
-YHCOGoOyZukR8qdGMSMFtSCrPMWCHCH/0WwtBM0QRB5PvZnI0Exk+1ps8IDF7S/FKp2qmEX1Ik9xBLm7
-p96MCkFX3mOympH40USBe2y0mybZ+ZHRPR0YTFKyGiMsRNvnTS5MjdYU3Qt89dtXdRqzpTOVX2ARLQOA
-JteIOZxrljptX45vu5u9hGLw/qMky9cCEqNPPfnSQzR7mibR+dTTOXTgQpHRsYcYwTHN0u3UK355Kj/W
-1LufimOMlozMp/c/rKk4luwD8ksiX724vxDHslSyWiDOIkuxMM8W0zxb1MtPsPzlPbYwKzXMYU5zuiEs
-cGRFoJkgmTiCHo93OhNoArw6vnPlXA1PUsLEi4QLyrbDFkVBy++A4Cc5ZiLBKZ9AStOlghRKAvbbzbsC
-4zUCf1Tu9Z1CdHXO2I8NP43qzPOEMbwtIhbPyXbUl26jKlqze9oWhmz8ZGP3bbPzRMvxFoBUjZ52hJDL
-DueY4RVv+HVBStyOm+R9fO46KWzgGisl+fn3zbNI8+TBBRZrDkcPjcQe8u7jNJ0eetfyPTF1hJbwJ0UH
-KnfwGdCvzTu5OX3NC/Q2Y4SdGe/uwuDj81swDm5CuL+DN2ZBbs9oGbaTz6t3jmvjmp0qgJsdW3YIwkp2
-3UOH6FGFmF1Hbv5bhs+zWKNaVDsBOKeA7c7eesOaMkZldBNJuEEscl5bMcXFP83TjqrhYWRDGCfByMLG
-uzU7zRQtDTxGPQez4kQrC59pFg1h3TUo18gG2hT6f6ToFdZ3CDxR6+l7us5id3rQfu+v/pCwtm9X/10f
-LygXn912Y8TLVFH38qmdL0HRtS4iaiun9P6aGSXwDLIZSw7LvXbOFA7mEmt2hcpxr5kxMFozRjLx7vUP
-tX6t6ye6AkzazJe+cvmKNF26Agvbrrs9maJ/0z9cLv/mlyIORfv4HtVG/qppje507imceXqC6pqRfy2t
-TdvEKfDMHyNHCKKaXfstDXavz4nsnOmUKJ9vz6NTkpdJIFcNMarwwOtMQFDebWaQ+cjmr1kvvbFRUo6F
-IAwyPam8CfGn7afs0/LT6hOHBAqTY2uova6nlMIb+2wXut4CgTLdic6dsDp5cBoyAqe2wAce8sofDXXR
-VebaBRHfCrw4n8p5ui/wYtOjQof5vOGEYs0HzrRNpiXsJL35DWBiEyV7oMfI4mp5NXKTSWkyL03khwcH
-foPv5OuzPj4BZZpun3U+qYoY6fpqSDaYy9jSbbUAjpBPeRjla7+9rVdW0KMq63a7mGRHR1KKo1w0eNHY
-pfQ5da93Q4H/K6dOQ09DeQ/DYWjur0VJIPIa+ng3tXRJGwaAk4PT4pIp/yfCIpIJ9I6T2G4civK1yx7Q
-JLQVWfUSEZTpJiJVpLZD9FBOQTUe5eGKrNQdRY2cMIMIouZxdXM81pzEN0bjdkgROvP5keJhSYqeJEHP
-4WmxOoMpRVMpC0NuolDd65et03TcJO5n8rF+oquZkU4OT4/bznVVY5Jx1ZHs2IQzIs5mW0F4L+kbJbsX
-gFnwFnippMaMiBBAem1WyUCLKtia2L/pHLWSajW/1aVRlbX2CB02GWsntY9RMscROULeF94YafUZzeD5
-j+TQxlB/HosDFLGr3HLFF/gUyUF7U1DEEK2KRsaUN7h+k8SX9lR/wO+eKanFeGouqdxYUydxfnJwOkZx
-fnJ4ir5A35weO52VNci3eMHDcubBU4X+f/Kub7dtnNnfn6dQhUVkI6rcfsDe2E2LNO12iy26wTYFDpAE
-gSIxtmBbEkTZ2ZxN3v2AQ1Ki+E+U7RRdfHvRjSVyRA7J4XA485tNbYH0OUCzXr6+dr05huEU+B0tYvzn
-fX5eFSWq6odOL6DY2GzXaYhcKrWuyUjTp9cuzbJYaOzfoRsYfW4w2NRrhnZipzTY+ZhDmzBFuF6XZnF6
-1y9H73oF6N0BJWea4WV0hyNcxgm60WkWPYKOEDDItfBwDdOoGru36/nk7d2/RNDe4WeWsuz8Xndl2aUo
-11qfhWttcns4kVdFaXDKEEQtR3s5Mc5ok+zi9GmpPQUUJ7ZRNT2LDOZDYRPApEtG+csJXMo17LLXXvWS
-dIYQIIO4J1/MX3EQ2js3sSPxzVR2lfaN/VMr7vutyZ/zJEtRXu+C7Y0ByDtYZflyR1xvnKDQE+rvfNWZ
-pa3FOEulgIgs7cOaW29w7eENOet4GeMIoRljluAILvVQjWRHWnMUhSzIafrMvdJgUxI3zIFADkZXwXYT
-BdlP50/Nboq9E4uBjkxtFMG9yB/oQW9ZDCb0g+/AowF8OR9KyNhytEQP8GCJHmzG5jmqP6ZZ/S1boTxR
-s5QacGGF6RN9Uig0PaCPmqAk9vuzHfhuVcRwEbfKcnQe56ib+3WruWWEGN8oLXL0hSWRPjryXmwjo1OV
-wxbazFNNbFPnU9ocJLKv3irG9dci/54v8+I+P72l4Vmf078FGNDbItUCw2wjvIHLvFYl5Msl+kbfaDZQ
-INfWIL90pbo92RV3DEyxS037+OydGTLcVpsV+l6tRvGSe0SPttQWbAZ1Bf8hdXXuhw0i8JgqPS6MRVFd
-bXB9in+v1yuqLL0ng3hA97+tHW50V6e+/oG3Q5lilopfA/efFKtVXOJukposVBO5iKQoYPoL6dHMhOqv
-ioh2GfHKlpttpTqVKu6B7Ow+1eKNw6+H+KQe67wJ+EWIDijbfDrhiwtPIFwfv8tSGvy0FwRsswPrIu/E
-HUld5frAi4T7wlMX+ETKoyoUHbqguCIBvecfkJ0mZubNnG1y9AeRPiap1URpAJ6NXOpLjGsuybUii5XH
-pwnLYwYN/cx+6zlMd8hGCtGftqKfU6nw51TPLWWTP9webooN7AxUX2QgqStMLsScfa71pz8UfQPvL0hj
-QMcgIEKg83xDt9vAYZMVpYNxrz7RBRhqRB8Rd2g88yYT7+PfJeTpXCCvBEHF8OuZM4RH2qMl6Zbo6H8s
-rZj9ELcZl+zn9sNQjdbYchIynG06CYn0uFU/G/4U9VfcB4BKCyu1KHDN4LmYW/hePdfD7z5Pv+Fb1l4P
-yrD/pZVogzJ2CfWkrF3CGyEJ3yf7makRma2slLJyvuBliGRqSxkOW6IFrOt7e1dUc0DLZjSi3+iDd15w
-RN8FUmgkJzlhNd6BsRlw3Wh6ZshVwahBSNrYO+4mIDlCeWqo8DFP1eLgLggVeDHYX5VydTzHUExzJOY1
-L+I5DfqUP8M4oTQ1zTqf5psjU6/0w2vX7no1O4NW101BJ3y5kwvutCwjzKaiL+zQfihWYfnqLuL5oHl+
-Ec/1Weku4rmYB+/D+SCyH871VD+cO6W5O9/YDWH93pSKC2WLaAd3JAyQTPJHTEt2GUPa2Vzgl9FSdTtT
-9XOe0qGED6Tl9ezfthvtjYbI8ydAcpdeVC+Nx6jXxZXru4wQBtU5gXy09B4fvW1kzKsJgFTbiIK+Rduh
-BmFDG9NyUBOPjmxNBBZhMUua4Dm3jZahV+P09kNcoyZhEYvm/t/AhLIEBwawjps94vmFXGcOhmZg4myN
-cB2vy6lXY3OxLXWpEBJIka6HVrzjKfwbHggvSo2VpPmJAAsGGBNFen8xtmbNuCmmcFYlJVEJ2TlhxbuY
-rdzwX8xhtkLDwcmArtla8Ys7RKTqoLhf+D/9Fa0RxvF8kBkKwGznvbKHnOiE+3Sy5IW/xctI1eJE6tIl
-Cn9tLT0HYo0v3UU8H2BROk1T2HWHdCQtm36k5QG6YdwUtZsjiB9KVpVAcZqOXv8aegFGSZGnODDlL+vu
-pZR7aTmAcba0Hw6ovEvRxf0AQLug3cvBflB4/4iuXq3GZosZkkyibkFcDXerMA+s+SaUNVE7COqfBVz4
-kPYNdkrc4a539zvefW93pVxX7I7XloCUFdHlH+VhD20p/kS9PYWDYZuplPzUxjvhbsATnhlU/yaeaa4W
-IWdDoeVppn6qPV/TQvS3JuYJdq62nLKViTAsHExIYo9BTRe4F7xeBFbwuU4QBozWe3RXVIj9OL2rURV6
-KE/bv3iBVbbOam2EFfRf1s0hrqLYwHXBq5kOGk0T9prHa2SSmfB9whZK9e2JvkW7Rf9j4bjOjBqm4E5u
-hW6Kf8xTY2FwQ2rZDMYcHsNCHx0GOrgdPfqJN8J4HuYLfE6ACd1708yRg1FvOYS8t+3E259+hepLMrE0
-qN1GIO26F8KxT3Ng5qTJHB0m5xmjhzUn5MYZv7jvDU2WqNlOeIRnUy+gd1GB/mzF6Uy7kiUv7kOPRicI
-f74aD0EHGtDE72VSrLN8PrCRcvueuZXnMa53aqH4L2ntf34d/9DULs6JFmBd6FFmyaFSe2Bh27Bou/Ee
-H70gGLMcjkEYjI3X/LC167rQapUQkXsihaliRnxy6YWP18cTjibxqNhDnpQAJpYOiQc06uErtjRF70yL
-qcgsXZroAyK0px3lSp0wKG/To6I81QQJMoVgKmsIalHQn6Yd5crkRg19plwKA405hqhHU1FzCnWnjjlq
-C901VxEcfhomucbvG3SlqaRMSX7bWkkuGUO7kaH5g6S5konXqqnCr0bFah9RRbT9TXXO9jefyAa9kHtA
-IwoaTZeH6imziPM5csnwmmaYnGbOivwuq9YqIIuMNfPQY/PVgGvLFiq+w2FUByHtzP4HY9NLbpKkQfaj
-vMjROJhSxzrvacjZGGF+LYefPYGNs+QUGmcfbSWoHyZui+rezGMygL2g5yhP26psyjtV5AuirS0uEScS
-sIAEsHy+npwqw2prKzeLz6kyWZptXb5QnaqyK9NQEmGu1Znkauvzc6GBgAP2vOi3BAu/V1RYlrh2iRjL
-seOxCw4/KRqEuj5SH+a2+XQvOIjoOeiqNftRzlFtH5oViquur2Oqjeq5z/K0uOe8GAVnULGGRPnsLj4Y
-7+asvLt0h9a3DoSh98/Tz8vqH+t16eQeb3f1cHeY19DRmyMNsHzJ8lNVAE7lpWRNZCibXQOiYDFk78cd
-HEsJw88zoViCkpksJSTL5sBAmoTJS7GFqlqcLFC6WSEDFdLAOE9Tiocpl5Di+4xog8kSWiMiDfL/hgNg
-NvyA3JDJ8htDxiIKJ3N+XUIeg68Ipdg7TZZ5cb9C6RwwOTW3YrQWOAiegccFJ/RLGVfkvN++MlW+rYtY
-qUYemipQLxulCn1sqqTz+5Y4onX/NkY+Ky7gNg/wjk2sW/PoiNGik+4yu47OFtkqrVAuJMW0IyRrAWmt
-EP6MdbdVEadJjOuRX+R/lij3VXfy7qT1XrkDyxk5vcqSpSbZmzEuUx2kESlpyReupohjbGdLNkoWKFl+
-Tv8m5+E2u5CFa2A4JpWE1cKGzED6OmLlZ1ai/K6CgmhkOelaaGru2E6LXmlwOI5dKXVu4ejdiZd5b04I
-eesVnBJEZBlP8b+kyOss70uG/uQwo9tF1I5V7ygMyVphmkbQ15mlyqZMNekcrOuE4hZ2FgrrizGqVnN/
-KjLG5R51D2Y+Hbb/tIr17KBsamerAiNhWzOnSm+qUNfTAXXi/EEorQmiO/RwEBJzWepklsR4oLfPo57Z
-4r78nnpmSpcjZv7xts15sAPRNUDZDtx2LM0IW/hvb3qnIWLUhXNTlJmzU1sG7JzrzarOHCN1xcnDsJ0v
-DVMGzI481sfSBwXgWZiPopoOT/oyMb2geq3jHN09VyUMM3yqiUh22L5ojW9gBTijIS9q3I7L3tbw1b4q
-+jc4Mob06kDqzS5MkUeSkuSKZ9jZcbJV6sIxKPiTcoy2zYVjA5J7Cno0O4JgRMiPfLpCX5Lv+yE0w1C9
-tUiVcb3gFf3e0sx+5ZN174cQOu9ch7LXDzmfhXsOfRJQq0RqUZb7N+k2ejqYsHq63Cb/RZIGUiqcCJgH
-mgiSn2+1u7T62VYcs/FsqlXvLBXBG3stUSC4uJ+bOY5PF8WnNTtZ00dQ6/LBTDpgPXygxxAodOnX+FNV
-+tfGI3hHQHdr0ag3c92cYkPyq0BxnI0nGXalKNYBhpsq7Kbj6FFJ2laPHdQfEcLEuI1Zp6YbtAn8pl+F
-R7EmttogecGhuohTlL6nAegGlY0Zn4v5fDXkFIUX4LAjGLRcjFngGdi2qv90SVnMeuCvKKJDFEWW5JOd
-Ttu1A0PKDZa9Qzd3x1aZN+DCWB4qhhIATse6D18DE2YOR44OyxTUgVsVcKDnAqPvrsTtINQdSJqhmA1n
-IwWBCVPIFdeJyHbeDdw1kl+KfBRQa2ZHeKPeLB8nJ1SK9s9duqb2zLEBlP7vHttvhjqKzNbWNC5+gtnw
-LKzbBsV9MroM/3kaja/HkznZBF9fbf7z6tXtIKWQzoiLYpMs4FjMbrU0L40eqnoFkNZVM7iY4tRgCXZT
-xnCYHtOHzUNFP84iWdT2XKqPzJEtkvykgFkMeKQ1VouPHXJSirUA44Nvohpyl5ZP6Jv7ZGXLXxQPCXR7
-ik/yLpCCmvl/1MXCpDgqEGJjE5mju6pYf+AZZXtIQbwPGXh+eeq3iWf9sf0bFzzd7OBvQFZaf2xw16bH
-GYNdxrYA4ELSdf4zUyOcg5fesedDWPnWceo3Z5qhE5BPCJqwl20DN0DKetIRnPnGQyehaWeUy4z3OCd8
-LaSY7qFZFU0Hg7zoHgns6eyS5bM0Ik6Wzm0AQ+yztCIhlN3bEecJWj1ja1r6zm36jTtkHb451LfLuSXn
-m2r+PHwpCeUhHEnQ8w3SXUNe0yJXGJaLYonyLxmu25C4YTAVav0Ri3WLN92EceRzN+BDdQKuVK1EAnAS
-qEVEGfm/9I6QIkf2Tb2Q3qRohXouzG5oslB+7vc/kCrag9dN2xBGeOTT4FPSS/xuEeOFCfXkRmjORUzm
-61jjPlUvUN7jsyA3V5fnGorIrojMW6H3OCN9QIi+ZMyE3jbBl04x3k2DBo3EF9MJWBwIcqAVR8Efy1ys
-ENYaRG/gTQSH1kapEKwrhVGBqIslqJPfsaDMg/osvjHB1xfL6BxV6wxjnmG+mcTii9+KCsj9VayQhRR5
-zXKTCHTI0w4B7/HRG/kA9dP9Pldijj3fEx77jtoQ/SZlvXfiNRydDZ24+01NGhhMm7HD1CxRtSZSqmte
-UyfAZPL76dkfUy6biVz1KGo83GyzfL4RV1Vf4rqKS28RY+82Tr24zKAY+aTi60gEmP8mzbYsP/SVz6hd
-+V4d30IO9JMr/+XrK//tVc5rdirEVVXcX/lv30zSbGsqxKi+ZNmFSfHN6q2vxr4Qnuw0O3V3/EDMBTaQ
-iHgorL3NJyWKEuXAK1xXRT5/6+uLgcYE5SbmggvInf9mlb0lKwMoH3uld8xqH5Pa5KXNY5fRmGxWjPH0
-Xy3Qtbewi8cGYAr+1Wye0S9ZzrBtLxtzvE8Gh4NLXXewqTQkFIyqpNmkgpoXD3hahzb8faojFkqFTvHU
-85OapXiQVBOycLOk1VCar1EFZZBi8hXdQ3N21EvU6j9ULYGec2QG8re4UUOJRYzfZ3XXEnabqSF0bJzh
-nXckimMm9190orrlSYdRTYopnwk98BdUdmimx5StIBjTGPG2OnlnjBqiXojsroXqQX23kIRedNrjizEs
-wUOHIjQ4ek/5R1n8QomE96xQRDJX56hWBs8wcFqOVijdJEjgKd6sQ0/MGIQ3a+/YG5W8G++8knZh6r0a
-z2Sv1CcJLQ3dYTYvo090AmBlApYdFYVUEcW/VLgiJHgxoCfKMXWxCQh9SYVsLm09y6+d6ew9573Sn1bx
-OCNfVPRKQaWE4IqOThkKX3PSL3uUHfqadj29YMKg0Z1ay7M/mYee73cy+ytqkkUtApUbBqdVilo8zln/
-6NCTjFltZ9NRc+BRetj7Vd3WFghbW9Dd2lQKlp3Nz1lpX7exqaQG7Gu+tKF9Rfewn/mwn/1/AAAA//9M
-7K4QklgCAA==
+H4sIAAAAAAAC/+x9/3fbNpL4z5e/AuFmQ6qWKTttul07Sj9pkm5z12S7sdO9nuPzQSIksaYIBoAkq4n/
+98/DACRBEiAp29nt3du811okBwNgAAwG8w2j0Qg9YWRGGEmnBGVYLMbeki5JKsIoFNxDo6f3WoD2oxXD
+Iqbp/oyyJa4UeksyRjhJBUc4RXglFkjQS5LeW2OGTuUvNEajL75A/2+aYM7RFyMUzFbpVKJDwQB9vIcQ
+QsUbKFG8lv/EIubhC8KnLM4AZIw877j6+S1NCBqjg9rrd5wwA/wa/s+IWDFd0fG962AwOL43Gi2JwBEW
+GOEJXQmEEY/TeUIQk5gpQxlhy5jzmKqOfReL10TgnbqmyxQfKs3RH8sG4SSByvmorJqjGWVoQvlKtUJ2
++wWZ8Z2akReytyP/WjYEnRCCljQiCR/F6TSO5KyZU/RyTVKBgilOfYEmBBF4XhBG0IRM8YoT9O8naMUJ
+R2KBxQBa/EojUIV3aXalZBCTxhT5GScrOQlion7W5sLLq4ypr/JX7eOJwGLF1Wf1uw5A1oTFYqtB9FMN
+6DRe6gbIX/W5mJI1TlZYkEjBGC8s07PS3XwwJAWfpSkVWC+E/uQriwV4iOZEmPST8xijT5/Qx+taq1/J
+xmL559On5rJ7TTjHcwIg+W8b3InATLzAQkGWTzbYl2lUQOa/bXDPGYHu6DWOqy9sJd6xBADlX2sr6YpN
+dRPVTwkFq21/Fdehf6BcACz8sOH76ybVTVO/Pn1C9+dEoIcPJf3hXTCw9w0LMqdsq/qVPxiQaqqUYxpm
+jAoqthkJORFy9r07fY7GqDkh5D85jVK6QWOk2HswCFdiGgxCxd0DES/J9/Bz0DKQKd04h674pudTW0t3
+aeZna6BedWU7zSX3lvBVshu3UkUC5uRSrI1JMYNHVbkzoK2wg+nurGCq2ECjbcVSkj+6l7qd+2Eb8zvd
+ZvrbNqt/e0FwlMSp+p4/NBZEOiVJQiK9IvRTDer7VZLM4gKsfLRQUhGhc5erbFpyZyA32rSgZBDzBsmB
+v8Jf20RW3+Bncyarjy/TeslnCWHiP8hWfc+fbEAGxLFjL+Wteym37aWwY8m9NCUb9IwxvA2MVRrPUFAA
+meQAulGGAknxi1hKc0N0gXU1AH4s3z9BFzhMSDoXC/m8t1dHkrMO2f4LfHYRnx83vhsNDbMVXwSyrVUJ
+gwwG1XLX95q/FC1hrJ09VgsAz7nqivzVIIdG4abHRNNjqsdMwR/LD0/QxbQkyMRNEEnLi+nZxcRFEI21
+pEjBKfqS4mQ1+ZVM83mrHmqUeENI9Gx6qUD0Q33jzODQoH+1y1G8KUeVXGPFmF58K039yqsa+N8p4xVg
+40UN9EfMxbNJKregxCzRfN9SMBcXm28d/TBk0MZLa18qBSqv2vpTKWX7Uiv8EyPrmK74q6hr1RuQ7qke
+6amuaWOUOZYfn6ALUk73yD3dY4nogpxdRK75bqBWcz6O+k70N+RK5Lxb/Tb3mArbNySeV/wnkkZxOn+e
+UO4WfOxs0Fyi/TghLPpcPrCxQjksCkDv0GPkvSAJ3pIIWuhJWfV+DmJsr58+acTlXjywjYPebAUztxAX
+YTXwDCec2ISzClVNGQhe/IXRVbbT5lwWC/i8sTNLSq/lGPG5/u06M/K5/cxYZz983sp+jHUnMdrX2kmc
+kHQKrE8C6af6oY2/ZIwyBaMfjl2MWqKxMupcRJD9t4gIhpiRQ1jEjDnV3+e0yg000Uh91hSkJZqXVEWo
+otixa2U+X8RJxGADcbIiORIarJ8IYhTYTQiZdgkhOdpy0zWm5bRr462ItGVBc3HAi900RKpI0FwT5d7t
+JO08B+pH2AJ8N7LyeRddNVorWfl8B9nuMqWbhERzWHMt3TYh+8lx1TK7yXKKAO3CXIn7hlSozC41Kexs
+l9+Q7/KAz5tnogKlbHAJp98PLMfOZ8W5XsIZb+oHRBwncToHZsU1dOVdQ9acym0wAg6aF6i+PG5bh4YW
+1aJbx+l8lWDWoYHXUPuMrgTpCctxGov4ty7wCaWCC4azDrhfP6wI23YArdKIMD6ljHSaFOQhOweREwlU
+bM8yuXvnNFnSaJWQwM8/+UN0BjT20/lbSQl/qB4B4DlNBaNJQhjP3y/nU0ZwmM5PZAftb0NBaSLi4ms6
+P9GEy9+s4hBPSfl9msTZhGIW+cN754Pje3nzwilNZ/E8OPMfwDj9xOg6jgjzh8h/kNApqJQqLxdCZMaL
+crlUEQxRo/gQVQqby6cBGy7EMnn8mkYkqPIRkuJJQqIjEM2G96oy24dVzMh3mJMjJYyVbMFYfHLgNgvY
+Z8vGr4aI1VlWtUOhLKPgjC28/Amf/ZE/rMsFsUjIEfJfYL7IR6DynSyzBAvyjiVHyM8wEzFO+CjKwYES
+tTLTYtqYiJ8LZgKafdaNiwVZcmcLX6mvfVoHiDpbBgi7W0WuMuZs1MurjBGwIfVrmUTW2TCJtEe7gE+6
+W6Y/92oVwHa3S4J1NwxLlr8gOBELZ+uUEPyDBurTRgNrZ0MBu0Le3dw5w5m7oX9RX/u0EBB1tg0Qdrdq
+QblwNgpMIz/HZNOvYRJXZ7skzlqzFNdKKI7+mp4QzKYLzbhaW87V4cnZ+JP8e5+ma2SdrddIu+mqNhRn
+457DZ22V79dEhbGzhQrznVBYqQjca2vav/Fa29C5oACsx6yNuaBs27Xsc6hek1cBd89fBdfdxmwlWnZB
+gdHLVPRtXLbqXlg/rUQPnlkYyNy0M0B6DW1pG+wc3gK0xyat9QbufboA6LVV5zahzt1aA3a3ENxkeMvy
+mBLOlZ+Ka388Qt4TQLOfxFw8fTIyHrwelY9SyZsdDXgDFoCyES1tSMlmHzA+fTIqfztaUBMIqVgQtol5
+XURlJIoZmYpTeoSkQGjHZYrCYZwKwqYkE1JKgDOvIVd/qAum+rjWPGD7UgQmXPhHhmir2KftPK6nADBX
+guUp6Mz/z/3XcRpnjM7ihDD/HI2RL0Vt/9haXDdFYWmCXFcpb4jLOS2uK6cRtkrlUSQ/DcCBg1EqTqY0
+I9XTRg4zRCVE5UhRvA0f0DTQ55vnC5zOyckKZkcFIXgBDdFUKTqHKNM6dsupIMcL8w2N8zLhA1WHen/s
+KsUXlIkkTi/lAaRUGTeIUhwwjUOi66BZOUeis4KmxvuwXOyB/x18hIWOzvwHXJNXE6k458GPD41R8PFK
+LE4IW8eFlGEMDCAb6sWiz3xD9OCDOVBD9KxEURk1/hlGTOHkgmaBnMqDY/uKVGA4V5+XFa3rGOMZCu7r
+wdbVtxgR0lWSuPRVObYqslAKLCQ6LTk6Go8Nnu6jPbRGe8hXTL2l7o9I9Uedl80F2GyHtbnXDQLFaSwq
+5OFEiDidO+mO1+SlOrWjMcqBw5Py9bGtmN5dbUWfVT9Zi39YxUSYhf4mX1hB14RxZWoqgH9Wr6zgNCOp
+4NGkbFgVT/ga/0oZuj9GB+jhw8bHONUfrcjJFV5mCSlPvWarXtY/WlGoDdJCtlPzg53mK7FAY3NtVsGM
+D+GrNBZBOSQrsdCYh2WN7zhhKV4S49VbmhBuPP9U+ogO2mbdr5ym7YtST99/P/nrm5ALFqfzeLYN1kOY
+0EPkI+S31jARFPeqgaRTGpF3b189p8uMpiQVgSwbrAet+FWxm9awbsXNyIeLGaPLi2UF/9JmWGWFghpn
+i7dKYAhqCnUJ90HD/W1F2DaAnaoG9SFcEsHiKRqjZfULCz+sCIuJFmY+1ArqbrK2LmU4JclzUMtXmA1Y
+IIHZz+IrG2NWX9B4PEZrGkfoYIA+ovwl8gDvvndcY358E4vpIsdv46hTzAnypiwW8RQn3lHeC416D3mR
+3KmYd+woukovU7pJbSXjdEad5VIaYYGtFZIZXiXCWXKDWRqnc1vR/JO7Ura095KrrdhZEnRdO5FHd6N3
+B69bpo0avx/xhCTWecO1edo2c/Jvkm3rAuBZkNMKffqEjNfFVGjzIMhxhm/wkvTYfXnNwt7s4RonKyIX
+xUdL74m2bJcdvyTbIYIyti6vlb/ceIxWaURmcdq0AaoRSogg1RacXZLteVuPSMKJBVcTCRqrBvYf5bm1
+nw5u2tbq66pdgE8XRIrV38eJMD1JCzY7Y4QvKvXOANTGaEE0+BBGZEZYncFWK5IIazVWJKF4iQWx8ehM
+ViEFbEmRwB/hLFZ6XP6tQjiWoqJlO1FflWf2YNAYo1AvcuMYKnnQwD2ceYf0pmGaSqHksaugiJcEp1Gk
+zKAS1m4HLaZiyAinybpBj2tLN4AXGZ0gjFmnd8jIr2Qq4Hsda/U5C2dxipNkGxjHCvv2FoUZo8u4csAr
+f45G6CT+jSA6Q2JBUELnFMUpCjZxJBYIpxFakHi+EIMcAs5sBZx8k+L1BLPqBP4NjdGjx9VZTVk8R2P0
+p4OD6vtE4pcH/D98NcGPoj/71c8RZpfw9XD2+NGfv659XYJw6f/hy8dfk0njo3I757+hEdRe/TqZMxxB
+O9EXAFr9PI3ZNAEOd1Yh69nh44Mhgv/Jpp1XdQtnj1u/wgcAgV5bC1s/n9c4xFqSMvoy5CSRM8b/gxwR
+vzr3QpxlJI0Cn6/njU9CsMBXY+sPEf/N+h1mgfpc1s/Xc13tsyQJfEamIpw0KpBLKDg7K7uCzmAcHmnC
+nNfgSSokf7J3QNZh7wF4TPhD5E8cXTS60EEB2TgrDLvyh2q22D9vWz/P4iSpKAzk1lYszrPD8+M6z9AF
+ty2lDqCUc0wyLBZhFOMlTSP7wOQTcadhkGjtVI4abW1sEnIl+a+R3AkiveDQHvLhxeHjg8YaLIzkcoke
+2L9ztDdGPkoAyaZAt2mB2t8ZTP/8L9/KXk0hqW38l8Q+Pbhg9BLUWZtFLIjfArSfz+XDnGN1rEorRmP8
+v7zJJOixFlt6knchfNy+HP3Dg4M/+m0Ebavlyr108nnUtnwU67cSTu8KuxDMjk2T7Kp1kRuNtZbetjKW
+jtLMH6KvH4XFXLoB83rkZF43mdaPLNNacgDBcMpjWf8LbbGVYsTjmhih5dPndAWRsQdNlaoCcLqNQySn
+gWRvz+LtXalkjA6tchx9ZpeVrWeJojFGMZui16zafdJrOwlJcWGxms0SUkzjGgfssQwaS6EyO4YoNiZI
+fGwVh8vxDGy49RgHzWG3QN9qGd1+Ke26VGr0VhGcdCWCYvCHlulu9dY2BP/KlMZJYps/OElqyih4o20/
+FluABU99haCG/thy9G4sm6dQfSvi/f0eCweEigUFJ/sHgf+HwqLlD6R81CCU/Fzzt2vYCmqHWF3GH+xw
+EpX9hfNjHLmsnYA21EoPpIHtlk2Hxa4ZEWKZU+5+NhvDiTiBlR/T9C1O5ySAEwy0THtUD+wVXlveXzsP
+r9c106Lg0USesFU4shQRf/nll19Gr1+PXrzY/+GHo+XyiHP/+F6ebUNp4groavECLKFTnJDAJ6WbBSMJ
+FvGaSOIcmcFCK7Fi5Ah5cYr+yA2Te4a5OELeH/k+nlPjPZcvIxNyCW+W5pvmqwW8WZhvmq8ieBOZb5qv
+XsOb1HzTfLWFN1vzTf7qnjaJy2EppghbJeQdSwJ8OUQzRpeSTPmsgcN7RtLvGIbgBXwZxmlErv46C7yP
+nh5cODInlBMb1LUJBZqhN1hFO1+GfDXhgsnpVtRhAOdeEiZsnM6Dsj176HBo1GyUXUHCArWSZf++9dFe
+QQ4fmuFSTBVtHJhFHkrKOHVZmmp5kP2gWjTvyMWc0VXmQpJDDSqRWyuWHN+7LgdL+Tn8Xxqu0QhJ1ns0
+GoHDgHa2+1aN0QJnjF5tQ07YmrAwoptUSEKnWxgQuf7Hjw4Ov94/+NP+4cHDnB7jR4d//PLZwZeN+aCR
+38lsgMp7zghPsrb916/3X7zwBk1U0Oa+qIA1eoOOecII7Kj0MiaBsoDCpiM5+5ab84VcZTEj+iyrdrAt
+ryeTiMqoshc16VZ+yhNSBPAw1w8DtKewoS/Qo6/QF+jrg/x/hwcHB6axUjcCjZF3nD+MPbSnsAv67vT5
+iZpOAzNspKbgN7BUsn1EdLqCzWEK9EBjRPgUZ4owspUe1KVfalPFXoFuTzYKIjBGXoXIjODIILFJVfn8
+8m/WmoxViKUMUG1cyLMkFoF/nNuKiwgoCP46RjF6gqZlrFct1CuPnJviMzPAa7OIE4KCaThdYPZMBAcD
+kAh9VBPxoaixeOWCbcoAcpZMC56huqoQHgxsipJVqqlgolbFNHKjmoElLkh5hBiUJwxzYiG9Zdp73hDt
+Hw4qxY0kMh/NeowB9ZXf7D6VcH61OM+LO6uulh4i1RSY9fWGnCzopvTO5K1NKsH2+YJums2qI9sS7mhf
+HdUQbeWBrmjiaAS7y1HOnLnA00u6JmyW0E04pcsRHh0+fvT1n/70+KvRN19/9ejLr0svOmXcCXxG1oTx
+qt9crX/lBwjpMOeyPvsqb7OYq+hBBeUwtJ2dH7ujo1XICE/iKQkGoW5awU+OQSiCjaxIkZPLpIpxn+Yy
+6X/5jrC0g32gQB6g1eoAV0aAVP3fCq+3mgui8oqzeLlp77aKN+KqGh0MxyXwPZfnpRwyVK+CmspFe4DJ
+vhtqT8G2NZKXcFjQSaCQheQqY9Yz6xSDd0PDBmyv7ro6CXKg0pup4abUOM/Vu+nrKB/wlHFisRrSWs7g
+emPUvY90yi2zK4aVsQSEJzsgURlidJct3oHktfLiUY6Xvg3idEGgNn+6YHRJrDA/godf5UxMolhQ5vCi
+Ux/ljgY/qnRS78IZna54XfWlv0lWp4gcDKS88I6TvzOcQYRdzSmypZQlIE+d2MTiCPl4SkZLGhHwT6wS
+bNgMBj4CWSZM6aamjLq2t+XBJKHTy5OpXMJxOkdj9CqdxWkl1UDTU0B5kq5jspGsgKRCEd7KE3c+yYMA
+lw9MZaCOrbAMkoLp/nBFWEnjH2HvDQZoHx3aS05pslqm9sJxSgJGN4NcKGkgKMpoNUO4pGtySmWhocbc
+YvW2+MgKPDEWE57AWmKQ4ov7VUHFWFaOmc1WaarG04DdxRFC6Y4yynPlkURQOWQU4n7UcmgwWMrAVvah
+aDkmGGxmULgk34VfBctTuIFKSKVR405nCu11l4P/TT0etyO/ECrJmfK9qOQ5a6jW8mwrPgfMvku/lav+
+1vMLUxlAIIQP+ANsBIXz9B7yJfC3Kd0AiV9jsQhnCaUsKLkEGuUCUkuVUAMaI0GfLzATgUm3ToWZva/p
+ajmRUqijr7mMNKPsJZ4uKjW2GmfrizxVZ33/oyPYwlqZyi5g1iPwfD1EAs8v2yrMuyor1ZwDPbWbVer/
+oJ17Y+QPWxpqJ64NjWypnAAw8LLxbqTXA/e3olXXLa2KQk3ktOGO11VH7iaMWedqvHYuuILX+f6NvJco
+a+EVRCfPIdW8ObdqQ+7t1L4RmiEWbVuJxV+x3SzQECMle/aHFfnv0yc4odZ3hUZRyZzLorkMaCnasW/t
+IN9mbGDzfFPBHiou1W2q7PahzAfckzUdIbLMxNY77txKmgEp1i2kLW7FZkbNOS4j3OaGkjPms5rTaJ2j
+saZlM7pySV+Q+bqJ084pf1YqOMP/HidDJLiL58EiBwf2sz3Jytc4ObdtIYOWDVNzVlDOOKqx2Y/tXKRr
+k7iTzWHHTaFzM7BvAjswfxt9W5m9OnDrWWxv+Qss8BEM8ND6/Q1ekiOlN2q2p1kjzO2zOLo6B7m4KXRd
+211EoVwLf7wk24huqqbTBxD3ZrM0qy8hX8QzcI+W53X16pJsn8OxdYwOv2xj4KQRCgJfFZaMwd8XyhTY
+6WMBpkarpgaSdPRR05hh8b8jRU2+hSi5OT8HVSTnSjRhHlrNS+g8zHCMPHn89holUyri2bZhcdZfl3z+
+M04gU6T9e5H12GuijkpnHstXqrN/Wz4Jgpf2LwmekITbv81ikkSOb2vZCSzIa8gL1iIINLod3K8QCvKR
+B7Xe35d1tgZO5aRoJWbeSMMJqrOldcQN0sumyTZnmPECc1ADG4SYv46TJOZkStOIB4Na6OB1LT+gmlyW
+WAg5ty/J1piBl2bSQ6fC18Bo4xsa5VkJ1hoS0hSbLsnWH1rlNzOipdDoy/r8IVTbCz5jdJKopEdlgIuN
+4RYeM7onuqCNjDnOkpT6TX9y5thtJDXQn1XBdyStLrULeQ1y5T93GZYeJLYuFj2LKsE9Vdz2Vhikspct
++tN6CFlNlrHos6gNbhXYW1RnFYHlaHG/zswMzrWCTPbAtuDaBRs3uZkPoiEtN4ufbjNyZG5rTalH584/
+qu0wTcD/IFt+ZI5sE+QnPSxH9YFsgr4B7n5U3RWdWSVqpzeRa3Aw5/E8tWpwJE3OfDAoQrYLcxc8dkCf
+Erw0geW+2BnhX2sS7JgtLYIQRg61XOQWvcpmq03Vo7P3fHi+NxqYUk9ieEAmahs8tsrRclYU+/NHi2R7
+ERJ5kqhs5bpm733qVWuNU+I6KuQmdAlT+ruMPYeyBfJuuxwWi5MntOYMUJbeMfEgFCxeBoPzvDr9LUZ7
+6DD/2PesYiOaGqDvoXYYINWQnjOgEAFgWAyjWjlbp5ctE+PZ9PJ7WpmrOcZeHqHWVrhrO42XBOrSDn7q
+vhUcRUG74NKq1Who7IuEW6ANv5XavNgGVywJ/JE/uEM9G7gj6c/HvTwtb22obmTasx6DHOcdS2aVhOJI
+af+soi5lEQj//rP1PB9b3xIHC9b7JhJrKOpIpyqsRSL0G1Ndo0KhFa/uwJ/6SLZgLHRnKuX4jIjpQhIm
+As2Adn5qqcqqGG1SOffyHthitf+qqV3iAPpbz/aV8RkjK6B1iO5X3xzfQHaoTQ74axV/yloV7P2xTknn
+u2JgBZ3PE4L4gm44ogwt4gjuXSOIkSlJBWKrlCM6gzv6JKbGmVQhMIm4qNNlEZ4slBrlvv5pkcpyoE+f
+0CJ8C5W/XaX8ZtKWYyU4rIcLlYYezGAj2eFvp3SVivGjA/9WzNDsR2Pt9OWGHQZK1zqS3bCtIwe31NNB
+n3OR8rbkCKNio4pTlOKUaojmalIFx20GNy0RBREaoUPy50Eo6PfxFYmCR0B67tuZeDuDrqX2+/2pqeKo
+PKma0Q4GW4kjQw6JI7tQ0JjTRc9Nh484uguzNzYvC4Tk9eU1gKC1RRanFdus9D7fhJ/hOCEREhTNiUBG
+izexWKA4UrO/JOoe8obKNtdrXfQ4N7dRaXDcXsK4by+wn47dw61SaYzUoek22zqcsrhlWz/eoRn5PYO3
+aslUISmdJ27amh8oF7cTdSgXHY0w9RaVazU7NRguF5rG7KjdUmkkCAv/At5jkNir0xWnkna1UcvvmVM0
+CK1TEDpjwPITEKTKKYFrKR7v2tZvcCHlYmwwIsVmADJs9wmo97Bno+/WOaCpnAdS3s0Eh4mpELZuX8YS
+eHUnO1mP+VWfMq3zq8dQta2OoCTnoEcpxy7xGWexTjXVsZ1Whqmxs/aZ8jdcp59jyvfWFMC9qWUOxt0u
+Ty3L1S7QNrM6Gle+1dN8YjPF46rI7MhUQkf54ifCls1bcQAAjRVg7Z6aHA0aFxgtEIBXg8DvGozRMjRG
+xlMNbpoQnEICyvotSPeNQjaTz5TSy59xIntRBoR42sggm+VZ9Py6kG1+1DuvQTtuNTpuGa4fMC9TaVYz
+3/W8oQ/Gx8zH2f+uvqzlmr4sVCGMY9RylJZg38WCo4e1UR9YUqW2XMFXcs5WYhmd/J5WFTCTWDSiYOQ7
+2QEwo9X6oL7VWm0Tp4FOeiKb7kr/jBHRpK73tbBAymYqxyc1eoPeA5CV3W8dAbkKf/+kB17Rn+ishehM
+EX08dlI9jzhrzRTZIHjppNdK778Q8VbzYfvOlS+govM9kL4rGZhxk1IjhqzBox8+RKVd/IWyGAergfVG
+RXOHqBKlElFX4cVDtBqiPx8MWqLRKrj70c/aWxcJd0Bd7lzdaBt7Wyvmcr9rvar+QqLWE99Uxs3iK4Sj
+ZZzC1o3EAgu0wByRK8GwWn1TyhjhGYW7YaX0ppI6wGrTPCs0ME7xihMui6IlFgtQnchzC/5tCxkPiwBH
+ZBTSXmccEczjZIvQEl+q2i5JylWz5gynAukEu2YjOBKUlk24COqre6DsmyV15Dfb4l5iftlMu3IRXNhZ
+dQNv5kpZUvJjiQR+q13PZfqElnwaI1VulxwgMJ3yStAYMFluH6iEtleSgatLAYtAU67lSc+4EMCr5vaX
+gi2Pl1lC0DTXd8tx5wu6QRg9yRfKfpxmK/FUDzJIuvmCeyW/lOrXnaReBw4QZhuSqk5/Lv+YUdAOHOGD
+ONVX0Z5VbkQ4r9DPUbpBy4I6gbcyi3h5HhXzshYH0mEN8Bk/Qt5U6Fwk5b0n/hOgNpJcYuwJciU8oObY
+m1G23NcIPITS+X4Uc+A3Y28qlP5HM6Bg4MnvcCti+TFvWfltn2YQ6Dz2PqI5EYKwE/h/fiWB99RXKVFu
+bSs1b4DaRQ3vPxAq3A9+8+luqvkhyouDhutWqvo8sUWpsC/e2MNV8/QU1QKn7vhWQav49bMLuIpbP9uB
+4Q6bNQYv0L3c3FC8+/QJPW5zRM1LFK90aEbT9qzCV37AkIdZlzJfdhRU94lEjbL5+0+f6qqAXEMMs+tC
+rhY0Rv6P2sSrL9AJQ99i4U3IVJDoAuc3UmvfX3h0xB8vcZyUoOrRMTqVxDbGKFXfO0ZLKL858OKAixqD
+G8d57hT5LAf3dJuRU3qygHBYz7zw17NMK+U7DZfWf/XoBhHZ5CrDafQins2aap58bFdc0GV+34ojuXp1
+ovxAEklz73RBUB5SpeYCCEkTQlI0VaAhOpUS1pLglKMtXSHMCIpTpBLiIzoDwWfDYiGRcLokNCVg9vG5
+xsFDdErROiYbsI3rl5AJCF74J3hN0IsYJ3S+Ij5IU7KmTZwkiBOCMFql8SwmEYri2Uy2iCCaJlu0wdvc
+hMXiKE82rRRzcFcCiqUQparCoEOMUy6wZNEamK0SksdBy4qnNNvK2lnRzjgVFMUiRL/o3nMhGwZiohBK
+8cdlDzaxWNCVQBEFEW8R8yGayJ1qQVLo0HLFBZoQtCZsi6aYkdkqQSkFmSKnIkE43VpI6FlWqJqP9AWd
+NoNFPFio3hHyJOvneY6kkLL5CBxdIVcg/wOA7RtvvKqDopevyG5UOWQDRULp5SrrRqDg9oXcohtIwHsy
+VptQNyoTuoFqiaeMduMAMO65sgjqbAtG9gpLXNlkFScROEh8z+jy5VXG7Hk6Idyul68GxFGRzTMjwZYn
+CK/fMwE+i9EVGtej8uXZKI3UmvqwIsoxBaKFKmA67Y/Jcc/0nDov8/cY7QC5f/9QstlqoWICtZazHRis
+3UR7sl+WqLXoqp57telEnG+A79P3aZF1zEd71ar2kI8+vk+tUfj/xlcTLTl//Bj+iLkIT+Dai+vrI/kG
+sIAa5Poa0VS+gjg2MI1eX7uwTmi0RWP0P0+ypyp8q4bKVe5J9vQUz/mR8zsspqeuz//28SOT/AU9uByi
+B2t0NEaque4a/+3fngj29ImInn78+ODy+vrJSET54zp/HAnWVidJo5YujVSb/8cBcC0Hz2/OdmLeOwWJ
+ZWoZZVBxdUAK22RZwHQKDpc4CxzeyLn/beNgCijBmZfnoYf76PAc6ZtW5F+054KqZQwxu6Fgf6VxKhsH
+zKtWM8zovbHORbfjXDYS79kKuor5KsNZ3kw35HVjoCzy6N4YOmGFa5f0AFD0ynYyGk1x6gvEIQVLvuGv
+UhEnCM8EYfnJU0oMqyzCQoo+L+SBTu797ptDJLpTGmjWOKzQsFc0edFus49WM44k2aliYE0yNqc5Axvb
+f7/nX6hUhJ/y0f5kbo6f1Kb7CTa7wXu+F5y937zffx++f3C+N3jPv3j/cTRfHltUSmK6sGw5esDq7vjV
+DcQS9dzYLNwwWpxogajICi1wShCwAejtD3oJlrOQXJFpUA7CwBXVrQMroeTZ4flxWyC0AnrkAEpiLsda
+tVWiPbfHat+XgC6Fm0ZiCzIvCQHIgd1w0SeWW8IpG0vaZmFBtQxqfTy1dJrNBeYLV74aLXEt9IHZ9we3
+cp6qHI2bfm29OZFN1HMHUNXO14YHY1XU0qkN28Keawd1K55KbmuLy+/vjIta+9aa5+nGvvQ66i2m6ds8
+x0TdHVhNEbtjvSW+AKZIH3+f9jlVP27UcnO1pmO7sOdjK/J9Xdgyff1Ts7XRNPAmyYp5bcnNjFY+wFnW
+6cqy0/q1GRyu3ePBt6nAV65YFDwlp+Dc/0M8X+R3VbkbC8G7gNDWjbaEdpZOFC1zuFd1RwA4+oZ+R8n1
+LPcHai5SITTEgyIz2WpTvf2WzMmVdjF7S+Yvr7LA++/37/kXcr3Ddr6HvPfv+Z581plh5559GsuzdWCg
+HVqGc4KnlxvMIn6kRqhJgg3DmTIxDK0XVZ4QuOthTdwYFjQhf6csckIw6KmtlmvLtpXH92kO3bkfwT7Y
+Lh24R/CV1rxXRrIZO1ykP54T8TIh8ud321eRSpa074OiYKCRvkoF/TkmG4fzMF/QTVDGNvGzODpvn2xK
+/1u5SNqSijGH83VQVVXVjnrdk9iakL44arWmIrdvp9ZEgW3J6mWHIGe803F6xZKhRbC6lS9qxuhUZytz
+XccoG6ZB8vxmJ3IUD87tec7uzP0TvHLg1/k/wP0SlbeZBvnk2skpeU7E24oJqn1Hut+8Bf1mEWVll0U8
+vbR323oOGGnrxIUU9i3xZDtOJbsprpD7fEcWqEI6lq3XF6m1JHM0YpOMWtqCwYuMWzAs98dot+JthkL3
+nS9tw9d+/HO/tdo5i54d39ul5Q7p5doxC/pGQnXMAS37z9Ud7xbr2MIVAGWXH4MdFmRgDbS17oY2aUay
+7mqwe90yn19OWDPAWzYdQa2YtMW9hkeZ1m2HXVlDGHNI/RGorCCCli9ukQ1EW0Qlfvk7ENRWv/x080oq
+XgF1T4FmbSX4E/TohpetyW6NxsBd4MKLY+vNzJBhFk94AD8YXaVRoIqWbR5Y6BGhJ47L55p2ouu2zFBy
+VbeKR0T0Sjj1r2n7Gaat6ZnSmmUDpkQO7JgZvQPszcXSMj+L+lQ+ZDRCX1euc7l2eKI809ot88o6EGI/
+3uujFIO/HUlWC+2TTds0GqFnQpBlBtZ6MKH+j2E+mVH6PyhOdeoAQaVIilYZ+rCKp5fo19UyQxMiNoSk
+5W1HOI1qaQF6nkuhUH4ghQfbidQ0dTVFctPkZT2RQMv/fbXMTjGbE3vqVdsFM6Z5q3HHTEXfUXYyFISL
+QJnH4vOBa9suqvsVjRFkxDlGvzaq/NVepTGQzxPKCZowPL0kAmGBuMBMIDoDTNrXhaTgUALkDVslN7Cx
+vL8eLc1u/Oruxu0Er4JtylWVW3jyOfWefzF+z7+o2HBGS2W7KNp13NobwNtT2mzOkcKAA0IS4LIaQXYV
+Lq97KxGKNnVuPBlmwlgctc7kCwTZwqOgaG7DvT++6XZf138D2rOD86Fq29nhuavus4NzlX8zt5cZFoT6
+Mf/jvf7Dp/ilEaxYRbaDLoeI3CXtL9rFrxwNcO6zO6orexsAhPAUjD4G4ReD65GFFADQ0sGGl6HdKtfR
+jzeGNbHw/Cs7kzr2oNRWLHXqk+XyvFA2W8lGjoL30d5g5Mzn2eNGUiOUvWD8XPh3rqDJT6957r/WM5fN
+3AJilddx9u53L2lLLY4Krofokf0s3+RCjozm7RXbT4rFjM8PyGqy61nQolmoZ+VcDuTSD5Zmyus2CuUK
+fCoo3L6ybHKZ3mz4DtRoFoL56gief0nnRyC1W0Ki27Vdcrb3McOVmrzz4/ZLXprKFA2ywUyDNHA0Upvm
+x5pyIZp+8r1uA8hPQFUUp+5bASwXCtBqG0pf+p7Fq/WX3vW9iheHBX+I9O2M9WPEoDey/EjRwFUk9OuN
+ClzWy14VHuz9aFLZbQzaNHzae6EztFMlLlMj2BOB1qg1cOj3g13tEb/787qN2+hGC9qV6LLzoA9Nlug6
+MdW0CA8fdmsRimbWklbeXq9Q0WdZ1ACAwBG4Zx7tD3sl6iwUAEqT8cS4uhV9lEewQ7SM05UgrXU9usHt
+Ijvo7K5/x6Y2GEnbndBlcUGd90HTjqIFQVwYDC5sKw98sYMCAOOqPg+LGZvJ18pgGWt3e12FbWW5/1h7
+pWFGNo2R7oQ1coZcLGIuKNvmJVTqVPWu5YaWNstQc0NYqK2kLNlH5u0wv/4vMKWW8lt7vqHdkzz9tqlG
+p68dSRvXISNZgqckGAVnw4/XweB8MJoPkf/g8P3q0cHBxG+tJqE4klufPBz8BGFNlas3U8G2Q7S2WXDX
+YURTkof7yR1oHTpHo4d2uUh61NQFVquy0houhr9EYwRNbl7i0OsO+opPdOdd9JWq+95JX40Yudnd9He8
+rVgvk++3lRhyxFq5b3RtLL04bQ71j2Wu67CMv1GsVT1aPKhDHVPzgE9JKNiKi2f8B7FMFOf8jkbbu2Rl
+67Yb7G7OwuqrqnkCdXCoMqygzr8dFpMeZM1vEdyRrsVu9VKKBBqzK2t7BSgP5GmW7qFVJVVEDRxtjYRe
+tLbwO0WEWvNss8rZtu/a6OhC1yvot2IsuVThugKHz6FQy05Zw3p2eZ4TThU9uzzvEqFTKmytuVC9MlWp
+zTQjcKVd6lIxrnGiqOISeGTVZx6AgAY7la0vSvW9PlUW+InFqXDfLlBWpgCLyj4ieHGESiTXfeq9gFp/
+OD39qUGTRdbagkUWviZiQSGPYNmQRbabb7NDbQ03KdK67Ap76ZvWcVZ3n7WPtuCOwW7Tn3vQRcvtgbI9
+Z2oQbDeBBMIx3bBou0uwc86ZdedzLwCzrJAjMvA65qDbBnajOVFpTnVuNFplmyPIcWtIz7mDm8MueeSb
+xhQqr0DFaIz+/eSvb0IlV8WzrZpBLyD9rRQzh8hHyHGJUnFyUDwTnjoUtAD5d/XI267np5tUCt4qMUun
+MXGS0Im21X+X0Elw1hR8zofoI/iTHyHIWzPKEhynx9MFZpyI8UrM9r/xGqTleE2e8UDiHyJPRapLpB13
+w8WzWY+WOwxFI1nct/muewqpd2QR7Jo+5p6+eMmr37zUKR3tfNzWGjCYUZ8+Ie8NRS/i2cxi9BmN0FvC
+iSh8+yCQLIZ0EIygmKOUgkJN5fL59s6Pu7qp3vdF3lg5z6BKIwXsLudQOUd6jPad+vQaeEob2wlex+n8
+GP2UEMwJ+juO63kJXDNO4rmLGQeDfmSS+p86Lavk0WN0gtckOkZviY4K8Lruu9aOtMUVdXevgqm082bz
+D1KadU0/S3WOadOSZlJ5Kexrnu71vLuqVqdrLLrr1ZPA6xH2qkuoZDmelYQaUjXSyKksNxVzJ71hEjk7
+iqBxxZI7mSKklwN4eViRf2vfOu2sKs1ghEW4hKtSPU5Sy7y/KGsyGQRbJWRkCoMj7cZQoO21UNkLu6gH
+CU5fYKGkB5dg1ejCyzJjd1n6lj4EjUp0hu/7SKUYQc9pRIw61dvjz2mlb+t3PzahGmOfhpU0hCrRXTUB
+ob1YS/5B35woxX4yiWG+8SOjhxEWR8h7onMDXQ8beQrtddvSFPpT4VfTFL5jyRHyR1xgEU9HGWYixgmv
+zOJwIZaJzh7YmhnwBeaLCcUs6pscsDv9323S/JXXg+Vp5CxZy7QqzJb0D26DNFL+wXMto3kF1qGwKhCZ
+Wc3VS89+J0zTjQKgDScKhbJpoGewO5jEKGicf7I3kpEZI9y4ghI6FIoFSfvZLgxq+53XgNRBrs25QBi7
+s3regeAIjtBETBdKs84LN52Os2It0qu85/YO5C3jVt2b3RjWTiYnBbLiVthOGtzNRfmdt+JXU0zrhWGZ
+6p43dAWz3WLFoN0u1bdlSpWMPYoZgcvmA19wrcX2bRkC9H5hJMWtcuKCBesMkDn3zcGTOL08MsN5FeMk
+CVkOERaCNdKea6M1PyH64qUWM6DRQnn+pzOAGY/HyKegi64vXGO2DPO8xtfmjlchzHO6zFbqRpGe1IHG
+H9VaOTXQHCF/XEdczTsVL4kEqr1eEBzJzbPy4XrYOSxm3bcfGwgvUI4A8ZK4MvXUvJiMApYI4+LjT5hh
+WdJ7GGFBxp7D6Jbb2Lxffvnll/3Xr/dfvPAGA7SHvIcSS3e5H344Wi699rtotT1GUNx7/lnqlOWDdaMm
+1xws6pktiyCvskrDGym3PEYF0BD5yzhJYn3hoZ/XCW5l6mfFe2itXIeU21D9xAS5QDW1fM7P+HmuJLy+
+5wKLzqLzxeJscb5cni3Pi0LXlU6dxktS7VA5U4L1wPTIUhaPTfm58XXJNTVSulGuWUvjK55TY8tVPlqp
+8Qac3BWGpzXnLF1U/vXNbtfOFxpfnCLfQpzSxwic7d6dPkcBJE5IU7RXGeBiTFRzYCrLJuwhf+BXKFi9
+W7lCxwwLQZhs0Ajc24Po0/ZT+mnxafmJD4J9PKeDb0fHFbLrIiqEZT0wyGKZEvUJp5K9yGV1dng+GKLl
+2aPzwhXDh0Tar4tJWCVMDdMBzBIL+/UEl/PF68V2b7rLPNiAczhAhKrCYdd6b523TS8HyH5VTn67z1He
+gJcp+KO03ZeuZ72uXXeDrHHSQDKoLgsrMiOW1JiTMBHtpfLMgh7YQSFg2xoC6bYDqmam9Mc4vXR1VA5c
+KKsKwLHhlsd/yPKUSMk1T2yiZDid2yTwse/oLUlCPYLNVIAGzIIROSa+FMCPRqPNZgM7Gk4juZXJU/Ro
+Q1kSTRM6vRxNabomTJAI9uNvY07HfjvqvXHJUHy5771+/eLF6Q8/LJctDc9L+g+zw/GBo4Y86GFG2Us8
+XRh7tW58ZTkM0WVrBESlUsnugku0h9Q9uuDusu5vGSumgSRSQJLBLndadAp3mru8S+OrfziHkZXuzGVy
+L98dmM3yX8zmX8zmX8zmd8FsTuJ0+o+VZaDGuxNmykWyhLiTN3QTDNp8eruJQmki4uxzESWfbESvO/n3
+7OB8EOp6g48IxFX58Qh5EyoEXXq79cEX/BRP/M/UA2Dp8gCqm17LiycHRGu4TDXb2mmMIWsRTgVL/oNs
+XQvLFTl/bTP2w/0VMUecIr6IZ2KfpJCpFKdoQtAUr+YLyOnAVinC6j6IzYKkCIgmC05xkpAIgmts+Itb
+JLK6BdnsUUVfhx4+RPIltOdO+sk3sZguKlW5kE4xJ+jPR9B0PHEzrrUI9fVlL8gMrxIRtGQOkJNgLbcE
+HMJd4u2QKt8CQKtAmZimJ/Kdu1iOGI2VD7B23QZMoFZ5D1cDFN/Uh1Z81aprzXkJyRVUQ/fqUU99xqgg
+9eGXQGuYda2ZF5pNut9o0+fLKfE7G28otppYh7u9VILBHM1Xk1D+fJXHBLxPvUH7UFbSmciyKqMJYFLB
+1Q8fotEZei/ORyrXB19NzuLzgcpz0jow7W2GOBJZj+6qrHyIYrQPzRjcZlmkclls+GdcG4A/T79ylwKJ
+LzgjPP4NTxLSb/dihAsWT8UR8p8ZGmS7uhsnyQRPL4+Q/xCiz+PfiG/TWde2xIxyIeX0HlsjbH5FFxr5
+kuVXmgY+QBAuaFbpJVmLIVrFzvw5yhtK98LFGKpQwW4SYy+5IiEnlAllmC7vojNMWfqlRV/3sX6Jxo6i
+B9otQcQDkK4GobpgiDJBWOBmpxLgx5iLI2Q7UBYdHwxv7kU7dFjIUH4x0TzmIpzHYrGawIlpmWzT6WIU
+RV8d/Gny5y9J9Oibb6Kv/vznP/3pG+sA4ZWgkKv7DobHsbZsI1f4HWip9rbjptGcHZzbM49biWulrWMW
+x0vyY5zamQzItzyafA9nTXli/hIOg/rwCScR74+/jP64HP0x2v/jf+beBzWFOBakep15VdMMFqiBjnwP
+KipplRiHzeO0csuXoNkROjwoR4LF84WovlLHhSP0pfEuITNxhB49Phhabky9/fmOL5Qtu+6GnztvJgnO
+aunx4yFyBTHW8J7F52iM7lffHLewx2bopBS9ZWXyRxVPOwttYCoDLzt56rH7HOxXwn/9ob42oYazoJX6
+Gmhw19Zwv+X7bucKUBOlQpu4oy9D/VC0wHEliQZrub5it3bk+CRTNjgGHqJJO3KE5XkoTOgUJ+Q5XWaY
+kWAi3/XgIeVolTTQv+yqTZDFDKjaZVJr4zKptRacrZVC3BbZ9sV0SbZuPBPMFkTfQPAYMi6qVjolNrMA
+mP2WcRoUL4foq8eDPoXwlVno8LGjeXw9/yEvWGkY+sJAuqc5YChoVj4o9mbHW7SmrGDfRLLfBwlfz/8e
+R3CzMkhrG/ng0mtvNGRRqKhCstzyCfi0HcXViZymxh7D5bNSdoVwU1twdjBUNZ07mnH17CrWi5Wv5yG+
+inngypwtsQeqUgcIZTGogxWVfJfKjSwzsQ3cQ6zbAyeIQsdlrxFnGUmjwOfruSvjt9x+Ah+o4A8LercC
+q+mgoNV06Ki+o3LBcMqlBOAPkXpIJGP2y8kJg76H/KFfn73+wEZHGKx+lYPnt6z4CsnhRSLZl39v1OID
+aF+xzOxtU1MkjOgSx2lwZq0m+hIYhVrDpiAVGbxKA0Whzm1hwk0NuFJmmuorByR/Q9cOaVuixVc96sZX
+u9Wd243c1buWYULmBM7Ju877KF73HH2R7KtafJelLV6Si6Ih6seNKwetuqKwFMQ1EeXPU7vPVJFz4W6b
+IPdFSUX7CtKk9sOrsG1JwPyHe15dvFEemQPgpY6uTRdKM1Jbt5bY1Jo9Se/ow2YOj9ips5V1FZmQk8AP
+J5i5OgfNj7DACq2SM/LxaikCGsqgBSDvpTw5tdVdnab2xSgnr2Q8UR7F4Ersb2C8cmJT/CkoV26kJuWg
+D1opdcemtNFZothM+hfJN6tq+zu0yrZ+FZZstN/SbYdOwt1Kmgb+kq44WdI1CSWli6eLq97ltv17aHIG
+tbCLrDA3bP40iaeX1QYM0a9tbVCXJKMx8uG2cPB8k3sgzMxf3QpS89zoq2x/suh5530blQNn1A3fcpDt
+sgR0nFqjwU2MBJW711owcAK+Hf3zthZnTTRGDwLvD+qa50H7zSXqpNmFVsfrcJpIcswDP6VywUdDRDrw
+9zGotNNLaR39hVgmQzSh0dYfFNmes4CEdDbjRAQDKRi2Dcjg5qH69u0jwROSOHdH2DzkPuu6a6Vlpyh2
+CbmmOyRSciX2cTpdUCbFGRBk7nXw/4NWiEiC+PvhY7JsRxVJTuWHjzoBt3WGEhvbTiC5Rfh4YG4ezh1H
+MTmHaHrcc9w4yVoHTclntxm2ls29N0kOe1Kktpse3nL08z1203IabN/nmkNQdNQAC9p80a7QOD8uxeDT
+FMhzhywciEXMB3bRNQ+puGiRkMtJVKqjg6tBt/rxnt0QWbW/gQ/+UrxLY0jscubLBXKpXKSHyP+L/N+p
+/N9P8n8v/XMjEiCdLUXAh2i5SsQQ8dVsFl8NEc1EoSOWv9FY/fn0qVAOg/98fivn9wnFIuCGj3fM3+A3
+QQq5U3XgDFdhMypbiG/Rp3NTdS6RyDrVHSTllEhzLZW6myQ16ryfDhoooUPoW+QfQHiXfj5C/oFvaeyn
+T+h+zL+P01iQIB000Pn7hr8/Nq9LMduB0dNx9UoWINZqOYGoRygzSyhlyjVf7mx4gEaoeJKDYU4OjEa6
+WEY3gRoqA4vCbBaA2yD0jDhTnxs6ck2KMaoDFmRqmBOLrstu4FDQ7+MrEgWPK31/gg7J/uPK8GponeK5
+YSFJyRyNUYqeoAM5Uvu+HB+/YtyQIHso2GMDo3WGV7+KZgt8OZ3b7M2GUcQyAfPFcHhwcDBEchl9zHl7
+3ZSaVzjZCsLvosZHXw2R/52sEsHMVtd7os76Y3F31U96V2/Y4EiCuYin/hAp5ZJhq6wa0z6rvbLNXJkn
+qY1FjJNCY219/ekTMiyWXGwTEuqt0KpJUPb/1hQKprLVgtfRkuMdcUB2LfMziKo/5LpBL7uypCq5tquE
+SSrgUuc4zVZCSjLpnHhD3VdbsGluD1YQcq/vMujec1t0v8NMmbw3cRrRjdyy5DT9Po9fNcZeQQwlB2sG
+nDtsr4X99dFBdWZpG2z9dW6Hrb3WptiDg2FLZhCLkfXIkZrQEpCJIChTtarx8Xp4Bw4YdSPOnpqIi2It
+HD4++AeYaFpsMt22FmVmkYdkzFxWjG0DnrIoTnHijHOg2ecwe/S3ZFSEYv/w4OCPfquFRtCs0yZiy6f1
+2Uwin82c5Qmaea5x3rXCbZ8KZdc9p3k5dwaQi9jlA1BMaSkI1bzGNRPrwt+9v2ies3FbOq9zHj5Eltv8
+5b9NOInVSRJc6oY9trUuvdJ128lM1RO05XG8X+Yb7Na67pKlsbeBuNDm5QWeOC5buHlbzEU2zq1dZced
+ZrEwxUtSGt2/OXbqm25p4dYEK3aKsm1l7YeP7eVuvVcYZswehnT5b2tAv5Xi7Hc4jTiUU605H6Lw0FVY
+cpEqg3AS5LPxzyr+hr28q0Cx02gbh0NtQTP3zuQYByCOiKeXPFBzCq4BtQNHDG+CXuFudS+pdStLqF+k
+tYaT+zqfiuM7X585n9qpK6r3/wzWtq3Y/I21WvVEarISyT8GrQsw9yM4GO7Cpn7O/aXOW2auoSydawtw
+yEBv5+LKo9F/gheFezEo6cebe11WPg9snt4Qecoxo61Aq335NlVv+1UNDKoFqCQkJKttwwenSAWm1fmy
+HSSNvJYw2wmGxL3VMfPCCWbeQOmyjYltRyNR9LZae/J0uAMZoSFd0FvPNV3V6gnUiuhjhvYU9/SGlX1H
+bjnB4IbW5ZoVWa+ggX1/bzKBXs7q9uP3XxjOFv+QA/ih/QB+6DiAf2k9gX/zmQ/gOE2pkVhplyO6/Dcn
+KWFYUOb4PmErvoBAHQkwgcAcF9jLNAIgkkYWEAJpzb6TgEfI/38WiCW+crRiGaeOLyllS5zEv5FO8rQD
+5AmLHVB8QTfPWild03fkyaiOkP8kitcI1v7YY3TjPX0yiuL1UylnjUbKDvcyIUvRvC6lVhJNabKfzPcP
+H9VwiHgJwfVtGFTVuoCyBD1LITEkjlNL9GClcl3x17V6FZqump3FcZo6yiqoXTVIQ/RgSpdZnDjTchXE
+bihPwukiTiJG0sBhRct93jpLH7Z77ZlEt5y269getWNrtKZZSWfPSkeQ2lpoM0Xqsduhbitdrt2u7q+i
+q+adgNX2Fovf3dIC0aO+lf9v1DdunfrG/ECa+8o753abe/ittWfdnt6fTTvmy23Y6aoJx8QiEOGwOH6j
+EXp0MGgppU3kpVzhWKVxanHByiPq1ewqtuDWqHofM4L9I6e0JmsyaMcIbnOimjCCLx1nYhWh3bcm+RT0
+XtkgJ5SF4dE1xlcd6tY08KG8P1RoSdQFCYJJseN/pwu1adiLDeN/tWL9HxtsAImOyIzX/JflK7/DQDFN
+4uwnLBbtTY7lKAKsf2uHo249VIs/dhvijMJFp/uQ9QBc6nGSdDnfx9l+htXIr1gS/EG+ueOAjs8XyHGT
+Nm11m+xkl7Tg+SpstxQ1QHqSRHGQO5SK+jX3+p5V1dlj2iqFiE8zPI3FttNvrduzrRtHfY304VwG72jv
+yHTFuPLR1CvGb+HiuRv2Vs6aUzqfJy5L1lVCp5I7aom9GgFS18cU5xNbeFJCp3lbZwnFkg5apjh2b3Gn
+OovZjtUXcFkTClRgoILwusKCyrOBI3LmxxxSi/EOrkcTyEjeNErnUqV1pPw/kK8O8eHUHzo+f/mnP5HJ
+N87PX0V49hV2fv7zN18R/KXz82z2p9nBgfMz/vrx14/cdc/+9M3hZOauG/75/SO1sDwM/YuKt6QiuLjK
+Q9xBy/et+ztNopbSC7pWt0bcYP+Csh2suikJpDQlHYWimGcJ3pbQLW3/SVaAxurBlEmPpjGbJqS9L5L3
+Pm5D/1bdotLE3i1dzeIkkV3YLGLR3gfNMJuVtLn652yZpmJf+wP4h4+yK1dNkODjhiOtUp3cbKQtDgYS
+W9EGeYZyHorlVvdfhFH3HeOFTszYFp12PRPf/eLhtuZRkPkZ3jxL02Jv6XbNaMhYtftjGrpahVulqPT9
+jmRduHADKLG7S4xGKtngBJQgeU1FwAnkiWruyeqmDvn//SlNI5JyErWYdlqq8KJ47XX0iEE6eEBQLVZv
+F6ObNlyMbtrLa4nkkTdQQQHec0aAfu949Qqcm2I+PMhR49DE3Y76n9T5v27Su+81IP1ddvcdS+6ss/n6
+wX57CjuN4wqU/AtGZt4Q4fAdSwp6wW+aynri6aXXOxZU+RalEd2ENCOpwjRE3sUkwemld4PIuH/u6DzH
+gswp2975KtR4f5ed/oFycdcdljh/l53NbxC94/5qtK5wTzQa9XG/zzf6Ype/CMWCUSESYjjuFIaZV9FV
+m74kxUtIaaRDtavRiCwm3WkI+jj3vNGOQ/aeQxv6Jyhwp5CwSY15L1qrvopFW83tLkag7IgbgYHqyORy
+lUroVE0L/1TdtaRuZIGc+VfxoKUqKaRfxeFcBWEHAzSCYCR3gWWcvogha6ryxsvViK0l5IgN5Q84PLeC
+/ifA/dJC4JasFNW7z6PueH55jJzEnExFEOl7xNuYCMTuRVfo6Rgp8I6kZUU5qKhSBO23pZe9bm03qZhp
+IVS0XdDMRFH9WRxdnbf3MBNd/SG5ejkWkG41E2cmizjvCJYnarKqlYz2kF/MWB1llomzw/NBBxbVsTKG
+VpY6OM9vlehTdovGufPVjj1Q5aM8uJJ/YCIo4iVlo/a1kmMI6fs7UwcYZbd52a0s2yOtgWzHk3xd9slw
+UC7hTETHfcD/E2CvesH+ArDbXrAw/mNUnwgw/L0Q5Mo4UG3qCTW4aQaG651TieQ6b1m706Wu2jbnvgWq
+kq6NqM26J4epMOvJcWhz9S4VTYXWRel2cqK2lTslV6Ij7FwPbjft+lUL3Jq/1T4Z0NWnKHcHf9RW5jtw
+UVCFfkFPDX+AG3ewtMTkTfoW7T9GR+hxv/xBeZu+RfvfoCN02F2smv2irBXyYKAj5Cs/vhbipXChQNm7
+UL5ok0EmE7iILiJSNvjuO3oVtM2It2Tai2CTSSh542EvQk0m4bYXcJljaRIWRs1HvT1hJ5Mwl2UetUll
+cKmIZOrukJ2rfFq28WGH7trNgpR2MU9XprSLndQ+9IfoqhvsUS+w7aHbqmiCPeqKAIE7Lq4EScWJyjvf
+LqFBVtMSvF1uUYAv0wjdr5TqTH8EN+6gfXUfb47kuE+ZoCzyIp7N8isOd997CiujYp8uge7aFmP3+KAj
+mO85TXQ6/sA/U2crw814WPeGPe8KK6zBV3TD8lurfrjlO1L+u/rwu455PImTWGzlCRiekvZT9M5xK67K
+FnEUkdRVV7fa+vpf4ZhtWre+4Zi/p4jJO4hn3C2k8MqICrxqiwqEyC6tH2jpcmF0zfe6wx2DV3cLDYzI
+jFtzLf1Dw/y6rcXq9K8O1Op35Y73iubp7OBcyu8w4m3YnvVCpy8og6yWTLyQ7DZcpbEUtNyV/M7jFgut
+hIrQulIeod5AxZHBQzhNwJO5bRnv4qeFmuEcQZk70Xg7+D8cgLmDDz3q9KNvEUQbGc6CLgkuywPMy7Rm
+x908ClQ5HXBbBdemKSg120He5Z1mgTuSFYSouKvzS3xVhtcDmhdtmm3Zl1YzVYGi3pyO5KVQNsxWfBHI
+StAIWgaaMskQDu4onyTS3uThNmiPdDzLR+O8RSECmK6C9jhFU+PnxASnNxVIjMbozE1elXG8hw0iT01e
+H04zN/hUbxfyP93GYWvV/WKb88zkd1L1eYtIIffNtkml91VNWOc6vO4R3a1xtAzfdgljd9cjdFiQqa1q
+cxXf2QgVS6C7BfrO4gBasg+0GKARenzQMnpwB3HL6GmcOwmBMAj7YyjrSGUhW7jXBiEbV7gptTUQAGWF
+T9uFlKJhTqVK+8kbLj5WleEr9KRPZfjqJpVdu2dYyZtkT4ZQRcvSLMW6N5BBUU9KOS3aGq+rkSyzCIVb
+xjvLGa7a8VWv2ot5bzQCX90828S2lXeUglERliXFXhV91dZctY8d6Ji04GAw2PW41O96AdTrigHUOw1E
+Uev2Lmvd9ks+0ZbIGdnyT0Bu6e7cCYXZQzloyFNBsP/ng0G/rAv7ncELpsuSLBCUVoLOhApeBCqjQ7Js
+aw1o9S7k+e6DOz9K9aamd2kM+ZAH4a80TgPvGHl3emjSHoivInUDm47g1qcl9CpC+0/V9y4ML9NInl5L
+NArr/lOkv3TJyG/BN+eg522rB/qq1ZoraHFJFVyw2mWQn6Eg7jz/Gu45r6KzRoVn8Xn4CjLzHxz3waGp
+ofhvE9vBeaghjvskqRdxuiK3STZfEJVp8sOPJ+N8RPbQIbzqpmZOUUBklu9TsBeBGd0c98WUk5nRjZ3Q
+8Q6ERiqPBfRn3OaxUVXmO6Nx+w+PQdRKj57Ye1Qqkf5P0PxOSHh9Q/8aXLFt4DSteLKVX7ucYA2Ptoq9
+xeXY9ipqP/6bzK7LwQ2Z+Yz4en6EvX7AXVf2GOa+dczjJJ6ouMX+l3eUu1VdQ9eHFytiFfag23DAHJc2
+97QpQfp4O7szPKm1FsHC+gIFuXjxBQoPHg+U2blnHUXmpwqKPiULoaucRV6vglwwekmcfctD4gLZvd79
+UEj3ddirN0Rhv17M4iS546ZIlEZDDsLDXXoAxgpviPoVuvI6Em9ZjQJwpaMyDZjKrj4V5s3bwbu+uIX/
+FBweerWn21uOpFEFn94NbohtipNpbhrUlJMVGBddqR50+IKNRugNWROGGEkjwtCEXhGONrFYoIRwjsQC
+p+gblMVXJOEIM4LEgmzhRxTPZvF0lQgkKIIYhk6eVzb6CfpmB173zR3wuKLumzM5mgYeKN5h66nMKZym
+fZj+/Vty/dvQQWku0j6V1XdsJQN0CyOVgLlgcJvW9r08q8+YNQNsfu9jVfeR8P+wpBFOThZ0Q5g/CAWL
+53PC8vwBN4z5qUhT4LPf4ZrvVuB9WBF95TOkuKhen9XhrnVHYQ875iBCffMQyX+6e71kzooomblzv1jE
+SbWj9vej7Yu3iN7oGIqiWNU19VZevT3PM51Jmv7vDcMua6zo+a1CazSWLifGyNl1yI2l7Dq9bgs1vbXT
+VZLcWhdLMCfKARQzf3ALZ3HtURQoG18hNJW2vMGg1X3cvG0ONNw6706HGhmgeiUS7nlxbK9UNqhymRtk
+eOi7G6r8Bc0cDpOEujeeXnenwn1Ft2yFKxsB2uUCV/Nau51GG4XK8dXvdvIvWIr/h9ls5veLCtgvcyL5
+4eGjx33qWeCM7CthPk7nkFaMxTx7Gc3dUXs97dj9fEm6UiMXx6E2p+YSwOqHXH5+YTWzFpNKp9FrvYPQ
+oY/TIWaQ+SLkdMWm5KX83eLVHfJFPBP/QbZ369pU9haNVY/0vHOxeYO0shNYkNlSaOdw942KpfN4o8xh
+e5kXypI+W4oXKwbyZH6KL8uH8rhYe31wPhgMbjPXqro0Ix8zevgQ3cQZXiEqU0T3db43yikC9nCi73PC
+ud7F+a6WBrKPH94OxrIbztu7Wlz3fzery3I4TskGlWfEvgVLjVKpFipXhlYLzVRaVhEvicrQ2hd5aZNs
+rsWboC4mKhqN0Oki5iihc45wfl003DmKCGOUDdFkJRBOOEUbyi45CkNEoyi893mOunan5+VsKUfU/+WX
+X34ZvX49evFi/4cfjpbLI879lh0j53xRR5RBrsarEVPW2n3HbMvtAM9pKhhNEsJ4OC1+B/5LSdjngiXq
+ogAYE7m7P1gIkcGPhE6VTUY+MLoS1RNMfm0jFBiiAnyIFLDZ3wflfehxOm9cvA4owjkRgT/CWTyCQa87
+WoR8NZ0Szms+o3Wy6qoUCjRGZzXh40KVkvR9WQ1uJ4wNIR7eNlKEsVDH1sItK1aAk9XSbrqGjwu6SZ13
+zl/I6kMYFG5rnDyhOK+l1FXvjZWPzXO6cnE++P59zDgkJyjWMsy56rc2F9IfsbN8/qln3EpltJQ7LWH1
+kFWzYE0IVyV3mBJojDygMpoRMV3I2aiujvDQHvxyVzWLU5wk22rgUHNqqxRklUZrGGA4ldAumHKwadXb
+rHcyQbOfGM3wvLERXDfQCypw8mOcEt6aWkzzmyrltaNHC3Z1ViFRdwV5EoyD+sKrVOlYgbY5DrIwY+F0
+QaaXbqFC7O11MsrazNK0ENaOy37MiXiuau3s8iXZ2vnNZ+62rLdYOa5w/V5kkJiclIAYkwoRJHhjqQEn
+zyivsfIhIG8eN3sydUANu0rIiFxqQYNFNFH3YQ1u9vBc9leuaMWTbQyiwZssK55g9ixJWiePit4583CS
+eOfd6E70Quw7IcspXCeaqhgGpq1WtkrIj3Fa5VyS2Q+RZebKmldM9tgbTWk6i+ff4oQwMYbbjfUMPW4U
+mTG6rIiX3VuSrGVvjLyHeVmoIn/IBShPymv7r1/vv3jhtSGQFdgRLBZHy2W9rEqA72ixYxMs6lMFoTb1
+c5fGClo0tVbY2lC9tlcsObYKiaPRCD1hZEYYSacErC1j72AfZMdQcA+Nnt6TnT3F8xMi2froiy/Q/wPN
+M/pihCxhtMUbVaR4f21eaq6+Hd+7hrA1XcHPu6L/2Yn8ZxP1WyzIX7Pc+6h/DUY5e0UGgFmfumdrp6pU
+kaASYCAWMQ/hFDRGM/TwIZqpp0+fkIdXghruNQoUzy8NUPkkQetgs7x1GlA/20DnjK6y77YlbP7i0ycz
+1WqFJqonTXK8xtkNKPIaZ3bSF5/Nmv62Imy7Uy1QIlAkOFllGWViiD40RgHP54zMla87+iBp8cF89+kT
+8vlq6dfItySCxdOyhH6W0HVQpjiJBoSnKo0rkOVkNgrkLz99Av1BZW6aMgUUuf8hjAiL11jE68bBYjRC
+Ezy9RFO6zFaCoBISuCP6cK+hTSmaZtth4aOBZIz8OV7Nie+6Yx6ZUST1XodTeb4hrGdNGrq7rl7YZDuc
+qMpfFoRNZHrsjHdyZsiVbdRwXR39yBj0iFunUsSFOpsVYPBsAxV4buCDJz17cv5cZQcTteqMMuUrXdBY
+l5Wy6V+ahY137tKw0y4wXBawiI25PBqh5zTbImg2eBiBZpcjQRHwKTTZopnGzymiSQRXpHFQIlWWRGX9
+1+fVhcqCVxDMVIKsh+jSJbuv0Xg8Rp7Xrvjpq36aabVg8L3rNqVZydnXtq8lM7erIGb5BmKxqssBKMf6
+7PJcbgnHrYeK0Qj9SHFUjABwDoY3YDTeIpxGSB2+FmSJ4lQO2gTelrMirCMENeESXxKuRxKQUrEgDGV4
+TtTQoiAOSSgRI3KVqS+DBsu6CBeYBx+GyNct9K3BVnr0P2jiVka/eWFmvRIFkZO+TdFco7AuKEcEaK03
+1btTU0N9Jan7Vlgf5WsXo+JEXzfKzWNH/umF/W3BCfOvCils0mHGqKBSADJwOw9BhqRTP5U7GYq50IuR
+aI73UHKcxqo3RlsvQykwaaJuM8e+iEXeSHWEt16VZtB4YG9tOY6/y+bq84ZlGF+4R7DY+vX+9fBhvr0N
+rDsr3aQcLzPIHG+W20P+vi8PS+rd8S67tYnTb2zKLd0yt3l798pkBi755rUZw2kKid1SYU3+M9da7tVW
+E0caSgnMCfIkVu/ILhfpxtj3EotzlsKo+3YjpC5C7VzgNdDdGiPbKMIIJwKubrbHdjt7ChJuRz8d11XU
+cFZmmz7+wKQzjz5w6fNb8mFF+G6ndLNgk4VybbX2Dxf7eE7rAmTppplzWNVYE6mxNDK2StuXxIVE2+DM
+tmhFs/62QMXcHpjrXc1yZ/F5H2kOHFJl1Re1wu6LK2V36QytW2+s9LhgcTr3jlpD9u+vOxORkIQIgj7E
+Z5fnN0uV53SlVO2cUJoQnP7+G0onv5Kp6GjnXwEovCRbHqwHfVM3fcb2u7XztqVvri2TA8zV+xkjfKHe
+/EwYVw4F/dmBLmNXuuiPea2tBmZo5u4GZv+B3LWVL588Cy9OCFvH091sz0OUYxkiicNiiy61N8C9PL6C
+UPhlnMIffAWBXeu5/BORtfzzW7wsoJY5YLyUsOcNrXnE6zVI8LuuxZBwlUpwiLw4iQVhOLmgDB43cRJN
+MYvkQ/VTSsVF3HxVfcPInFxl8leB6LyqUNJtWavJEb7Gv1KGno7RIymz1T/Gqf7osNJWjuKNnf26YZzA
+glzQQvApqaC232EpcQy1ONMk4hSnz1aCqmj7ht2zkZkzmBNxUkuPNpDsw5Nt9QYW02kdvtV2Y5C0R6xI
+E3nQaEfbKbHKe2zYQEjxBk7LICeYTRdoXC7DUL0yxU4J+Csaa+DwV27eNAUud+rD5Ouv6r2UxbCgExOk
+ZUb0dKSCMP1CXPoVfYv+/eSvb8IMM06CXwfoCMpWeW2tpjiNVGo1WeZVKoKSAAvMF8EAcgMeNA31eL6u
+H0rzAadMkOhCntgcEKA9uchqH+tCju5ZLqqYvPODJctaFfdZfK5Jp7TjtpU5RB8qXhnNnmjBMW8Jrzo8
+5kZXcG7MYYjp3pgP50pQUHrq0dfP98fIh4npN+2VagssixQvxsiXS6NZpEjxVxYyXjWLGRMWMkdZqaky
+KO2VcLZJa2LCVy5McF7ZK+FsmCCtlXaXvViCsaWKzOPekfxfNW+Zt5Rvl/W3C/l2UX8bybdR/e1Gvt3U
+36by7ev62618u/WGLmYS87ckkQLLfwfvo71B8H4zkOeOByPjMFCY50hySp9NeLB0+Lhon7rcpY6vJoLh
+qQhgwX6fUCyC5dnh+WBYIdzZ8uzR+XnhgWflNUUbnk34KX1LkoA3jShvqEBSwp8KYBFxOkd0BmpJUEwo
+/CH6njJErkDNMES/rrhA3qODw688tImTBE0IWuMkjqwuNobhmQ/zJx35pF0wwxmjyze0kd3V4vrS7N3J
+Bmdwiw23bVL3rRuSnfZNYjY3H1CJoLGaAyG5ItNG1m5Z7bKlVmNKtNWkoY3Ba9lQ+GqyjMUzc1txb96N
+TahyeZ/cyVZiEf6FCPmY4mUjtKrhQWMkxxg20d/KpWY0ishkNZ/bFL2GFGJ05lUEgo5nSHbGV7ixT7Jm
+t9GDEwFQgaN0L3fcHjs9dPm409fHkqEiNzNwQpZgS1riS5JvsEjvJUO0WRBGEEbZii9QRAlPfdHdULmR
+NV/Kc9MUiyZNbuDlBM893Jzg7y6+TOrs614GhWMhTGAF3ZjCIx/tIdu0urWvr2U0rdS/CDexWNCVhdp8
+iC7CWZxGf5dja/3+Eb2KjqwdQNeDHdxUraPUPkKQ0rIxKiegb5I8mvfhS7lUVjB28/3ASj4lotULkDRq
+nS3PougUT/o0KZeiqzJowyO1KaYqE0S7mDoYtPu0ile69rKZcUc7Y+dBCCfZAk/AScrDk2lEZvNF/Otl
+skxp9oFxsVpvrra/eSHPklgEnnmkanJbVwhN3VfeWFvK/D0CPurfztdSNYVKTFyFb+F2a293s/LrM++k
+ZVOFTKme76J1P1Au7qRlC8pFd6MaQsZfiDjF8//4bvs69xkyZqSceY5ZCWfJM4DID23K+60hWOV462c9
+KKpdk5rC1n31oXUoQHtypgDPncaYbuVDfZTkKRg2DJJOaUTevX31nC4zmkq5UjfrViMGan0XRexBotph
+xuYhk//L3bwuNOzHa7twdd9wnnFJSx8qLjcOBxm3nKSrKa3D7noq7jk7V2Szz0TlzYytCSTh3KoXjNWk
+UuOPrb46bUQ+i877JGoqnGhKKrQnojA8J2Q3egF3+eI4JsFZ1McZ5LqLJIbbx++BJrUG3biH7gTLyret
+1f0Gz3XdCvYsOt81i/N9Xa5fNb6/U7oOk6koBO6Jo/aSn3kQDZHaO/qs4boK8UPT2Q0nEMfR5vC0xkln
+/y/JVnZgjZPeQcoDG5/VDFb+sR7jXsuDG18xguSmjGKOcLLBWw4qmFnMuJBlQ9fGZupiyx3WNB7CpDre
+oTy8M3YmPESTNmpiUD4uQDbpDFJG+zulni/8ayc7VXK4250vKotgmNApTojcvjEjwaRHdOBdnnX9dyBL
+y8M8RP1pYYiru1E7TsI2yWRJBJb71Ugj+lb9HX9mYaU0VEko9PCh2mTfWnPAOsUz/eZG5O6nWOilVjC2
+9OqZz2qJrxwr7TpZyyCxeCqHxPuWx+lUxcy4dMKPhsiL8JZ7eebE22gk9LRoHAPuQi2w02Q2aV96/OT7
+w2W+P3ScMeUhwyWIX0JaI1OvYz1Z3GruwywH1unmtuZBSPkn205gvxOeYlG1z4mwOWEpLpqbKd0mSQOu
+UPKYuh07pNLtlCqd41bPqer4mpJB5vKBv5+F7gPk7k7wH3rYJbNB69FNiQ0t4kTb2Q66tMs5pOF81jN+
+oBpH8PBhO1TZfjXz1ztLzr0kxJpRWenfPgyO+8QWs/qEbWhALsk2UhkSDD8fa2w87Bw6QF6ncAF7hHp1
+SbbP4WrmMTr8smUhqznk9l1uaAvz4LL2qFumQm6LtXzTJfXBwZtNQ1nlVOCYrcvbrzzAw0q3R+Cxt0fb
+feC4JFvwXm8TkZdnAs/P7/jOReXmV++yWmGyun7JLXZVWmiP/ka1tsiTLqaxPFPhPJ3H+67cstf3Opwh
+G801g0pUG453xjHvj8JFroqtx01T0+LjhvoIQRRHeYzU9WCHO/w6ci8op+iGGbjuS+VPvv7KH6KJoDgA
+TyXlOxzPtgFrZD1rllZuM4YFWbnRfItWaURmcUoidJR71HQi00bQElvuYfOt9pRBRyXeTmyFp02Jr3S+
+6YERuGGcGjdBF/dolZ44A/St4ZcTCnoC5AvA0WuVJBaU4HTjRImvTJT4qgtls9/LOPWHsuWdFFriKwmJ
+64ocZ0oMY/MqhUf7rqSYe21nVwcxu1eNy/eocNSqzzD/ofoJp+MHgf8HSH7pD/LLp+XE84+tErEyhrwm
+Agd2MfJ3cDAvj3zYOGTf8eFD50QLEzoPdIKSOREiTuco7zIo4bWVBw66qm+7HkKClL5dpWmcNnbd3KU6
+nOJ0SpLA9De3uOncdyIyffUUhDxPaWC/y0NITiYdPlPXoRpDYTG82IwU9onvtFfA7UHVImfxeSWqe6wj
+uJ0ngrz5e2O9MuQTTNC4j9RUXRWWxtjH3RbR3O4k+uGVS/ysV6ogqxvvEmc3iZk1I53zlBiueOcijNGM
+YHQGL1ZLqKwaY51Ho0/2/rwW5Qfw11ngfeEN0FO03+tKrlmRx6MI0h4j7wsPfVt+Kv3r0ZHptn+bvP+O
+xAbO5pkBAsd3ehNUq4J41kcffMNd33+4jFPXBmAVCeo70k4Sgf9wia+6qquIC7bqCl+QeIlFUzg0To06
+D18dgcmpSBpByg/Dr9L4NHCdMGsOpkYRh6upUzdXaejDKOd3IvcK9cu0R34fLMA65W5UQwP5OP3BLj4G
+sIl9K9FIAds1blapW9FjAHndtbCzZ7B3mJ17ILXdgXjBCF8lOvsyDk+A8fZx2uzKxOvwkeOUie+26oL1
+Z30uWitSzx73VCbpijeY5QKA365vUxTo6IeB7g1Fb6GILT2rXckB3f27QpHLAm2XJdcq3dOjU2BQt4wi
+bxeClBG1gOtvK8dQ50rbq4ydkivhcGbVO30Vd1c0iKuKvTHyHkjRMnd0O4vP0R7yZN1oD32Qv9+7Ln0r
+7gettiWn8n771Za2puD1PLA1Z+DdxqzcFEz9TjdiGwjkwasEReEJf8cSmwlDwq1CvppwwYKDIVoVQob/
+ra/ujfjWtxWTjDBnW2V0VBc/cvZH5e1b/UOdjnvR3NYGazpWpy1e0CxoV9FpHYZrFpqnHTms+jBUywYr
+NxbBVnCNyxA9hnvldr7RwH5IK9/JSkBTM6gne36WZWEUMzKFHCq+4D/RbJVZb8XQnPujoShQsSpHyH/p
+l/E5QJyjGlFWLDlC/tgAM1JQC7LMEizIEfKfTFZC0BRB5PHYm4gUTUS6r8UGD1jc/kIsk7GKWVQvsgRP
+IXf32JtQIejSe0qWExI9GSl0T416kzi9PDK6pwODSUKWQ4SFaPq8yYWpmzVGDwJf/fZVmdpo6UzlGyym
+iwCwyTViknPFEqfty/Ftd7OXUAzefxKn2UpAYvSxJ196iKbPk3h6OfZ0Dh24UGRw7CFGcETTZDv28l+e
+yo819h4m4hijBSOz8cMPKyqOJfuA/JLIVy8ezsWxhIqXc8TZ1AIWZul8nKXzKvwIy1/eUwuzUmQOM5rR
+NWGBIysCTQVJxRH0eLjTmUBPwOvje9fO1fAsIUz8EHNB2bbfosjn8juY8KMMMxHjhI8gpelCYQrlBPab
+1bsC43UD/lG513cK0dU5Yz/W/DTKM88zxvA2j1i8JNtBV7qNErRi97QtDFn52dru22bniZbjLSApKz1v
+CSGXHc4ww0te8+uClLgtN8n7+NJ1UljDNVZK8vMfmmeR+smDCyxWHI4euhF7yHuIk2R86N3I98TUEVrC
+n9Q8ULmDL2D+2ryT68NXv0BvPUTYmfHuPhAfX96BcXAdwv0dvDYKcntGi7CZfF69c1wbV+9Ujtzs2KJF
+EFay6x46RE/Khtl15Oa/RfgyjXRT82JngOccWruzt16/qgyqDG4jCdcmixzXRkxx/k/ztKOSPIysCeMk
+GFjYeLtmp56ipdaOQcfBLD/RSuALzaIhrLuC5QbZQP8/edfe27au5P/fT8EIF1F848rJAS6w6zQtcpq+
+cHt6gzYF9lzXCBSJsbW2JcGUnWZv8t0XHJIS35IfKXqx54+eWCJHfAyHw+HMb3Sl/3OB/oh5DoELtp7e
+Fas8dcODtnt/tYeEmb5d7bk+PhSk+uW2Gylepom6p79MvATG17xIpayc2vvrVioR3wKaMZWwJDAxUwhc
+l1jRFRrHPR0xMFktlzivvn35pPRrpZ7oBJm5jpe+cPmK6C5dRxaxrbo9yaq/7h9Ol7/+RsShcB/foTLy
+T/pttNe5RzjztATV6ZF/htXGvOKs4tuwjxwhiGx27Vka7F6fA9o52SmR/t6fRydlL5lBnjQ1SnjgeQEI
+6txmEpv3bP6aaum1jZPKuKrwEpCeGG5C+vjwmD9OHxePBAAUBmfWUHtejxmF1/bZFrZe0YAa7oRjJyxG
+v42jJYZT21EIMuSPsNfVRZdd105w9bqKJ7NzOk+HVTxZt5jQYT53nNCYy4EbfidjKDtZK74BTGzGdA/0
+CllcLZ96bjapr8zrK/LTk5NQkzvl6qZNTkAZ3e1TlZOsiATXpzRSEy59S7fZAhiisCBRUq5Cc1tvbkGH
+Deq2WYyKoyHV4gpSabKo7zL6jN3rXTLg/w8pnBc9mvEehkOy3G/FSaDySvZ4N7f4tA2JwOhkLJJMhVd4
+meC8Qt8ITu2XQ0m5ct0H6Iy2wItWJoIyfiZiRZQdooVzBNcEBYkWeMFyFGmYMJ0YQvG42r0dK4LTnZux
+H1aEzvx6rHhas2JAWTBweFosbmBK0TnVhQGbKGJ5/fLVfN7XmfuS/lRPdMo10uh0fGY61zUfo4JLbaRn
+E85xdXP7UGHSyvpSSf8CkAvuQZZSbsxxFQHJwBSVS7CiVssVtr/jGLWUa7m85aVRg1o7RKe6YPVyex9l
+d3GChyj4a9BH3HxW5PD7Z0poaah/jcUBhthFaUnxBT5FdNC+Co7oYlXhjZH1DcKfZOkPO9QfyLtLprVI
+v/QlVUprapSWo5NxH6Xl6HSM/or+c3zmdFbmJK/jCYnqmQdPlWJVeSB99tCsF6fjrjfHMJ3SeEfTmPzj
+Pr9aFiVeVg9KL6BYz23XqYmMjFpjOtPs6bhLszwWGv932AbGnjsMNtWCo534KW3sfCygTbgiXC1Ktzi9
+a5ejd60C9G6PkjPNyCy6IxEp4wTf2DSLFkFHCTjkWn9/DbOoGtu36/nk7d2/iaC9I88sZfn5vVJl2UiW
+a43Pwtia3B5O5MuidDhlSKJWoL2cOznaJbsEfVZqRwEliK1MTc8jg8VU+AQw7ZJT/goCI72GX/b6q45o
+ZygBOok7jov7Kx2E9tZNVCS+m8q20r62f1rFfbs1+WOeZCnOq22wvQkAeYfzLJ9tietNEtxHUv2trzqz
+tLEYZ6kWEJGlbVhzixWpEFnRsw7K+IhQmjHhCY7gUg9XWHekdUdR6IKcpc/cKQ02I3HDHQj0YHQTbDcx
+kP1s/tT8phidewx0lLVxBPcif8cPdstiOGAffA0eDeDL+VBCxpbDGX6ABzP84AclJMkyu8Vv06xSMyvb
+raTs0lPfVyDTLkevfHxEOLrGsTVuEgiwdRnGhGSTHKeoKiDku7YijjipPiekpJgoJB344KCQLovRAIU9
+r+8qa+an+BbPSVvr5lCKxaLzKuI7LV/hLoM4epfhedopWln68IzOHszcultgMKvLd9TX/CdraR+F4Bu9
+ysVYhz5emOCKssHXbI7zxMxY68AIlkRJ9N6gUHMze1QHqPHfH/0giPMihkvZeZbjqzjHah7gteXGGeK9
+o7TI8SeeUPzwEB2sI6eDXQd1qpZZljg35VPWfDS63+Y8JtXnIv+Wz/LiPr+4ZaF6H9MfEiTsbZFaQYLW
+EVnBxW5zPBCiM/rK3liUKSDX1KC/bKXUnmyLQQdm+ZmlfUKSnTmyHS9Xc/xtOT+KZ8I7/mjN7gXcAL/g
+S2ZK6t1wYqQxZgpwl4HFUbVckeqCfKgWc6Y4/04ncY+uoGs/9Oy2Dp7tE++HtSVTBjpgSf2QFPN5XBI1
+YVHWN5P6yKQYeP6B9ujMleHBFBHNMhKVPV4ORnUmVbqDGvC7dY9nlrgqFEzds3mWiEsxG2i6+6QqFhcZ
+pHierUHBfZ2lLBhuJ0jghp5f7XE2CLAk9tSYWj20hYXK6pIpduxRQYkI1GDxGYmW5FcquukKF1ou9F58
+QPfoOXNrmnzXZT+oOHSJ0TqECMCW9FKfYlKJrcUqQ3l5cpHwJHvQ0I/8t32E2ZZdi0X201f0Y6oV/pja
+R8vQOvanVLgCV5WJagtbpXUl5sLcE21sN03g6Cu4JkKODTYHIZVKyvMV2//NF3lBRyzsoA3IYsypVJzb
+omItMprKZdw7Q4MBevujhOSyU4xKkKg86QL34EG0PVaS3bJz/YenFWc/xderS8p+/wm+wgviOb47DuRK
+Fi072NqvBprGnGx3QU2zYqFNC1JxTDkey7BTz+2Y0c/Tb/iWt9edeYyKlU+NpNsozZxUT0s1J72RMke+
+9x/ualHayFAtleyBKEMlU1PKcSqUzbaqw/hdsZwAxDunEb1jD16j8JC9C7V4XrBK0VnRApvADMyJwLb4
+BU/MgHtW8/gcfMNFIUAytNheLOScSYd40c9Fld1liT3BmPTxXC7X9n2FqDjNO3zCWCIuJpwT3xh9qQuZ
+kyZVDw6bn23tlEkeG4I/gBjjfxZ5K51rXs5Ohe3OQKOu8YkZPAboFP9XDx2jgFg5TrDjgNd7DbdrQIrl
+o4fkPJwmxODqTQgOcZ46KrzNU7M4cBlUULjJKFfFk1Y+uI4nLMpd/wxfRcc2bnNNrdGvNFPaKbQzfuCw
+yxH/eaf1rOM456gJOqUvK5kyL8oyIlzmBZKKGPTlKjyb53U82UigXscTe87O63giZwm9vNqI7OWVnerl
+VackoFcr/zVBu6+54WDe4H2CKZfDNWre2mnJr6ppO2v3pjKamU655olVnCFL+EBajs/+3dSenbFiRXYZ
+SH3Vinlo8adHKupm21WtNKldDM7MTDpDj49oHTmzDgNc3zpikJjRetPrMkcb03KjJh4e+poIQ0TkHJKS
+X/E6mvVRRdLby7jCdTo3jnXx36ELgw5OrGCMd8cLCXcFhQf7btj2bIFJFS/KIaqIu9iaOZxJ6fVo1/te
+NPgh/NvfE5qeGUnOsrcBUhYMTBTZvWn5mnWjSrmC/Y2EbSXkLoYV38WQ2w0dyw1CIDUcXLDYmq0Mr+F9
+xPFvhIoA/2e/ogUmJJ5sZJgFqO9Jq+yZx0T2NqJLXvpbdtUwbbC0Llui8Nfa03MgVnsaX8eTDWysF2kK
+u+4mHUnLuh9puYduODdF6+YI4oeRNSVQnKZHp3/ro5DgpMhTErqyO6p7KRu9tNxg4HxJkTpgls/kAKA9
+wJDDMVIPhYbCu8e7tmo1PmPgJql2qgbi2uF5AnzgzcZjrImqg6D+VaDX92lI4+aILTxhtveA2dX3RcsE
+yD1gfOmZeRFbdmYRFNaUEk9M3xI4RTZ5nOlPeyEwXGgl4Zklb7NkZ5BzN0uPrRGnRA05JWeO40UdUTox
+i2DmcyJGJ83M7jTGIlaI/bZEncLu2JQztssao0YydNTZrcUz6+XeP4tcC56lT0xXBZ56QxTU0y/KSFwC
+T07jAddVXMMi4ek09OKPKnF4wJK/47tiifmPi7sK3GrytPlLFJhni6yyBtnCBOgHEAitK1ZwKXdyZnOA
+sSAf5PECuzYG+D4dFkb11bm9RZvvDOwg4TZ+bQcpQyQrBzccuRADxO1RXfxtnjoLg8GumTgwtorASPZo
+PyPS8AP7xEuJQ/bzBcFlcMOFXtZctzfqzQhh9Kph5d3pL3E1oqxqSQXhzM5QteICtylc3Ao3mOD9JNLk
+9Gz+enWEV3HfinehUfMdjOmYDVHI7pBD+5FU0Bmqsiov7vuIhbxJf570NoGc26CJ38qkWGT5ZMNG6u17
+5lZexaTaqoXyv7S1v/2tWxObqwR2SLWl3txcum8ihBuTdZZPXIvQvXL3M+5fRANaB79u6k9Nx7ZFwjUh
+XFaC8fchYQSxZ88V1TkbFMhZOxT+ZVzFVrsB11RlEyp6fERh2OOJpsN+2HP6n4H2a+tCc7gD2JBzDUuD
+cOKDEeo/jo8Hwov5scW7WMrZKPyl7Rhba3QA0TNW4GducLaESFIlYKicccw1gPMmhzvOUwuSAVdZh7oO
+axaFw8lQOb44CsEJZmgedMziyvFlaD3ruOLJYFzr+1ZL19OsaS390bcZGCa4KXRXX2+LPBwgmC0BcHBk
+GWpnGkvgci1Eh+axxtIvfmIZ6ocasyg7swzVI40WQWdVf7SLFxWjI3/QTsl0dTVHYulXfdJpHrFDr/ab
+nW+bh+xA2vwWS1g5NEoPbCc+EbWGWaIPJi1Mj9ZpnE9wl6z8aUbi2zl+U+R32XJhgujp+IAPLTdRloQo
+ut1cyHiCq7DPOrO7uc71UlyUMGCko7zIcS8cMgd49LSJxQ4T4ZVCfp2NRGqcf7YNICZg8SYTT83xdAJb
+E9XgPG2q8sXRqaJYOk1teTF1IgFLS0pwJFZe98qwLjUK9VrtREaR0XI6I9lM1ZUYiIWGSC0lOlWmMqSp
+KyRKp6rci6mv7QBdq3PB39QX1q2uBBrxJieYqg1fnUeA7xPSKAhzWFcSbAdpCHAjmaN6h2RMsq80SNVW
+OeyRn1b54yzHrZVdElPRomHf1kcW1Nc0n+kEe5HrexWJ7mCSCa78UzPH8VIN+EitYe73WZ4W92IsjsI3
+ULGaZkScrwC6fZuIre23Tmh9E7TQR/96+nWH+ueGnnSKEfS7kXaPGrTQsd9AOXCqk9n7ZQHA7SPtAonD
+zqt3RtIlEX/fU4DdNVBr5IJ1h7NKMtOg3evDKW0SoS/lFppHsGSK09UcO6jQBsZ5mjKAeL2EBnjhhN9O
+ZtAaGXq7PgVsjAhfjwckS09mXzlULNX7ecDNDBJ7fcY4JegimeXF/RynEwCptzhCsFoQfPAGnOwEob+U
+8RLnlfTKVZk5cEPgrlFbeueqflsVsVGPPnRVYE6cRhX22NlIS+ycNqDWEDonkpARRueLolOuA9Sah4ec
+FuPZUTaO3kyzebrEuZRk3p9xxJrgwZsSiw/d7bKI0yQm1VFQ5P8ocR6YIXkqz6OT7kDNzpGeZ8nMkjzZ
+iXNiTtIRLekeY0vKZT7sfMVHyRQns4/pD3RwLmXr9Iwa3MLRStJi41PmID2OePkzL1Fxu81A6bKcdq3v
+am7PT4tdggt4u20pKX4b7LYdZejlOSXvddowArE98yn/lxR5leUrvEv6OH0RNXPVOgubZIFzsRH09cxT
+ZVWmlvRo3nXCcMCVhcL74kSpsXjcyAPTxfNmh8F82m//WRXv0cPYE9/MC4KlXdEeXK9UYVExG9SJ8wep
+tAWIYN/TQUlMdKmTeRJNg9o/iVq4pfvye2rhFHVE3OMn2jYRYZhUVQFdPey2Y1lm2DP+/qYrDdEDRSeW
+QNHOTTQ4aqs2brCjLlbzKuuIiCMzFc+hMnKwEhiVRdiypw9GIhWJT2XtH560ZTw9YOpyR97dPic8TD98
+qkb+6bCtsRpfwbjwhkXpmiHIXfa8elz9q6V946NzyG6/tN5sMyj6TDKSQiHtKztRNk+7jBgU/EVHjLWt
+y4htkERf0q/FKQhT8kcBW6Ev6PeDPjTDUb0xdJVxNRUVg9bS3CwW0HUf9AGiqnMdNrxBX4yzdI1mT7bv
+lUhNNpP2zbtBpgkHvJ4th+D/I0kDqcvOJWwxS+Dir7fau7T62VYcNx2tlvNWLpVB0lsNXCC4hMe0G3rA
+BjxgtWZ507Qxo/XeLEVglHxgxxMoNAoq8n5ZBmPn0VwR0GotZstx180ZBru4vpXn2Wc60uvAgLsqbKfj
+2NH/mlb3Oqg/MlSgcxvzsmY3CEH4zb4Kj2ILTIxD8kJoThGnOP2dYek4VDZu0y4mk/kmpysyBR9GydDV
+xcgF7tdNq9pPnWyIeQ+COUPLiqLIk+Rd6bRfO3CktuNZ8my82/PKvA0u+fWp4oBHEL5i+/AYBuGsw5FD
+GTIDQOnWxE5quRdpu4LpdhBSJ/It3Mzw6aylIAzCEHIyKyAynXeD7hrJX4r8KGRWTkV449ZseufnTIq2
+8y5bUzvmsgNK/3tP/BdOiiKz9jt5MvETbmK0Ea5tdbakwdGo/6+no964N5jQTfD0++q3k5PbjZRCxhHX
+xSqZwrGYX5ZZXjqd9u0KIKtrZkp0RTzDElRTMwoIRNeH3VPFPs5jIs32jMxH7hhJTX4yYFqOodYYseXH
+HXK/y7UArkxsohZyI88n7M198g7LF4Y1Cbo9g1p7HVqQR+h/zLPFpTgaUL09F5nDu2WxuIwr3IUURI7S
+iRd3ssGff/7554s//nhxeRn0/N+g1bb7xocPw8Ui6Dn8wtlxxmGX8S0AuOfsyv/cBFlD4QYAULLuyPr1
+mWZTBhQMAQcFsQ3cACnvSceOzdONCV07o16mt8M54XOhoYNsmr3cdTDIC/VI4E8bncyepRFxMuvcBjDQ
+PksrEkq5ezviPMHzZ2xNQ79zm94Jh7X9N4f5vnVuydVqOXmecSkp5U1GJMHPN0l3NXlLi7oix10XM5x/
+ykjVBFdvBnhk1j/iUdPxSk3MTD93A65Z5+Ch1UgkwMSCWlSU0f9r7ygpemRfycGw8CbFc9xykXbDkvKL
+c39wSatYD143TUM44aOAwRjQXpLX05hMXWBbN1JzrmPKrz2LV1Y1xXmLL4PeXMvhkBXRPRy5F0PrcUb7
+gBTHzwcTeluH8XdCC6kbtNFMfHKdgOWJoAdaeRaCnj6KS0ysBtEbeBPBobVWKiTrSuFUIKpiBurkNyIp
+86A+y29caaKKWXSFl4uMEB4Qf1MzsfziXbEEcl+KOfaQoq95DkCJDn2qEECPj+goAIQ59ftCiTlGAZIe
+Bx21IfZNNvToHNUjerYp4+7GmgxigjVjC9Ys8XJBpZRqXjMZYDD4cPHm70Mhm6lcRSw7E9x4l0VZrPEy
+EqrqC1It4xJNY4Ju4xTFZQbF6CcNF0oqwIKXabZGIFjPvwec2vcAVfFtlqf4x/n34MXp9+DV91zUVCrE
+y2Vx/z149XKQZmtXIU71RVLkFc4rWnw1fxWY4Vt0TLbiTtvdPxDrgoBMRTwUtt7y0xJFiXMYK1Iti3zy
+KrAXA40Jyg3cBacAm/lynr2iKwMoH6MSHfPax7Q2felzBOY0Bqs5H3j2rzWhDJr6xWMNVQj/WjbP6C9Z
+zvMGjGpzfEAnR8AUjhWUQwsJA+0wqTepsBLFQ5E+rQFSGdqI9bVCF2SIgqTiqdQ01YQu3CxpNJT6a0xB
+2Ugx+YzvoTlb6iVm9Z+qlkDPBcYP/VveqKHENCa/Z5VqCbvNzChQPs/wDh3K4pjL/QMFOkNnOoIrWsz4
+TB+BH6GxQ3M9pmwEQY+FajfV6TtnpBfzTuR3LUwParuFpPSiixZfjM0SqSkUocHR72z82BAfGHAjyAtq
+p4/qBFfG5DkmzjqiS5yuEiyNKVkt+kjOzElWC3SMjkrRjdeoZF0YopPeme6t+qThbuI7wvkyes8YgBgM
+WCoqCq0ii3+t8JKSEMWAnizHzMUmYb0mS+xzdWtZfg2n8/di7I3+NIrHG/pFQ6+UVEqI2VB0yr70tU76
+ZYuyw16zrqfXXBjUulNjeQ4Gkz4KZF3MoiZ51CJQuWFyGqWogRA/a58ddpJxq+2cHS0HHqOHrV+1bW2h
+tLWF6tZmUvDsbEHOSwe2jc0ktcG+Fmgb2md8D/tZAPvZ/wUAAP//ShxuvONyAgA=
 `,
 	},
 
@@ -24370,25 +24390,55 @@ tGllGjddBO8ulyRuhKBue0KrbvyE3Puc+6ET1rj8EwAA//+xJoPmMQkAAA==
 
 	"/partials/action.html": {
 		local:   "web/static/partials/action.html",
-		size:    2476,
+		size:    4665,
 		modtime: 0,
 		compressed: `
-H4sIAAAAAAAC/6xWQW/cNhM9+/sV8/GQtYGVt26DAk0kFUFzySG9uO2dIkcisRSpcoZeb399QUparw0n
-jVv7YomcefPmafi4dR/iCMpJokbk58qEaP8KnqUT7f8uam3vHm0PMaQp71zUTnbo1k0VXEVj9QOo4DkG
-V5Vd0X5QbIOvd+W1pJ0hLkk/FryLenpUaQUilmyVAD9UnfW6EXycULT1bipwO23vMtH1//OEc/ayepkI
-I/y/gc3mCn6GzQbewcZIqjDGEDfwguZ+J4xejvht7aUlurJ+StzWuycL/6qbkYY/pLP6P3byGYnk8I2N
-MN6zjCghhgM14uY78dyHKyzHoNE1YpzhZ+JG+gEbcZdpS8bPNFxezZ/Xpfh0va13a7kXCkQmHBohFds7
-/OSV1eiZ4M0byPMDTQMb5QLh5lxNnaLM8/oakmapPqKTR9TwS64EHxf0N76j6X2RMtMnPjpsREjsrMd3
-4IPH9ydJp+RcFe1gWJQK0nqMjeiCPgrIGMufliyryUmFI3rOAcxhFFDW2XIu8YiOeJqc0UvqrfUK4RgS
-yIiQVbJ+AOkw8iVdARvJZWfWdgts1uc5iOBgnYMOYUoM1oNe6hbBoQ+xpNCEyvYWNayyX8NvBk9vQCYk
-pzPOwaAvhPB+QsVzxVwKOEBETtHnJx/iKN01fOrPIoaABJ1U+4eIXCK3xMYSTBht0GcJK/nCVhc0OZdE
-ryH0X0izBMQ51QdfzXW2z6D2ISrU1QwOESk5zlysBwkeD2CXWS2FfXiQIxc4SWYXRI29TK7o8P19KacM
-qv0TSfPRkLzUSGOHEfrgXDighu4IEpK3DJSUAUlwY+CSgFAFr2kLI4zWJ0baggETUqQtHOCAuKcteBiD
-Z0NX1+fz1FE1hSncYWxrezqfEnpZ/ZmQMrFK2agcViEvukGAjFZWxmqNvhEcE4oW6p2dLWc57hdfN6ib
-xaCKn/6TJ60KPWtK61GdHeil/vwFeqHvCbk4xeqncHKBNTR/vS7ci3Z2mXZpJpvW2e5ZHz6w7Y9fsdYW
-bvPg/prjrJLn9/GZrq/cWOmrS8zBL9wpdaPlk6917KFjXy0DvLiwVfs1MjO/LU/1bgZ6faI/LUTN2/ZD
-PqU1TdI/XB57PNK1Qz+wgRZuREv1Lke09c68XS710z3gLHGVfHFzPeNe1M5mtIgTSm7EPp/ADHr2a2af
-B8ytY57cM03Wu9xZ+3cAAAD//822YQGsCQAA
+H4sIAAAAAAAC/+xY0W/bthN+zu+vuJ8e6gSw4qUpBqyVNBQrChRY1wLp9k6JJ4kwRWq8ox3vrx9ISY5i
+pE3ieejL8mKZ5H133yfeRzpZbV0HlRZEeRKe09Y69Zc1LHRS/O8sk2pzb7px1vdh5izTokQ9TVZWp9Sl
+11BZw87qNM4mxduKlTXZKn6NYTPEMejHiHeW9fcyTUDEglWVgGnSUhmZJ7zrMSmyVR/hVlJtQqHT58MF
+h+hx9NwTOvh/DovFBfwMiwW8hkUrKEXnrFvAM8j9TuiM6PBp9Py4OlWm91xkq4OBo9h01PwhtJL/kMlH
+JBLNE4kw3rJwKMDZLeXJ1Q/JQy8uVtlZiTpPugF+KLwVpsE82YSyBeNHas4vhtervTscL7LVlO6ZAlFr
+t3kiKlYb/GAqJdEwwYsXEPYP5DksKm0JF3M1pXci7NdTSBqkeoda7FDCLyETvBvRX5iS+jdRylA+8U5j
+nljPWhl8DcYafLOXtPdap041LScxg1AGXZ6UVu4SCBjjnxQs0l6LCjs0HBYw2y6BOM6KQ4p75SSHwQE9
+ht4oUyHsrAfhEIJKyjQgNDo+pwvgVnCcGbRdArfT87CIYKu0hhKh9wzKgBzzRsGhti6GUI+VqhVKmGS/
+hC8t7r8BtdZrGXC2LZpYEN72WPGQMaQCtuCQvTPhyVjXCX0JH+rZisYiQSmq9d2KkCJQ4lYR9OiUlbOA
+qfhYrYxoYkiJRoKtvxKmCIhDqLEmHfIsH0CtratQpgM4OCSvOdSiDAgwuAU17tWY2Ng7OUKCvWRqRJRY
+C6+jDi9vY7qqxWp9IGloDcFjDt+V6KC2WtstSih3IMAbxUC+akEQXLVwTkBYWSNpCR10ynhGWkILrfWO
+lrCFLeKalmCgs4Zburic76eS0t72doOuyNS+PwXUIv3TI4XC0kq5SmNqw6BuEhBOibRVUqLJE3YekwKy
+lRosZ2z3s28b1NVoUNFPH/OkSaEHTWlq1cGBjvHnwX72XiOq9b/qNG+rtbFbjbJBeG/dd7OYgzq+bjJf
+omnsFwfE0N3KIYGoGd3QZnf7eGzqqT3u+s32aMKUWYauMIiSQjuU9/AliEaE/W9C7xAYy6pWVcSm6GYU
+QIdFtbPdYFEs3CONOJjf+r11gzXM7MATymXMuFXcWs9gDU4B94jL4FHGTgoc07Sv/mva0zctkWrM4hmt
++Glr0H2b7/Vz+NqAl0BsutZqGfoyXBu/G8EvKLoT8mMU3QG9OHQaerHM57D7NXzQ027BT+IXgeiAoSzB
+IG+tW0PqsLMblN+L8HuFWtJR1/7rR2/9dQQ/fL2qWiPnnz7fpFcvr+FcGMCu5x1shPYIgx4UXTLGH/sT
+4CtkbF0TchRiIgf7Y3JaGm5Qpb1NikHEYnzXQebZ7IxpPE123/h5U8BNuDz+Njt1JtFnNnliYpFX6Zmt
+GWsnX3aK9y+uZAMlm3S8RI73E1Wtp5Wh8pv4lK0GoNMX+tNYaPuqeBtOzox6Ye729hp3dKnRNNxCAVdJ
+QdkqrCiyVftq/GG93+ZaEafexOuOHHDPMq0CmsMeBefJOhyoAXT2H4V12GN6OrW8PqoJe2dLjd1U7BH0
+Pw8IBwIc4J5EhD6IMAHPhOgfFyJbBfbF3wEAAP//lu9JPzkSAAA=
+`,
+	},
+
+	"/partials/alerthealth.html": {
+		local:   "web/static/partials/alerthealth.html",
+		size:    3177,
+		modtime: 0,
+		compressed: `
+H4sIAAAAAAAC/5xWTW/jNhM+x79iILzA2kAkv5ujI6tIswV62KRttkXPNDURicikSlJ2gmz+e8EhJctW
+nK59scj5eIacmWfoXFwVcFOjcfArstoJyOfiqphM8lJugNfM2mVi9DYBVaVW6O0yQWO0SYrJxdCE6zqt
+q/TzlVdc5I3BTsMInH7TkqkKDWGtpCo7LLDupcZlshXSYWobxnEBjcF0a1hznRT5vDHoA85LuSkm3efo
+CWvNSqmq/zjjQDM8o1SPmgwuvgaYLMu8eQh6cIZJ3uzCCkpgVqOqnPAQ3xxz0jrJLehHcALBIEflgAvk
+TyRExkUInMFtLfkTMOC6btcKnAarjbsEpkpgKliRFBGks1Az68C0ymaTiz9aNBItMIMU6J+4tz6c0yRb
+Mf6EqrSXYNC2tbMktcHQoGuNwhJWLyTmRjqKvGVGAT43Bq2VWtnLyYUXc+FrGSBUu16h8dfxpwGpYCsk
+Fwc6DyRVBdoQuOSsjnd6whcbActsks+bYpI7tqr7Hgob+k25ViUqi2XyQeZzJ5CVVGZnqJi5E0XOQBh8
+XCbkyn26l4lF95sp0Uw/EQ0+zZKCFvmcFfnciR/1fmiV9c7+e6rvzab60hrmpFYUf1NBtz8V6o49D6Hu
+2PPZUDebKrZVd6i4PQPoIXRcBxS3pwL94scFgYTVqf63oWk9QFyeivCVWXezUtqsWU2XiWuYrtnz7By0
+v9ST0lsqVlyeg3KvvzDHPMi9Br88B+ShpWP4JTy0e+fI58Qjvw3Eyt1Kly8eymCDzC0T4YkfmAjfQXvY
+n18W9F0Y3KCxmERGDk7gdFXVOBWz/hXgrbHaLKDRUjk01/G8NAhewwuyAJGFDnhL4gVLf0FVpeGOc67V
+o6x+ovmyfH0VGXH67W14+f/hBpXLrNPN70Y3rCKWTGdJMXCISSj7MF7nOe5Ve2KLfjTZqcgGdJ59YDag
+6tiMULqp/j2O0cXn9+0inT62iwkbKyIV3tEMu/3tDaZedseed6LZuy6xj48AhlY9UOa2YcrXRj4uk5Dg
+BJxNrVQcvST2p/8z4E37msTG7LoquH8Teqv6zvAPqvdZJvHp99IfeF5iP66ZqaRawP+vo/PgdQk70y2J
+aH/KNfbM64S7GXyg6EfqgbyfkAfye8r8SPx3eFxH8tv40o4U/awZ4cfxMbLHDatb5rAc6aizhtKuKGE5
+SFaYGoPMDQeIaRWNkOyB/iVRDwzidE3ibOrkGsk+88ke9UTvsGObt32PbDtLbxGLcVQfi3JUfx9pcUQd
+i3RU3xXrqMGYWIfxD6h16N7X8KgN1XJfu1/NXQHzOVEm0Gyfjr1db/NvAAAA//+ZgOmqaQwAAA==
 `,
 	},
 
@@ -24409,31 +24459,31 @@ Ofmd3ZTnVh/zsTYSelwjV6WExZ/yyqCqTN6tV7Mc1j/FvwAAAP//AnF2s2MDAAA=
 
 	"/partials/alertstate.html": {
 		local:   "web/static/partials/alertstate.html",
-		size:    4617,
+		size:    4679,
 		modtime: 0,
 		compressed: `
-H4sIAAAAAAAC/7RXb2/bNhN/7X6Ke1ggtvFU9pJlBRZYCrqiw4JuQ7Gme0+JJ4kLRWoklcRz9d0HUpQt
-O86fJe6biOHx7n73u+PxvGD8GjJBjYlJTSWKqETKuCwIyCIaCt679SRrtEZpP1tqGzP9/zhK1e04nOXZ
-VUysKgqBkylJXo0W5em2bcutQCcZLSiUGnO3HC1MTSV4WUwuS24AbzNBK2q5ksAzJUFjrdGgtAZsSS3Y
-EoEK1Ba4AS6BSqCZ5dcIE6lkJJWuqJiCsdTijPQgcjqMajXOaTTwFGVcZwLHZ0FNUGNnXaBwdLRn838x
-jDtP45Yki7kL456AqsbiY5GU1ECKKMFwgTJD9iDwayWaCiOV5+MzyEou2Oxz0HsMzN8NGk9tRfXVk/lt
-JF5T0VCLLHAB79wJA1TjlvSmRAkUGNYoGcps6Yx06XkwpB7XbiK+bGw/FlomVNaFZB6PCRgKukTmtAzO
-4HJ94IYLASl2AgY8B26hUGjAKuhSDinmSqO3ypAywSW+ASoZ5EpnvVGnuuXXWGc61Gq69EKUDFTeW3KG
-HqTJxxipNT0X5hNKd2XfO4+T6Q5H1Om7uxYT1FrpX7ixSi/PPaR4tUKZKYaTzpbP6LRt/R3dR3DO9ZMK
-2RcMQy2WXBbgHc/gg/sYyFSFkGtVgS25LAwIfoVAwfIKwaDmaIBRS1PqCDSmQVAaKFRU5EpXyFwF6+WD
-JDmc64txYbznHWLmdFBEsohMqW5iEjhlJHm9WvX/tO3ZnbKTRZRyyWISLl+T/oWZha9fg1NP5Udcbjnd
-qPd9sREi0rwoLdlgCAYKRcCayHCZ4XBzYLALYjEvT5NXiznj18mrux09VWzprfM8Js6D782DY7rb2trL
-lIhMFb3tKmHf6b3nvw+i0aJOFsZqJYvEMwEfcelI7LYW8zqY6EDfY+3HtbVtyv+5MRNJK5wSmO/aGaxe
-Atr1eDwY4DuPhwMOPrMQLpmJXP1vHb3kFZJvFeCfHG/MCwIc9JV5GXrKFS43HcUnyLWSnxshILQdV7Bv
-7lror9ofjcAvWjgtt4QPjFul71Ga422tz92feLVKraKhiX24rbV37BZoDFfyPgtcZpyhtOecxcPrTpKL
-IIHJ6725vGBknkz7JnL49HzSeM1VY6AHYmCyWoWW3wsvmIHzu3szgbKwJZzBd9C205cmWWON1MaEM/ds
-3kXwFQSvuL1UZz+Qe7kNRcGZz8zr1YqztoU1f6NF7VU5W1+BgQuS/K4k7kG/Xm0W36aHhdnqRVQGSWol
-pFZGDHPaCOvXt2bIXBgAz1mj/WQaH5dH/XM9eKfb9sjSwuy+4Ze0MJ7kYyhVowcUvwTCybMgnHgI5kAY
-3j4Lw9uDYjh+HhHHh2Xi5PR56TjdQXHwtvUuc/he8q5YE0llMVnM+9VGQLOrbt8tNtt+1O4E3XIj8sP4
-8MBwY2CBygxFt72elPZO1yT4GShsuyvQ9hYKrZq6/7UYxzBu5JVUN3JM1lgKtAP1utFFwNktn5yk3QH2
-V2psl4v/lj+nB53i83PY1+QGxOxyWWPb7sxG+wHPvhjUJEmXsMeOk7XtYKIejajdP0INvbtBKtnSegzE
-b2gMLZAkZ/tgBOk2kgeepfV3T9o2tfaTG9TvecSOT8IrVur5Yw/e8Qnpx5WotJXYtr+DKXz+DQAA//9J
-g4qxCRIAAA==
+H4sIAAAAAAAC/7RXX2/bNhB/dj/FTQViG6vsJcsKLLAUdEWHBe2GYk33TokniQtFaiTlRHP13QdSlC3/
+SdIl7kvE8Hh3v/vd8XheULaElBOto6AiAnlYIKFM5AGIPBwK3tr1JK2VQmE+GWJqPf1+HCbybuzPsvQm
+CozMc46TaRC/GC2K823bhhmOVjJaECgUZnY5WuiKCHCyKLgumAa8SzkpiWFSAEulAIWVQo3CaDAFMWAK
+BMJRGWAamAAigKSGLREmQopQSFUSPgVtiMFZ0IPIyDCq1Tgj4cBTmDKVchxfeDVOtJl1gcLJyYHN7yIY
+d57GbRAv5jaMewIqa4OPRVIQDQmiAM04ihTpg8CXktclhjLLxheQFozT2Sev9xiYf2rUjtqSqJuv5rcW
+uCS8Jgap5wLe2BMaiMIt6W2BAghQrFBQFGljjXTpeTCkHtduIj5vbD8WWspl2oWkH48JKHLSILVaGmdw
+vT5wyziHBDsBBZYBM5BL1GAkdCmHBDOp0FmlSChnAl8BERQyqdLeqFXd8quNNe1rNWmcEAUFmfWWrKEH
+aXIxhnJNz5X+iMJe2bfW42S6wxGx+vauRQEqJdVvTBupmksHKVqtUKSS4qSz5TI6bVt3Rw8RnDH1VYXs
+Coai4g0TOTjHM3hnPxpSWSJkSpZgCiZyDZzdIBAwrETQqBhqoMSQhFgCta4RpAICJeGZVCVSW8GqeZAk
+i3N9Ma6087xDzJwMikjkoS7kbRR4TmkQv1yt+n/a9mKv7EQeJkzQKPCXr07+xtTAly/eqaPyPTZbTjfq
+fV+sOQ8VywsTbDB4A7kMwOhQM5HicHNgsAtiMS/O4xeLOWXL+MV+R08kbZx1lkWB9eB68+CY6ra29lLJ
+Q12Gr7tKOHT64PkfvWi0qOKFNkqKPHZMwHtsLInd1mJeeRMd6Hus/by2tk35v7d6IkiJ0wDmu3YGq+eA
+tj0ejwZYuxfjA0mQT/YekldbbwsuUTHTuNDA5R78NdShvSF9ibrT16zE4FtR8BfDW/0MCgadZ174rnOD
+zabnuBTaZvNrzTn4xmRL+tW+hf4y/llz/Ky41bJLeEeZkeoepTneVerS/olWq8RI4sl/d1cp59guUGsm
+xX0WmEgZRWEuGY2GDSGIr7wEJi8PZNv1kHk87dvM8dPzUeGSyVpDD0TDZLXytdQLr6iGy/29GUeRmwIu
+4Ado2+lzk6ywQmKigFH7sO4j+AKclcxcy4ufgnu59UXBqMvMy9WK0baFNX+jReVUGV1fgYGLIP5DCjyA
+fr3aLL5Nl/PT17Oo9JLECEiMCClmpObGre/0kDk/Il7SWrnZNTotTvoHffCSt+2JIbnefeWvSa4dyadQ
+yFoNKH4OhLMnQThzEPSRMLx+EobXR8Vw+jQiTo/LxNn509JxvoPi6G3rTWrxPeddMToU0mC8mPerjYCk
+N92+XWy23TDeCbrlRuTG9eGB4cbAAhEp8m57PUsdnL8D72egsO0uR9NbyJWsq/73ZBTBuBY3Qt6KsZ0j
+94RC2rF4HKxx5mgGpqta5T6GbvnVCdwdfz8Qbbo8/b/cWj3oFJ+e375eNyBm102FbbszWR0GPPusUQVx
+0sABO1bWtoN5fDQi5vB4NfRuh6x4S+sxEL+j1iTHIL44BMNLt5E88GStvwfStqnDX+yYf88Dd3rmX7hC
+zR97DE/Pgn6UCQtT8m37O5j8578AAAD//5+DqkVHEgAA
 `,
 	},
 
@@ -24569,40 +24619,48 @@ AAA=
 
 	"/partials/dashboard.html": {
 		local:   "web/static/partials/dashboard.html",
-		size:    1350,
+		size:    2696,
 		modtime: 0,
 		compressed: `
-H4sIAAAAAAAC/5xTTW/bOBA9O7+Cy13AW6CMmh5dSUAOTW+99VyMxBFFmJ5RyVGcIPB/L0TJjtM6QJCL
-xfnge88zj6X196oNkFKlI++1ImdSz/tKBwbryen6anXe1HIwwZmbz1PhRQUCRlH513jqOGM1nuwZVllY
-fz8hzt/j51URGCPH90mwQA7jmYgF620SLlCmnbn59Belp2EU4yKPw1zLiavVarU0dBx3pmWSyEFPeXkc
-sNKCD5JDcmbHFkOlOx8E4zG5xUfLe6r0cvj/P7xHkg+5PgRosedgMb64J8xB/JCPXgJW+i4XlafWWyRJ
-WeSqhAv6DVjLpJUFAZMZdkhS6YZFeKdVH7GrdC8ypE1RNJxGuuboijGBw3+PDGaWk7QSiA6l0j+bALSd
-iVelPzJ3oDowv0ZM4plM62Mb0PCUDE4riB5M761FqrTEEafV+QUkjUP9JxI+CEaCYIKn7av3y2K6m2dQ
-QF7YbICTIS7tfXEaLCMospWSrstmFGE6djdC2W9L+LRuhIzFDsYg6436J7U92jHg9R344MndTkZNH9Xc
-l/263qjLXQetkjxOG+0Cg2yid7180Wp206xD11dK5fEM8CwKrENdPz29gnsozko/qA2c0H7Nf/BwKIsJ
-qlZznKc1U9Xz9C48IkkG2u3sqEqfkL9Ncbr+jmhv22k97bbS6ylM6rbdEu8DWpcdt9anGTwDaCV+h0DW
-gkzP5zk4Pek30J8x2aOG89w7qX8HAAD//zYcprRGBQAA
+H4sIAAAAAAAC/5xW0W/bthN+dv4Kli1+jn8orXTtU2a5KIp1CDAMxbJhj8NJPEucaVIjT3ENQ//7QIqy
+5MRZi74k5N3x7iPv+05eSfXASg3e59zZPWemEr62+5xrC1KZiq+vZtOg0mqhK/Hmh+A484BGRyz+Fcps
+bMxVKCMnuVaZVA8hY/9/+PcsCHTOuu+DIMFU6CYgUq5vg3ChpN+JNzdPSirTtCQqZ9um90XD1Ww2SwEb
+63aitIac1TzY6dBgzgm/UNyaSuysRJ3zjdKEbjBu8SDt3uQ8La5f4QMaWkR/o6HE2mqJ7uwcWatJNXGp
+SGPOP0UnU6ZUEg35CHK2ggv4BUhpDWcSCESssENDOS8skd1xVjvc5LwmavxtlhXWt2ZpXZW1Hip8OVQQ
+PRzPGYGrkHL+V6HBbPvCs5UaKm+AbUD806InZY0olSs1ChuMuuIMnAJRKynR5Jxci6F1KiXxbbN+nAm/
+EDoDWmhlts+eX2XhbHyDDGLDegKcCHGp74lpkJ4gi1TyfL0qWiJrhuiCTORb2h7nBRkhcQOtpvkte+HL
+GmWrcfkJlFam+hCI6l+zPi7ydX7LLkd1nHk6hI5utAW6daqq6UfOejb1OPj6irH4PA2MoEBWyNfH4zN5
+u2zi+sOU2nqUP8ULdt0qC6nWrN/H1+pLrfvXe15EDRjUSRejnhtnC407v9RoKqqjzOq368/JvMrqt486
+EPNMXzUaPob1dcq2/NM6T/cE1PpFDHXYINCpHFOGDZWfCLgHWmM/ofpCqtyeDi/va7s3LGcvzgxJR/W7
+8zxRdQPVp21oWq1FbFryTjRYkGGBAo1TO3CHuP7iI5ZEOCiDRt7HZkO5/V+Ckh+PA6g72XVPH3r5K6L8
+UG6n9+rnyNKTbT4720AFIff1gq+h3Bq71ygrTOK4CHMPzihTfQVmJNJ/Af0qnJjhBCRR8SphChUTwjH3
+R+sc6pig69ho/x0qf09OmarrmJg47oaxmAjZdeOk7JNf912ckAomdFr2Igrygq47Hl9p8MTes/mc3bL5
+azY/SWiRLgFp9tTvJsPnEiMLKw/xndTmERcT9Vo9HNDKk2hNnA9yIJ9WU9DKT1HfnX8NHlFVQ/Gs5JRf
+fmydQ5P09prNY7iYLyafWh99vwTHhRMTCz6gU3RYhNk8tne28uSsqUY6K7+8kyzPTzf4zVoabnEn+dpZ
+S6usPzYSd2Tm0NX3SubHY0zXdXz98rSeEH5oeH8X5Zf3bfE3lvQEZIgjL7wyJfaBBI7Y/9mbm5ub8+hV
+plXqfKunnb8wQckLKLf97Mz5aTb/HPZ+VDQE9czD1rMPo3LDN3vOT1+RMQFnpHYIRkqg8ANk3Jx+FH1D
++UklOWCY2r6z9L8BAAD//6HkgnyICgAA
 `,
 	},
 
 	"/partials/errors.html": {
 		local:   "web/static/partials/errors.html",
-		size:    1698,
+		size:    1779,
 		modtime: 0,
 		compressed: `
-H4sIAAAAAAAC/6SUz47bNhDGz/JTTIkCsgFLThbpxZEEpEFycoOi3lvQAyWNJcIUKZDUOoaqdy/4x7uy
-14sWyGW94gy/+XH4DbP2oYAvSkmlIdu0D8VikdXsCSpOtc6JkicCokl0K085QZtHikU0T6kkT3iTvH+w
-gSjrFV4ilKMy4P4mNRUNKqdVMlFftECbM8ecnFpmMNE9rXALvcLkpGj/kRTZpldoC25q9lQsLj9vEnJJ
-ayaa/2CcReaMTBykS4h2XiZNU5vui94weIjnwkYayndMoF6uoIB35A5lOGtHVcNEUkpjZLd9/1v/46Pj
-LQdjpAg74tIIKI1IajzQgRvoB84TxZrWxLZqxVl1zEnFkapPnC9Xrgk107TkWN/w5LkF+mxz4RPnMI7z
-8DRlG1/7pyj2yLEyWL9C0SFwj2Ycb6LTBJeVF6o7195TgTxplBz628t2IcegsEdqnNeACUBv839AqhrV
-7+ctfI/3Q1Wh1vE6TvZDF/997fZ0P3TEH9RJj7Gv690cb+EXn+Q01hCiOmhuZ8GJwK31fHKL3rGzdrpt
-rTwJyEMB++GdmbUfrvcbZjiSwvt4HG36N9rhNEECS/+9H7ppAnxCYfTKiXBaIn/Web7T0HpfKYoyJvrB
-gDn3mJOqxepYyh/k9TbH3skauWd3qViTK4ew6rhEpdbwqwNZheNsHEr4v/1QwGzg7nSrlPXZ6bLDrE9B
-642xvjw9Strpc2tX7uBMYLBH+uXWIt/jZEe1eWQdWnNcjfD2XRjdKIrG0cqkf6DWtPHdD0uf5SDMNIFh
-HWr4qmQHme6pAKMTu+YB0q9M+TL20bPxAh7l3cwLz0uiI4AEMmrP1So85GQc1cBxx8RxaXetUanVNJHi
-r4Ej/EkbzDY0tP3S7bvv3P947vxAL968gDAPvlPfZJjDFB5bpoFpoNBIWYNp7ZP7AhJ+/g0AAP//aB7A
-n6IGAAA=
+H4sIAAAAAAAC/6RVTY/bNhA9279iShSQDSzlZJFeHEnANkjQwzYo6r0FPVDSrEQsRQoktc5C1X8v+KG1
+7HjRAr1YImf43tPwzThrbwv4rLXSBjIGlWDG5KS0EkoraY2PbBDWv5sO+kEIqnnTWgKtxsec7JhAbVtk
+wrakuHML+M2vsh0rsl17W6zXWc2fZ2StjgRkQ02rjjlBR0yK9WqZUilBRUPf37rAKus1zhFPBv6X1kw2
+qD1WyWU9Y4GxLwJzcmy5RWp6VuEeeo30qFn/kRTZrtfoCHc1fy7W8+NNhUKxmsvmXzQuIkuNXD4qn7C6
+DzBpmrr0QHqhIYh4JbbKMnHPJZrNFgp4R66ojN/aMd1wSUtlrer273/pv3/0esvBWiXjieTyTk+XmTjW
+SvDqKSeVQKbvhNhsfRFqblgpsL7Qk+dO0CeXC3dCwDguw9OU7QL3/1JxQIGVxfoHKSYGrqkZx4voNMG8
+c1J15dp7JlHQRquhv7xsH/IaNPbIrPcacAkY+uZvULpG/evLHr4lh6Gq0JjkJqGHoUv+Ond7ehg6Ej7U
+Q49J4A1uTvbwU0jyGDcQoyZi7hfBicCl9UJyi8Gxi3L6Y606SsgjgVsEZ2bth/PzlluBpAg+HkeX/pV1
+OE1AYRPWh6GbJsBnlNZsPYhgJYpXnNc7jaUPTKtVxmU/WLAvPeakarF6KtV38uMxr71TNYqg3adiTc4c
+wqunDWp9Az97Idv4OTsvJb63HwpYNNyVapWqfvG4/HFRp4j1RlvPo0cr131+78wdgkuM9kg/X1rkW0Lv
+mbEPvENnjrMW3r+LrbtarcbRwaS/ozGsCdWPW5/UIO00geUdGviiVQeZ6ZkEa6jbCwLSL1wHGjf0XLyA
+B3U1c9ZzSvQKgLp/BNnQMOrHUQ8C77l82rhTN6j1dppI8ecgEP5gDbqJH8o+V/vqnPsP4y409PrNC4j9
+ECr1VcU+TOGh5Qa4AQaNUjXY1o3ck5D4+CcAAP//U33wsPMGAAA=
 `,
 	},
 
@@ -24643,12 +24701,12 @@ H4sIAAAAAAAC/7JJVEjOSSwutlVKKslTSCrJ001JzEtPLQIzK4qVFPLSdTOKUtNslaqrE5NLMvPzNNTT
 
 	"/partials/forget.html": {
 		local:   "web/static/partials/forget.html",
-		size:    119,
+		size:    152,
 		modtime: 0,
 		compressed: `
-H4sIAAAAAAAC/xzKQQ6CMBAF0KvU2VQX4AUoSy/gCaZ0qMRmmnQ+0YRwd5Lu3uJN7JbCZoEi1EXokFiz
-tM6/kdM8pM04FkmBDAwZCxvGNxi7uVtwftev1p/6nj9N1kDHwQu2qne/1pYF/nGeNL+6pyfPVwAAAP//
-41bbhHcAAAA=
+H4sIAAAAAAAC/2zMQQ6CMBBG4auMXVBdgBegLL2AJ/ihQyU206QzRBPC3U26dvctXt4IWjJUg5tNaDbp
+IyRxbfyqI0l93BRz5hicGoyHDLXhabBd6RLI7/KW8hFPXUf/CykRBt9ur8prcMeBxbYiV7+Wmtj87Tzd
+9Gge75h+AQAA//+v0rCWmAAAAA==
 `,
 	},
 
@@ -24752,35 +24810,43 @@ kPbj6XFNA6z51KuYSaTMHYWJRyzmlGCtFn9hrRxGXePwpOlxhYTb5W8O37F+S5OMtQkoZ+OZhB8rhTJy
 
 	"/partials/incident.html": {
 		local:   "web/static/partials/incident.html",
-		size:    6267,
+		size:    8631,
 		modtime: 0,
 		compressed: `
-H4sIAAAAAAAC/7xY3W/bNhB/7v6Km7alCVDZSZoVmCcryLoMC9p1w5LuZegDLZ4lLjIpkJQTQ9P/PvBD
-H3bkJK2TvVj08Xj83QfvjowoW0KSE6WmgRQ3AfA0VJm4mQYopZBB/NWLPksi8jBPw6NjM7E2Q3KUGuxv
-SAlPUVpZM8ZpKysaU7Y0Et23+Rgp7baMJ4wi17C3B0oTjZsQDEq7efbafF5EqiAcNNM5ToOrjCnA2yQn
-C6KZ4MASwUFiIVEh1wp0RjToDB1SEHPQZkm7qx0D4UASzZYI+1zwkAu5IPmBwzPq2YipM8sWNODmBOYk
-7AEIEyaTHI3uBqdDTIyETOJ8GlQVUqYvWY48wfeMX9d1sKbMotT42VpkRMEMkYNygimUXLMcqkqzBe57
-6uic04O6ho9Xb/tK+dkNnZYiLxcYivnc6EKsIhfNflXVbD26oHUNzid3fDr6gEjPkusghhA4IlVAkmsu
-bnKkKS6Q685K0dj6tw2ZoQgoBrY45zSIzzmFK7bAiQ8OFRq9e1yXmkgdmAnkdHPSiAAuwpzx62mgZdm5
-LxoXD4HaOC1qEb4JnNsHuAf5X/upF1ERR0pLwdP4zDr6Ha4m0diTHBbD5uEMS/uhldY4xZ3JVl0r+h2u
-AhhvyuuNdgFvre0dshv6rZ58LuhvSylNhF9qokv19Mb38p14owYoxhOEbeoaIz6ftu+J0nA2c/nu2XQ2
-uzSb3K94WHJ2u2Xl81riL4Y3u6jeS/HjjCkt5Or0GlfTqkKeCIr7d47fQV0H8S9lnsOvjt9k2Vd3xVVV
-Ivicpb5YxH+WOcI5ZVrILSvGeFvIU/MzraqZFqTb/Py2kHZjM0ClmOBNcn96m/4hcclEqaCpGwr2q6qt
-WqNm/oIqOB0kj3Lkqc5gAodQ1we7+kdigURPA0ZN0R/E8S/kbMH0lZh8H/Qs2vCeMtpzKbWm/KaqmC2C
-jSF9ocoY7Z/k3i5B/EFwHNBhu+EHBH7AW31BYTqFw8/zi1nY+uSJYn7YQOtQW2utk+v6vhDsBs9TaH0r
-tpMZ/MxMc5hpHlKckzLXdnyr+mHkW61TWkrbLU6Psj3b0k17VrEJoq73NEnVgC2vSKqsJY8gE6XsRd0u
-QI53AHJsgagnQvJmByRvnhTJ0S5GOXpaqxyf7OKgkw0sT57qzbVI8C8poEZpNp8G7q7V9qbNFlqFXGiM
-o3Ez6iZIcu3oZtCRk1wov8ANu6m5kAn2GfqENbYUdYMslaIsRq5xMdn2ZcnNHYa/DFoZKere8qKUqZfv
-hp+V5TfuN7YFstb9ktbOLvzy3NaLtQ7H6GpVYF1v9HxbYY8+KpRBPFvBsDQzbUqAvW8B0Vtb4T4C0wv2
-r9iPAvIbKkVSDOLJFiieoUXzUE26916YnTSnIhpnJ/dwd6WdOH7f9ZguAUgjYvtap/DmWgNBk1mODbv7
-Y39DpSUrkAag9CrHaXDDqM4mR4eH3/mKqjMk1OuvaWw8Ho11j2K8tk7xxlsnGketU35GQnPGO2o07jaL
-9EzQVcMs+z0bse80TskuO9DuukFsXNozSYfnXSBun2/jY50lXn+wIv5O1gYoacPR+OiBtY36a+tb4h0Z
-0VjLxkqNaaKxdWIXUdsD8Hxpuu774w8KwjG3keSJlvDWjPeXPu8dBH1f7H/LOMXbV7A8MF5Bu8ud1xAr
-JjS+ZTz18llybbNMTgqFPTE+7LKT9cX2YazJewRMcRy+azY4B5NCI7LM81CyNNO9Y7Pc9GaP0D0VOQ3/
-dnjDo0+WB05hmD6Brgb/XiCHUyg5xTnjSGEC/XenA9j28uTdT7z3rQeHm2FnKhMdXTHNxA33sD71muRW
-7a+XIyo4vhfOObEfjEajxxSn5ah9LB4oIN17sa1IEh/1ZNzIbFNSxjSGqiAJTqCQGN5IUvxozFPIR1ZU
-axgX3k2rNQB5PUwb2NnrwUi8LGf/YKL9Y+X2Qtr5BDQuipxoXNNVOTndE/n/qMNPgq52UiDM9CI3WtiY
-ix8ukO7zXwAAAP//bY1XK3sYAAA=
+H4sIAAAAAAAC/7xa3XPbuBF/vv4VCNvG0kwo2YmbmaoSPW7ON82c6+vUvr507gEiViIqCOAAoGyNwv+9
+gw+SIEX5S87lQUSAxX78drFYAJ4SukEpw0rNIinuI8SXscrE/SwCKYWMkj/8EJKkgsVsGZ99NAOtEcxA
+amR/Y4L5EqTlNaec1LymY0I3hqP7Vh/DpRZLeUoJcI3ev0dKYw1dFYyWVnj2yXx+mKocc6SpZjCL7jKq
+EDykDK+xpoIjmgqOJOQSFHCtkM6wRjoDpykSC6TNlFqqbSPMEU413QAacMFjLuQas6HTZxRgRNWlJYsq
+5RYYLXAcKBCnVKYMjO1GT6cxNhwyCYtZtNsBofqWMuApXFO+KsuoZcy60PBiKzKs0ByAI+UYE1RwTRna
+7TRdw8D3jq44GZYl+vXuS2iUH+3YtBGsWEMsFgtjC7aGfK3k7XaV6NFXUpbI+WTPp6MbAHKZrqIExYgD
+EIVwuuLingFZwhq4blCajq1/65Dpi4C8R8QVJ1FyxQm6o2uY+OBQsbE7oLrVWOrIDAAn3UHDAnERM8pX
+s0jLonHfdJw/pVRntah1/Dlybu+h7qX/5Id+mObJVGkp+DK5tI7+GbaT6dh3OV0MmVenn9tfa26VU9ya
+rM21rH+GbYTGXX5B6xjlLdreIcdpf9CT30v1L4WUJsJvNdaFejPwlWV3jefABrUpXpYT9QHt9cMGJNXb
+obEWKcpTQIdQMVh/P1CusdLocu7S4u8CjZFYCdzDpzX4FEhxwelDgFQ4+fui9h8K98fAFOwa44wqLeT2
+YgXb2W4HPBUEBnsreliWUfJTwRj6h6M3ifvDPrvdLhV8QZd+/0n+XTBAV4RqIQ/MGMNDLi/Mz2y3m2uB
+G+FXD7m0gk0DlKKCV/vF22P6LwkbKgqFqq1IocFu14RGNf6VKHTR2z1iwJc6QxN0ispyeKx/JOSA9Syi
+xNQRvXp8Q4yuqb4Tk79EAaIV7QUlgUuJhfKPux21+2oFpN/7MkrCVR9IiZIbwaHHhsPA9zC8gQf9laDZ
+DJ2+zC9mYu2TN4r5foDaqtZotbvL8rEQbBrfZ+/21d1RMPiRueZornlMYIELpm37QYVh5Ku3C1JIW4DO
+zrL3tkqcBajYBFGW7zVeqh4s7/BSWSTPUCYKGUTdMYp8PEKRj1YR9UaafD5Ck89vqsnZMaCcvS0qH8+P
+cdB5R5c3T/WXStElB4LuxBvUGp1Twy/3vHVg7QyEp7cnmJhzawMP4HWUjJ8129L2aOB49LPopGunwLdv
+XQVuxFyQbcjiuftBR0dblamWhJ8oMLPdvKx+NGyO9aIfZIYZsr9VtEfhTsxaG7ETHeDMDmFbzR+sPqDN
+sMWkstnb0zBbWWbOqAnqFLabWhJ6qStetlBSs5xfA6/RlS5cCQ7NubASoVXMhYZkOq5azQBOV67fNJru
+lAnlJ7hmM7QQMoWQIOxokS1BV5otpSjykTsBmLLkpOArLu75iYnJvUEuCNb4JKr5L0EHrPNCLr1s13xx
+Et3tsAV7cIJtdjqxudBlqldl5YahDWjHz8bsixJr79JV2kXGa856duLrF2ywoTR6jO62OZTl41kxIP9V
+mUw836J+bmbY1HluhWF98GwcamAOfM9L7sGsf4JSeAlRMjmgiieotXmq8Hz0Pik7r1b0dJydP0LdbAgu
+hqqjjcn/CFcsDs91BnfnGhU0njOoyN1/7G+stKQ5kAgpvWUwi+4p0dnk7PT0z75s1hlg4u3XJDEen451
+0GO81u7x4LU7jaPaPT8CJozypnc6boRNtdnwKmIZpnNs73edkU1mI02axjYubc4g/eMuENvjSSfZ4yZK
+6qQf7loaHnS8LrQBz+c2PDJH7mBrIqBSSedgugdudBjcRLbFty7Rsb8AqoMf16Fu/P/E3Ara1vy6c4/H
+dKxl5YEK9unYBkgTrYeD+0ZouqCpu67/ERjdgKTw/GAn9ZQw3nnAVSFlr5afCv0eTm8b/XtBHNreCXiJ
+ucqF1N2oV5ryPnpY5wzrDn+3F7b7rrEGnm5fum7sfUaDUNSNnzpUyCOhFsT2KLQ9eoSuRuIxogCXR3l5
+lGxV01kFrtXkf7Mkyei2yO0tlnHz/tJNVD1s9oKQvJ38O3zfhZTmxPCOjK7sG1lLiCrSFJSKEhO/3UcV
+4iudL4JAlAys9LqjLId1njioRa9M/3aXLDBlQF4p1IFhuXdwOJQ1/VoO8U4MDx+taIzOTu0/9A3xYj0H
+OTktS7RWe+nwyHR0tQGun8g+KMccmM0cvtN2fDHtwcYjMmydQgZ/opzAQ3WUACtl78HIsonNcqR86fnT
+dGULKoZzBQEbn2Oy8/Zk+3ZYlXgYmbLy6Xv2SucPaDNqbtD7CqNKVsFYLOky00H+3HR3naCjeWZzpv/X
+GRKf/WZp0AXq75+g5rLhlxw4ukAFJ7CgHAiaoPDNbogOvdr5uMA+LKxr+2/9HIYmbJrDUCbuuVfrt+A2
+sDb73WZEBIdr4byW+MZoNHpOgb4Z1Q/tPUV089Zuq3IJz3pur3jWG1NGNcQqxylMUC4hvpc4/5uBJ5fw
+vFOFBcbFfX3K3le5Hb+V2tmn3hC9Leb/g1T7h97Dh4nGJ0j7/N2yVTk+zZ8X/I42/N3eqxxhQJzpNTNW
+2JhLnj4kuM//AwAA//+nBQiZtyEAAA==
 `,
 	},
 
@@ -24867,30 +24933,40 @@ S/HAVn/JWQ+yfG4wgi5P91hQ2H9L7FC75KebXdbJWbypp1f7LwAA//8CAgz6mQQAAA==
 
 	"/partials/silence.html": {
 		local:   "web/static/partials/silence.html",
-		size:    4381,
+		size:    7396,
 		modtime: 0,
 		compressed: `
-H4sIAAAAAAAC/7RYX2/bug5/7j4F4b0kQxy3HbABheOLYei9u8At7kM2nIeiOJAt2hYiSz4S3dbr+t0P
-JNupnTTdv5OXShGpn8gfSYluzMUtZJJZuwqMvgtAFaEt9d0qQGO0CZJXJ2OVTMtQFuHZuRNMJEyiIfB/
-Q85UgcZjpULxLVYccXHrELtxGHJtqgHGzcNSG/FVK2Jy93wvLoxu6s4AyVKUY+NsFZ5DphUZZ6mTBokl
-Zgg4I4wjv7Rre7/xncc8iYWqGwJqa1wFhPcUTE7vwb13leYoV4E/wC9kpXN9FXTjbN4j1gNCibIOU6mz
-TZD8W5uK0QW0bduGVRVyDp8+XVxdXV+s1zdwfbX+HIWn709Pb5bw5fNHEDkoDSQq/KoVLuGLRQtK3zlB
-KpnaLOOo9p5NWf59/lDxY7KHih/m7h93hjeGkdDqSM4M8D+dDesaM5G3gIJKNDBwDtpAzKA0mK+Ckqi+
-iCJdoyLL06VCirjObJQ2QvKopEpGjUXzZ9EIjtFfDZo2cih26WSvDUpG4hbHLLDkaHnjL4OXeT47/VWi
-PfhPs/z/2nnN5NF8LrUleyyfPfiv+wz/kTq1C7BYM8MIOaQtZLqqmIXZYr6AWtRoYfZtvnBpZ2uWoV3C
-5T2raokXEGeaY6La8A7T07MFqDY0yIV9E0decjRStS8JYsXRmHXYv0Hs5f0F1NrQ6t3b9/9aZLKxhGZ1
-thA5y3Al9ZtvIn+zdB7cWmAG90q60JKpYqlNEdWbIqoZlVEuJPrJ6ytGWRkkhQufq9idEG7DtherrU3H
-jpC7dRSrfvCJGLRDH6ckjnYWjmFhhdayAo+VQD38T79Z+zboPLdI3oGpTWPVErNNqu87Sc9AN5/avlUc
-mZprU+ChqxM+NKShU+mwnwjbOnSoIP6bA5XYNYGQYqYrtNCojdJ3CnhjhCq8ghUSVYZQoxGaL4A1pCtG
-ImNStv3hIOhXsvX7fA5JmDZEWg3KKSlISYUcc9bInh0pso2LvyVHjRvjqNv2jFlx5GxJXh3uqB3AunPd
-PtdYu2D3jXV5nvwhpIR0SxaPo/L8AAk7m5ttMUhhKWyUpVYi7/2WwllksEZGq2DWwy+AZa41mINQsGuo
-22Zrpp4a+n6Xa+mdYKwzHO2L0v8NayMqZtoRFd1hQdKNI5A4kqJ34secfT6M4xP7MGZa5cJUs7lf5MKy
-VCJfBf3sYycOkl5vEunB+f7LiIunC2Hs5x0zSqgiSJyGS3bTSISHB/fz8XHr5fTzZy9hXv7kKs+Ty3th
-PfwQo2lqDMMzeThEfShAoYb0+m5Cvt0L/tLd2C4DyrdehRyNw3b/IwCyoZ+F1r1EwfX12eLs5qbPRCqR
-8T51yAzXF5XdB1scUTlaQ8V3Vvr2crLW9QiTJfe27Cxt34LpCVyM4OKoN8ktDXbGlGrebk2eFJLgC7Dz
-EaVLOy2hk5i4J0RUuArscu2/GpM4Iv68wqXie+KnICw/+A74BYXPrFiT8Ql5WOmLRfOS/Gp42KYq/ewk
-ZgdKz8/vu6aqa3UeHgqkSy6Gq+V/Qm1mdgGCzx8fg55+tgU+cD/7fy2MwYfylsjMTPB5kPjpuH67KPL9
-wA7RjCOfpbs19HcAAAD///UW+codEQAA
+H4sIAAAAAAAC/7xZbXPjthH+7PyKDfPhJI9E2r5OMuOQ7DhXpdfp+W7ql7lxr9cMSKxI1CDAAqBlxVF+
+ewcgKZG25dcyX0wKWCx2n312uYBDyq4g5UTryFNy4YHIpjqXi8hDpaTy4m92uiKp5FOeTfcP7ERvhnBU
+BtzfKSUiQ+V0JUzQta4woOzKaqyf7WMuVdGqse/TXCr2qxSG8Nv7u+lMyaqsDeAkQd41ThfTA0ilMMpa
+ame9WBuiDFBiMAzc0G3bm4XfO507IRNlZcAsS4w8g9fG6+3eKHfeFZIijzy3gRtIc+t65NXP0bjRWLYa
+cuTlNOEyvfTin6UqiDmE5XK5nBbFlFJ4//7w+PjL4enpV/hyfHoWTPd+2Nv76sP52TtgcxASDCvwVynQ
+h3ONGoRc2ImEE3Hph0HpPOuj/Hr8UNAh0UNBt2P3f3eGVooYJsVAzrTqn82G0xJTNl8CMpOjghZzkApC
+ArnCeeTlxpSHQSBLFEbTxBdoAipTHSQV4zTITcGDSqP6JasYxeC/FaplYLVo3859p5ATw66wiwKJB+ON
+wrRSCkU6FHM2Gzwb7k+ldZ9wH47JJWowOdNAoNbIRAYFYcKgICJFWDBB5QJMTgwwDVLwJZDUIglzqcDk
+axGOIjM5kLmxMSRpDjJtbYQEzQJROPm6JhFB15HWPszq2BNIlRSA16VCrZkUMApTSTHeg4MD2IVdMBWG
+gRsaW4IQAScn5x9mrdzPJ7N/RJ9ns79/uPjxp4u/HF1EZ+c//nTx/tP5SXRw0K58Ztw3X4YO7E8ngy1c
+YCvXQFyw+v8pxfOZ8Lejj0ewts4FZ+OgDTdeEV4RgxSYmLiA4zUpSo5Qo31UoGIpCT7i4pcLqS4bfNdV
++yXF+ZVg99g4EOC18mfD/d7aJUVWZ0drKNFG34ftn/IWzaGKlOtYHoZof++lGDnlL69Ng/oMCjO8HtTz
+E7vDK0rzkbWx4kR1a2FBTJrbCl17IUiBegJMaIOEgpwDAc1ExrEW8GFWM+qwodS/pf6XPzSrcqmNHgpb
+p/wVsP6Vy0RPQGNJlKtryRJSWRREw2gynkDJStQw+m08sR8XXZLUfZz6MIrldIHJ3v4ExHKqkDK9OzSo
+0n0dDckGQ9bqfgWws+tDKKUy0fdvf/jzJOWVNqii/QmbkxQjLnd/Y/Nd33pwpYEovNPZZZITkflSZUF5
+mQUlMXkwZxzdy3fHlvlenNnw2cbtVgjXYbsTq7VNbYSsMzvnuq2y30bNBBgJeJ3yiiIQsBu1LYXl3LcR
+TdogjyfN2uj3zlqXm66NupO2ttVpdvv9nt3skWYo3ghp2JylruEdjDq9TV7BoXc2ETuB7eqti50Pn2wD
+anLU2JuuOaUZt90CnbhWpi6STIM2jPNOL2PjoVFQDcxoqFOrp+wui0iGaugct8cX6+XTmpZWeurCFYfB
+rYEhLCxQa5LhUDxq1D/78HvXBjmfazTOgb5NXdEc08tEXtczDQL1e9/2tWDH1LlUGW5rb+CoMhJqkVr3
+BrC1Q1t78nmHvAmmskANlbgUciGAVu6A5k5RNdehRMUknQCpjCyIYSnhfNlsDsy8hK2P49mSMKmMkaIV
+ToyAxIgpxTmpeIMOZ+mljb82Fhr7DIN62T1mhYG1Jf5m+9WcVXBau67vu6GzwW5u6PKD+LNN/GRTGMIg
+P9gCwq3F1ToZONNmWgltlhxp4zdn1iKFJRITeaNG/aQ5GY+BCbhtqF2mSyI2N4PNKi8OAzvRlWm3dknp
+/k5LxQqilh0o6s28uH52lIQBZ40TT3P2/jB2d2zCmEoxZ6oYjd0gZZokHGnkNW/v6mkvbuR6kW6db65Y
+KdsUhK6fC6IEE5kXWwlLdlVxhJsb+3O1WnvZv0d9gDBVmcqCicxvDm0P3+rmB/Fx5/bjszuj6YY2O6Gx
+XrZL3Y+GECZHQpsIGtVWEZPXF7BhYPLOGAp6a6Q5ifXG6mavN9S9VepNrAtzOxoGjRV2qDUtNImky7WV
+XQovLGVbqLy1ZgpGTw0rMPIW/qm76o3DwND7BWaC3ples33hN9ngH7mT4RMEz0h2apQz6HHhk85lwePS
+x+2nZiPahazFKQxckO/e29+i26Okml0z7djcloR+Jdqithuhtt4z0VazR+vf2zu1xrcNgnU6f7uNzy6g
+9m2qbevsffmyP9n/+vWP5PkmebtmQxTBm5P2kvKNtz0bbCf0WILUBlJmXp4zI0YnoMediPi6X/D7CaIf
+yyB9XwbFNze6TpnV6lYNbcbrq4Y4WAu6gdUqaIrlnVUfe/16DCO7sjfo/0cyMXozgTfj1cpd+o5bZVtS
+Sz+crU8IpzVik8SrFdiBs+Zq01b+bRufa1TeA4bdk+sO2PptJyRbPnzu/bo+0NRH1ZubDM2MsvbD/oGJ
+y5GeAKPj1cpr6ETWird0R+4/hF3l7ceVI1EjRsde7F67X8+alc+vVP8LAAD//7ylnWrkHAAA
 `,
 	},
 
@@ -24940,48 +25016,48 @@ Pg0Exj9G3PR0Lhd5Fu79+Iz8EQAA//8VMhJI5ggAAA==
 
 	"/templates/index.html": {
 		local:   "web/static/templates/index.html",
-		size:    8593,
+		size:    8633,
 		modtime: 0,
 		compressed: `
 H4sIAAAAAAAC/8xa/3LbuPH/W3mKDf2dyL5vKNpOcmkUSTM+271mJjdJz7mZdq6dGxBYkYhBgAFAyT6d
-XqMP0r/7NH2SDkBS/CE5sd3JuZmJTQC7n/2Bxe4S9OTx2bvTD399fw6pzcTs0cT9ApmEJM+nQaxMIU/y
-PJg9GkxSJGz2aDCYWG4FOpqYSzYNht85KniSMWLS1zCE/wdPEcwmkX/wTBlaAqm1eYifCr6YBn8JfzoJ
-T1WWE8tjgQFQJS1KOw3enE+RJRhA5DkFl5egUUwDkyptaWGBUyUDSDXOp0E0Jws3HnGqgkaUJBlOgwXH
-Za60baEvObPplOGCUwz94ClwyS0nIjSUCJwejQ6DRnIlxVhiOY2oMVGslDVWk3yUcTmixgSVevZaoEkR
-7WfZP34qUF+HBb8f+1xJG5IlGpXhFxD82D0NRowbEgtkT2HzOE7VAjWs3PqAFtooPQapbEiEUEtkr8sF
-Jdz83iv/Dx7zzPmTSOuX1x5ckkVMdFgqGVqVV6AZ0QmXYaysVdkYjl7lVw3XnlCJqghzZbjlSo6BxEaJ
-wmIp3Kp8DC9HL/Kr1xB9A/ulIEiRJ6mF/eeHwCUsiObOIDMSaMwBhGD4rwhqDl7C/vELR3UY+mAeWXNw
-ABEcwzdRV5XQ4pXtKi5wbsfw7A+V2gPvegc+huPNZO2go/mL41fflnMCrUUdmpxQLpMxhEdty0fWq6u0
-o3GnCvWJobBjOuRSoh6PY5wrjfVWlZE8huG///HP4Wdhz/CeuP+qcT1wTiQKz8pl0guYXHFpUb+GeiIl
-km0zlzlj1Xfj0YuNY9xPQWIUI4MC6c6tODquvV5N+wAJjzveXXKbhiUGsi6KdnEzhlroIFbaeaOaPsqv
-wCjBGexRSkuKnDBndJ+zVNcskpZJYzg6dAhEmtCg5vOWTuSKG8iJyzXls+ASn8JormhRDmogLoQ7hbI6
-AcZqdYlj2Ds8PKxmUpJjqFEy1D64qOYmP2cJmpbAL0KWqc8bfQMuKaxqIV77zenae7zL3jIWRdi2uR62
-1Oqp0Y5jEUqlMyI6Buw9oy+/fca6dFRzyykRpQjUWuku08mr58+fH3eZlkTLJpArwjnBuNr0DWEhL6Va
-yi4he4Vs/rJLKDBBydrhvcQyYGIl2OudyfCwF4RVHokFoZedhcpDx53Qc9VOhiYjQmxyeOtcHY5eYtYi
-/1kmfxtTocjl35/CzzIJN8+MWBK2J67ao1H9/BRGzQKsHgEwbnJBrsvI6tQF55ZJVBefSVQ1DpNYsWvX
-NrhEo5VTfBr43uHUahG4FVeHp4H7ub9ajS7QWi4Ts14flPWM8QVQQYyZBlUlqCoPwzkphIWtQhSAVgI9
-OU+IKzEeqQPl1CFcoi6Xdoipsma1PpjEhbVKgr3OcRqUg6DHYVWSuKbGu7ccOElCkNxspolO0E6DvYpn
-s1zJGUxMTmQNbHSopLgOZh88GjQmTSJHt5PJR0lMtOvFvgrRJCrtr4ek54dYE8k2rVpjmfMxZ9PAVV4H
-yfhi55qvysHMh0mbahKRareayR0bV7sUGtc75BscPilEi7+OJkkWjd6C+wguiQi1fIH7Q24xM8ODDZVz
-g4vm+TRQOUprWHwufc+18YRnCaA+UbM3brwxqgPxeAujEl83cgFYpYTledl3TwMPBmRBuHAEMFfa9b9z
-nhQaGbzLUX64OPvOR6FRhaZY6/WRLIihmud2vFCc7R8efEbHSSR4zzEmVcsdRm+7LNEkT53LJqR2iZ8K
-Zt+7X07KDvgeCF7luovhZoLZ+VWu0Rh/NG6DU/qmi1TOBbMfC4FwzrhV+nZghguUFLto1WQwuygfboAq
-3UekVJZY/Iz7KhKu5LC9Q43AhiCYXRRxxi2cbKZ60idRIb50AupH3wf1j4OL0k8FR7uVBV1LTQRqGzIi
-E9QtXf/sGOAHxRAqQ3f61pFuzkSVeCshsZUQW9nP/vW0ySppnF5W744o90t3pZxhNedes4LZhXuEt1xe
-9jLaYDDhMi9sle19OurnuI1Ad9Kyur6B5/NquJzTCGt2uqVA50xVg9UK/u8ng9q9y8J4CsFqRQqbjuq5
-/YP1OoD1ens3PFnl1v0D+O03sOoSpdlKIVrlTC3lrhja26LaXdUajFm7YgznBOYkLAzqYV0zYLXaGLRe
-w4TXEkpaSjTasMRyL3VumxjKaWB14a8T+KyTIptg3WiYoSyaPHyzS9qHU6iEy0gVNoCqJA9/MSjmw9lb
-lYAqbP+0dnA7joUnT8AL+hMx71Fn3Geh/eEPRJIE4YOn7aaGkj+YVSQnhU0rui2xrYM6uOmw1N5vncDh
-ppL0wuLJE3jcjYvb7GArJtfrvhr9uPovwmnWD49PBRqXvkLKNXVljYQiuTFSfqfo6pPUreIPhEs4U7TI
-UNZZtxdBjW9Sa3MzjqLyikLpJCoMSXATj8EvsSAuS/zkpmEDMujbiFfWbY0I/eVR+x3hszbfSTXcVFez
-rWBTeuEtkUnx8OoynPvrvZ3qnjWLsO9K/amS84MH1thcG4vZL3XHVlbxLdUvPBWctqkeWHGS8209T96/
-gXPJ/D2ReWAFNZbt7o5IeGdT1PCjI6Bo4C039uvremP2aGnj1L2FkQm3aRGPqMpKe8NMSde0lqNte282
-rkS60ZCGsbz3f6/VR6QWlITvPefDbbERhF56F9zFXs91C3MvHB2cpsTCj0plD5wjnDJv5IJb/Hq2lvj6
-ISw1ltBLtUA9F2rpt7Su/iayJEmQ3T2wPWZYg97GC44B3lUMUMb7B5L8bziEtZuL0huRVTmn5vd3ypmi
-BohkcH5Fslyg+bouuqERbqabW6HNU/Nw891fe0mr5Y4LQapEKJLw6Ni/MS04Lm8hsLxRAaNp8ynvY/0h
-0H/D+2j8vZqn+zJLWPBbcxSSoTZUaQzvIsj1boLo0T14Qq0Ki/fiNMQ1Y7/ek9l/kr0fl83F3WRSdP/v
-LO0uPN3PzLflypTLCfdgCVnVRIZzpTNyNwj2bLR4do9Ibn+bvBP7H7nAC7K4IxcVPI8V0exOXDK5H19Z
-rfvUq9XojaSiYGjW60eDSRQrdj17NInKP8D4TwAAAP//GXbiO5EhAAA=
+XqMP0r/7NH2SDkBS/CE5sd3JucnExo/dz/7AYndJZvL47N3ph7++P4fUZmL2aOJ+gUxCkufTIFamkCd5
+HsweDSYpEjZ7NBhMLLcCHU3MJZsGw+8cFTzJGDHpaxjC/4OnCGaTyA88U4aWQGptHuKngi+mwV/Cn07C
+U5XlxPJYYABUSYvSToM351NkCQYQeU7B5SVoFNPApEpbWljgVMkAUo3zaRDNycLNR5yqoBElSYbTYMFx
+mSttW+hLzmw6ZbjgFEM/eQpccsuJCA0lAqdHo8OgkVxJMZZYTiNqTBQrZY3VJB9lXI6oMUGlnr0WaFJE
++1n2j58K1Ndhwe/HPlfShmSJRmX4BQQ/d6PBiHFDYoHsKWyG41QtUMPK7Q9ooY3SY5DKhkQItUT2utxQ
+wq3vvfJ/4DHPnD+JtH577cElWcREh6WSoVV5BZoRnXAZxspalY3h6FV+1XDtCZWoijBXhluu5BhIbJQo
+LJbCrcrH8HL0Ir96DdE3sF8KghR5klrYf34IXMKCaO4MMiOBxhxACIb/iqDm4CXsH79wVIehD+aRNQcH
+EMExfBN1VQktXtmu4gLndgzP/lCpPfCud+BjON4s1g46mr84fvVtuSbQWtShyQnlMhlDeNS2fGS9uko7
+GnerUJ8YCjuWQy4l6vE4xrnSWB9VGcljGP77H/8cfhb2DO+J+68a1wPnRKLwrFwmvYDJFZcW9WuoF1Ii
+2TZzmTNWfTcevdg4xv0UJEYxMiiQ7jyKo+Pa69WyD5DwuOPdJbdpWGIg66JoFzdjqIUOYqWdN6rlo/wK
+jBKcwR6ltKTICXNG9zlLdc0iaZk0hqNDh0CkCQ1qPm/pRK64gZy4XFOOBZf4FEZzRYtyUgNxIdwtlNUN
+MFarSxzD3uHhYbWSkhxDjZKh9sFFNTf5OUvQtAR+EbJMfd7oG3BJYVUL8dofTtfe4132lrEowrbN9bSl
+Vk+NdhyLUCqdEdExYO8ZffntM9alo5pbTokoRaDWSneZTl49f/78uMu0JFo2gVwRzgnG1aFvCAt5KdVS
+dgnZK2Tzl311GbGkS4cv3N8uncAEJWtfgyWWgRUrwV7vTJqHvWCt8k0sCL3sbFSePO6EqKuKMjQZEWKT
+61v373D0ErMW+c8y+duYCkUu//4UfpZJuBk7A8P2wlV7NqrHT2HUbMDqEQDjJhfkuozATv1wbplEdZGa
+RFWDMYkVu3bthUtIWjnFp4HvMU6tFoHbcfV6Grif+6vV6AKt5TIx6/VBWfcYXwAVxJhpUFWMqkIxnJNC
+WNgqWAFoJdCT84S4UuSROlBOHcIl6nJrh5gqu1b7g0lcWKsk2Oscp0E5CXocViWJa368e8uJkyQEyc1m
+megE7TTYq3g225WcwcTkRNbARodKiutg9sGjQWPSJHJ0O5l8lMREu57tqxBNotL+ekp6fog1kWzT0jWW
+OR9zNg1chXaQjC927vnqHcx8mLSpJhGpTqtZ3HFwtUuhcb1DvsHhk0K0+OtokmTR6C24j+CSiFDLF7g/
+5BYzMzzYUDk3uGieTwOVo7SGxefS92YbT3iWAOobNXvj5hujOhCPtzAq8XXDF4BVSliel/35NPBgQBaE
+C0cAc6VdnzznSaGRwbsc5YeLs+98FBpVaIq1Xh/JghiqeW7HC8XZ/uHBZ3ScRIL3HGNStdxh9LbLEk3y
+1LlsQmqX+KVg9r375aTsgO+B4FWuuxhuJZidX+UajfFX4zY4pW+6SOVaMPuxEAjnjFulbwdmuEBJsYtW
+LQazi3JwA1TpPiKlssTiZ9xXkXAlh+0TagQ2BMHsoogzbuFks9STPokK8aUbUA99v9S/Di5KPxUc7VYW
+dK03EahtyIhMULd0/bNjgB8UQ6gM3elbR7q5E1XirYTEVkJsZT/718smq6Rxelk9Y6LcL92VcobVmnsc
+C2YXbghvubzsZbTBYMJlXtgq2/t01M9xG4HupmV1fQPP59VwOacR1px0S4HOnaomqxX8308GtXvmhfEU
+gtWKFDYd1Wv7B+t1AOv19ml4ssqt+wfw229g1SVKs5VCtMqZWspdMbS3RbW7qjUYs3bFGM4JzElYGNTD
+umbAarUxaL2GCa8llLSUaLRhieUe/twxMZTTwOrCv3bgs06KbIJ1o2GGsmjy8M0uaV9OoRIuI1XYAKqS
+PPzFoJgPZ29VAqqw/dvawe04Fp48AS/oT8S8R51xn4X2hz8QSRKED562mxpK/mBWkZwUNq3otsS2Lurg
+pstSe791A4ebStILiydP4HE3Lm5zgq2YXK/7avTj6r8Ip1k/PD4VaFz6CinX1JU1Eorkxkj5naKrT1K3
+ij8QLuFM0SJDWWfdXgQ1vkmtzc04ispXGUonUWFIgpt4DH6JBXFZ4ie3DBuQQd9GvLLuaEToXzK1nxE+
+a/OdVMNNdTXbCjalF94SmRQPry7DuX8NuFPds2YT9l2pP1VyfvDAGptrYzH7pe7Yyiq+pfqFp4LTNtUD
+K05yvq3nyfs3cC6Zf59kHlhBjWW7uyMS3tkUNfzoCCgaeMuN/fq63pg9Wto4dW9hZMJtWsQjqrLS3jBT
+0jWt5Wzb3puNK5FuNKRhLL8PvNfqI1ILSsL3nvPhjtgIQi+9C+5ir+e6hbkXjg5OU2LhR6WyB84RTpk3
+csEtfj1bS3z9EJYaS+ilWqCeC7X0R1pXfxNZkiTI7h7YHjOsQW/jBccA7yoGKOP9A0n+NxzC2s1F6Y3I
+qpxT8/s75UxRA0QyOL8iWS7QfF0X3dAIN8vNW6HNqBnc/O6vvaXVcscLQapEKJLw6Ng/MS04Lm8hsHyj
+AkbT5pPfx/qDof/W99H492qe7sssYcFvzVFIhtpQpTG8iyDXuwmiR/fgCbUqLN6L0xDXjP16T2b/6fZ+
+XDYXd5NJ0f27s7S78HQ/R9+WK1MuJ9yDJWRVExnOlc7I3SDYs9Hi2T0iuf0N807sf+QCL8jijlxU8DxW
+RLM7ccnkfnxlte5Tr1ajN5KKgqFZrx8NJlGs2PXs0SQq/6PGfwIAAP//5LcZbrkhAAA=
 `,
 	},
 
@@ -52,6 +52,11 @@ bosunApp.config(['$routeProvider', '$locationProvider', '$httpProvider', functio
         templateUrl: 'partials/errors.html',
         controller: 'ErrorCtrl',
     })
+    when('/alerthealth', {
+        title: 'Alert Health',
+        templateUrl: 'partials/alerthealth.html',
+        controller: 'AlertHealthCtrl',
+    })
     when('/graph', {
         title: 'Graph',
         templateUrl: 'partials/graph.html',
@@ -160,6 +165,7 @@ interface IBosunScope extends RootScope {
     btoa: (v: any) => string;
     encode: (v: string) => string;
     panelClass: (v: string) => string;
+    statusLabel: (status: string, severity: Severity) => string;
     timeanddate: number[];
     req_from_m: (m: string) => GraphRequest;
     schedule: StateGroups;
@@ -224,12 +230,21 @@ bosunControllers.controller('BosunCtrl', ['$scope', '$route', '$http', '$q', '$r
             switch (status) {
                 case "critical": return prefix + "danger";
                 case "unknown": return prefix + "info";
+                case "nodata": return prefix + "default";
                 case "warning": return prefix + "warning";
                 case "normal": return prefix + "success";
                 case "error": return prefix + "danger";
                 default: return prefix + "default";
             }
         };
+        // statusLabel is the name of the severity level of warning and critical
+        // statuses of alerts that declare their own levels, see Status.Label.
+        $scope.statusLabel = (status: string, severity: Severity) => {
+            if (severity && (status == "warning" || status == "critical")) {
+                return severity.Name;
+            }
+            return status;
+        };
         $scope.values = {};
         $scope.setKey = (key: string, value: any) => {
             if (value === undefined) {
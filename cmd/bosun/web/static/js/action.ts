@@ -7,12 +7,17 @@ interface IActionScope extends IBosunScope {
 	message: string;
 	notify: boolean;
 	keys: string[];
+	problems: string[];
 	submit: () => void;
 	validateMsg: () => void;
 	msgValid: boolean;
 	activeIncidents: boolean;
 	duration: string;
 	durationValid: boolean;
+	owner: string;
+	team: string;
+	labels: string;
+	fields: string;
 }
 
 bosunControllers.controller('ActionCtrl', ['$scope', '$http', '$location', '$route', function ($scope: IActionScope, $http: ng.IHttpService, $location: ng.ILocationService, $route: ng.route.IRouteService) {
@@ -23,6 +28,10 @@ bosunControllers.controller('ActionCtrl', ['$scope', '$http', '$location', '$rou
 	$scope.msgValid = true;
 	$scope.message = "";
 	$scope.duration = "";
+	$scope.owner = "";
+	$scope.team = "";
+	$scope.labels = "";
+	$scope.fields = "";
 	$scope.validateMsg = () => {
 		$scope.msgValid = (!$scope.notify) || ($scope.message != "");
 	}
@@ -38,8 +47,18 @@ bosunControllers.controller('ActionCtrl', ['$scope', '$http', '$location', '$rou
 		}
 		$location.search('key', null);
 		$scope.setKey('action-keys', keys);
+		$scope.setKey('action-problems', undefined);
+	} else if (search.problem) {
+		var problems = search.problem;
+		if (!angular.isArray(search.problem)) {
+			problems = [search.problem];
+		}
+		$location.search('problem', null);
+		$scope.setKey('action-problems', problems);
+		$scope.setKey('action-keys', undefined);
 	} else {
 		$scope.keys = $scope.getKey('action-keys');
+		$scope.problems = $scope.getKey('action-problems');
 	}
 	$scope.submit = () => {
 		$scope.validateMsg();
@@ -51,9 +70,27 @@ bosunControllers.controller('ActionCtrl', ['$scope', '$http', '$location', '$rou
 			Type: $scope.type,
 			Message: $scope.message,
 			Keys: $scope.keys,
+			Problems: $scope.problems,
 			Notify: $scope.notify,
 		};
-		if ($scope.duration != "") {
+		if ($scope.type == 'assign') {
+			data['Owner'] = $scope.owner;
+			data['Team'] = $scope.team;
+		}
+		if ($scope.type == 'label') {
+			data['Labels'] = _.filter($scope.labels.split(/[\s,]+/), (l) => l != "");
+			var fields = {};
+			_.each($scope.fields.split("\n"), (line: string) => {
+				var i = line.indexOf("=");
+				if (i > 0) {
+					fields[line.substr(0, i).trim()] = line.substr(i + 1).trim();
+				}
+			});
+			data['Fields'] = fields;
+		}
+		if ($scope.duration != "" && $scope.type == 'ack') {
+			data['AckFor'] = $scope.duration;
+		} else if ($scope.duration != "") {
 			data['Time'] = moment.utc().add(parseDuration($scope.duration));
 		}
 		$http.post('/api/action', data)
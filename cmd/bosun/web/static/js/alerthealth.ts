@@ -0,0 +1,53 @@
+interface IAlertHealthScope extends IBosunScope {
+	health: any[];
+	error: string;
+	loading: boolean;
+	order: string;
+	reverse: boolean;
+	setOrder: (order: string) => void;
+	toggle: (h: any) => void;
+	seconds: (d: number) => string;
+}
+
+bosunControllers.controller('AlertHealthCtrl', ['$scope', '$http', function($scope: IAlertHealthScope, $http: ng.IHttpService) {
+	$scope.loading = true;
+	$scope.order = 'AvgDuration';
+	$scope.reverse = true;
+	$http.get('/api/alerts/health')
+		.success((data: any) => {
+			$scope.health = data;
+		})
+		.error(function(data) {
+			$scope.error = "Error fetching data: " + data;
+		})
+		.finally(() => { $scope.loading = false; });
+
+	$scope.setOrder = (order: string) => {
+		if ($scope.order == order) {
+			$scope.reverse = !$scope.reverse;
+			return;
+		}
+		$scope.order = order;
+		$scope.reverse = order != 'Alert';
+	};
+
+	// toggle shows or hides the recent runs of an alert
+	$scope.toggle = (h: any) => {
+		h.Shown = !h.Shown;
+		if (!h.Shown || h.RecentRuns) {
+			return;
+		}
+		$http.get('/api/alerts/' + encodeURIComponent(h.Alert) + '/runs?count=20')
+			.success((data: any) => {
+				h.RecentRuns = data;
+			})
+			.error(function(data) {
+				$scope.error = "Error fetching runs: " + data;
+			});
+	};
+
+	// seconds formats a duration in nanoseconds
+	$scope.seconds = (d: number) => {
+		return (d / 1e9).toFixed(2) + 's';
+	};
+}]);
@@ -27,6 +27,7 @@ var IncidentEvent = /** @class */ (function () {
         this.Value = ie.Value;
         this.Expr = ie.Expr;
         this.Status = ie.Status;
+        this.Severity = ie.Severity;
         this.Time = ie.Time;
         this.Unevaluated = ie.Unevaluated;
     }
@@ -110,6 +111,9 @@ var IncidentState = /** @class */ (function () {
         this.WorstStatus = is.WorstStatus;
         this.LastAbnormalStatus = is.LastAbnormalStatus;
         this.LastAbnormalTime = is.LastAbnormalTime;
+        this.CurrentSeverity = is.CurrentSeverity;
+        this.WorstSeverity = is.WorstSeverity;
+        this.LastAbnormalSeverity = is.LastAbnormalSeverity;
         this.PreviousIds = new Array();
         if (is.PreviousIds) {
             for (var _d = 0, _e = is.PreviousIds; _d < _e.length; _d++) {
@@ -122,7 +126,7 @@ var IncidentState = /** @class */ (function () {
     IncidentState.prototype.IsPendingClose = function () {
         for (var _i = 0, _a = this.Actions; _i < _a.length; _i++) {
             var action = _a[_i];
-            if (action.Deadline != undefined && !(action.Fullfilled || action.Cancelled)) {
+            if (action.Type == "DelayedClose" && !(action.Fullfilled || action.Cancelled)) {
                 return true;
             }
         }
@@ -135,6 +139,7 @@ var StateGroup = /** @class */ (function () {
         this.Active = sg.Active;
         this.Status = sg.Status;
         this.CurrentStatus = sg.CurrentStatus;
+        this.Severity = sg.Severity;
         this.Silenced = sg.Silenced;
         this.IsError = sg.IsError;
         this.Subject = sg.Subject;
@@ -226,6 +231,11 @@ bosunApp.config(['$routeProvider', '$locationProvider', '$httpProvider', functio
             templateUrl: 'partials/errors.html',
             controller: 'ErrorCtrl',
         });
+        when('/alerthealth', {
+            title: 'Alert Health',
+            templateUrl: 'partials/alerthealth.html',
+            controller: 'AlertHealthCtrl',
+        });
         when('/graph', {
             title: 'Graph',
             templateUrl: 'partials/graph.html',
@@ -346,12 +356,19 @@ bosunControllers.controller('BosunCtrl', ['$scope', '$route', '$http', '$q', '$r
             switch (status) {
                 case "critical": return prefix + "danger";
                 case "unknown": return prefix + "info";
+                case "nodata": return prefix + "default";
                 case "warning": return prefix + "warning";
                 case "normal": return prefix + "success";
                 case "error": return prefix + "danger";
                 default: return prefix + "default";
             }
         };
+        $scope.statusLabel = function (status, severity) {
+            if (severity && (status == "warning" || status == "critical")) {
+                return severity.Name;
+            }
+            return status;
+        };
         $scope.values = {};
         $scope.setKey = function (key, value) {
             if (value === undefined) {
@@ -710,6 +727,10 @@ bosunControllers.controller('ActionCtrl', ['$scope', '$http', '$location', '$rou
         $scope.msgValid = true;
         $scope.message = "";
         $scope.duration = "";
+        $scope.owner = "";
+        $scope.team = "";
+        $scope.labels = "";
+        $scope.fields = "";
         $scope.validateMsg = function () {
             $scope.msgValid = (!$scope.notify) || ($scope.message != "");
         };
@@ -724,9 +745,20 @@ bosunControllers.controller('ActionCtrl', ['$scope', '$http', '$location', '$rou
             }
             $location.search('key', null);
             $scope.setKey('action-keys', keys);
+            $scope.setKey('action-problems', undefined);
+        }
+        else if (search.problem) {
+            var problems = search.problem;
+            if (!angular.isArray(search.problem)) {
+                problems = [search.problem];
+            }
+            $location.search('problem', null);
+            $scope.setKey('action-problems', problems);
+            $scope.setKey('action-keys', undefined);
         }
         else {
             $scope.keys = $scope.getKey('action-keys');
+            $scope.problems = $scope.getKey('action-problems');
         }
         $scope.submit = function () {
             $scope.validateMsg();
@@ -738,9 +770,28 @@ bosunControllers.controller('ActionCtrl', ['$scope', '$http', '$location', '$rou
                 Type: $scope.type,
                 Message: $scope.message,
                 Keys: $scope.keys,
+                Problems: $scope.problems,
                 Notify: $scope.notify,
             };
-            if ($scope.duration != "") {
+            if ($scope.type == 'assign') {
+                data['Owner'] = $scope.owner;
+                data['Team'] = $scope.team;
+            }
+            if ($scope.type == 'label') {
+                data['Labels'] = _.filter($scope.labels.split(/[\s,]+/), function (l) { return l != ""; });
+                var fields = {};
+                _.each($scope.fields.split("\n"), function (line) {
+                    var i = line.indexOf("=");
+                    if (i > 0) {
+                        fields[line.substr(0, i).trim()] = line.substr(i + 1).trim();
+                    }
+                });
+                data['Fields'] = fields;
+            }
+            if ($scope.duration != "" && $scope.type == 'ack') {
+                data['AckFor'] = $scope.duration;
+            }
+            else if ($scope.duration != "") {
                 data['Time'] = moment.utc().add(parseDuration($scope.duration));
             }
             $http.post('/api/action', data)
@@ -753,6 +804,45 @@ bosunControllers.controller('ActionCtrl', ['$scope', '$http', '$location', '$rou
         };
     }]);
 /// <reference path="0-bosun.ts" />
+bosunControllers.controller('AlertHealthCtrl', ['$scope', '$http', function ($scope, $http) {
+        $scope.loading = true;
+        $scope.order = 'AvgDuration';
+        $scope.reverse = true;
+        $http.get('/api/alerts/health')
+            .success(function (data) {
+            $scope.health = data;
+        })
+            .error(function (data) {
+            $scope.error = "Error fetching data: " + data;
+        })
+            .finally(function () { $scope.loading = false; });
+        $scope.setOrder = function (order) {
+            if ($scope.order == order) {
+                $scope.reverse = !$scope.reverse;
+                return;
+            }
+            $scope.order = order;
+            $scope.reverse = order != 'Alert';
+        };
+        // toggle shows or hides the recent runs of an alert
+        $scope.toggle = function (h) {
+            h.Shown = !h.Shown;
+            if (!h.Shown || h.RecentRuns) {
+                return;
+            }
+            $http.get('/api/alerts/' + encodeURIComponent(h.Alert) + '/runs?count=20')
+                .success(function (data) {
+                h.RecentRuns = data;
+            })
+                .error(function (data) {
+                $scope.error = "Error fetching runs: " + data;
+            });
+        };
+        // seconds formats a duration in nanoseconds
+        $scope.seconds = function (d) {
+            return (d / 1e9).toFixed(2) + 's';
+        };
+    }]);
 bosunControllers.controller('AnnotationCtrl', ['$scope', '$http', '$location', '$route', function ($scope, $http, $location, $route) {
         var search = $location.search();
         $scope.id = search.id;
@@ -1444,6 +1534,13 @@ bosunControllers.controller('DashboardCtrl', ['$scope', '$http', '$location', fu
                 $scope.loading = '';
                 $scope.error = 'Unable to fetch alerts: ' + err;
             });
+            $http.get('/api/problems')
+                .success(function (data) {
+                $scope.problems = data;
+            })
+                .error(function (err) {
+                $scope.error = 'Unable to fetch problems: ' + err;
+            });
         }
         $scope.keydown = function ($event) {
             if ($event.keyCode == 13) {
@@ -3323,6 +3420,19 @@ bosunControllers.controller('IncidentCtrl', ['$scope', '$http', '$location', '$r
             var key = encodeURIComponent($scope.state.AlertKey);
             return '/action?type=' + type + '&key=' + key;
         };
+        $scope.describeEdit = function (e) {
+            var parts = [];
+            if (e.Owner || e.Team) {
+                parts.push('assigned to ' + _.filter([e.Owner, e.Team], function (o) { return !!o; }).join(' / '));
+            }
+            if (e.Labels) {
+                parts.push('labels ' + e.Labels.join(' '));
+            }
+            _.each(e.Fields, function (v, k) {
+                parts.push(k + '=' + v);
+            });
+            return parts.length ? parts.join(', ') : 'unassigned';
+        };
         $scope.getEditSilenceLink = function () {
             return linkService.GetEditSilenceLink($scope.silence, $scope.silenceId);
         };
@@ -3363,6 +3473,10 @@ bosunControllers.controller('IncidentCtrl', ['$scope', '$http', '$location', '$r
             var m = moment(v).utc();
             return m.format();
         };
+        $http.get('/api/incidents/deliveries?id=' + id)
+            .success(function (data) {
+            $scope.deliveries = data;
+        });
         $http.get('/api/incidents/events?id=' + id)
             .success(function (data) {
             $scope.incident = data;
@@ -3377,7 +3491,7 @@ bosunControllers.controller('IncidentCtrl', ['$scope', '$http', '$location', '$r
             $scope.editSilenceLink = linkService.GetEditSilenceLink($scope.silence, $scope.silenceId);
             for (var i = 0; i < $scope.events.length; i++) {
                 var e = $scope.events[i];
-                if (e.Status != 'normal' && e.Status != 'unknown' && $scope.body) {
+                if (e.Status != 'normal' && e.Status != 'unknown' && e.Status != 'nodata' && $scope.body) {
                     $scope.lastNonUnknownAbnormalIdx = i;
                     $scope.collapse(i, e); // Expand the panel of the current body
                     break;
@@ -3415,11 +3529,26 @@ var LinkService = /** @class */ (function () {
             return "";
         }
         var forget = silence.Forget ? '&forget' : '';
+        var scope = '';
+        if (silence.AlertRegex) {
+            scope += "&alertRegex=" + encodeURIComponent(silence.AlertRegex);
+        }
+        if (silence.Notifications) {
+            scope += "&notifications=" + encodeURIComponent(silence.Notifications.join(','));
+        }
+        var recurrence = '';
+        if (silence.Recurrence) {
+            recurrence = "&recurrence=" + encodeURIComponent(silence.Recurrence) +
+                "&timeZone=" + encodeURIComponent(silence.TimeZone) +
+                "&length=" + (silence.Length / 1e9) + "s";
+        }
         return "/silence?start=" + this.time(silence.Start) +
             "&end=" + this.time(silence.End) +
             "&alert=" + silence.Alert +
             "&tags=" + encodeURIComponent(silence.TagString) +
             forget +
+            scope +
+            recurrence +
             "&edit=" + silenceId;
     };
     LinkService.prototype.time = function (v) {
@@ -3522,11 +3651,16 @@ bosunControllers.controller('SilenceCtrl', ['$scope', '$http', '$location', '$ro
         $scope.end = search.end;
         $scope.duration = search.duration;
         $scope.alert = search.alert;
+        $scope.alertRegex = search.alertRegex;
+        $scope.notifications = search.notifications;
         $scope.hosts = search.hosts;
         $scope.tags = search.tags;
         $scope.edit = search.edit;
         $scope.forget = search.forget;
         $scope.message = search.message;
+        $scope.recurrence = search.recurrence;
+        $scope.timeZone = search.timeZone;
+        $scope.length = search.length;
         if (!$scope.end && !$scope.duration) {
             $scope.duration = '1h';
         }
@@ -3537,6 +3671,9 @@ bosunControllers.controller('SilenceCtrl', ['$scope', '$http', '$location', '$ro
                 if (limit && count >= limit) {
                     return;
                 }
+                if (v.Recurrence) {
+                    return;
+                }
                 var s = moment(v.Start).utc();
                 var e = moment(v.End).utc();
                 if (startBefore && s > startBefore) {
@@ -3572,6 +3709,23 @@ bosunControllers.controller('SilenceCtrl', ['$scope', '$http', '$location', '$ro
                     name: 'Past',
                     silences: filter(data, null, null, null, now, 25)
                 });
+                var recurring = {};
+                _.each(data, function (v, name) {
+                    if (v.Recurrence) {
+                        recurring[name] = v;
+                    }
+                });
+                $scope.silences.push({
+                    name: 'Recurring',
+                    silences: recurring
+                });
+            })
+                .error(function (error) {
+                $scope.error = error;
+            });
+            $http.get('/api/silence/upcoming')
+                .success(function (data) {
+                $scope.upcoming = data;
             })
                 .error(function (error) {
                 $scope.error = error;
@@ -3589,14 +3743,19 @@ bosunControllers.controller('SilenceCtrl', ['$scope', '$http', '$location', '$ro
                 end: $scope.end,
                 duration: $scope.duration,
                 alert: $scope.alert,
+                alertRegex: $scope.alertRegex,
+                notifications: $scope.notifications,
                 tags: tags.join(','),
                 edit: $scope.edit,
                 forget: $scope.forget ? 'true' : null,
                 message: $scope.message,
+                recurrence: $scope.recurrence,
+                timeZone: $scope.timeZone,
+                length: $scope.length,
             };
             return data;
         }
-        var any = search.start || search.end || search.duration || search.alert || search.hosts || search.tags || search.forget;
+        var any = search.start || search.end || search.duration || search.alert || search.alertRegex || search.hosts || search.tags || search.forget || search.recurrence;
         var state = getData();
         $scope.change = function () {
             $scope.disableConfirm = true;
@@ -3619,10 +3778,15 @@ bosunControllers.controller('SilenceCtrl', ['$scope', '$http', '$location', '$ro
             $location.search('end', $scope.end || null);
             $location.search('duration', $scope.duration || null);
             $location.search('alert', $scope.alert || null);
+            $location.search('alertRegex', $scope.alertRegex || null);
+            $location.search('notifications', $scope.notifications || null);
             $location.search('hosts', $scope.hosts || null);
             $location.search('tags', $scope.tags || null);
             $location.search('forget', $scope.forget || null);
             $location.search('message', $scope.message || null);
+            $location.search('recurrence', $scope.recurrence || null);
+            $location.search('timeZone', $scope.timeZone || null);
+            $location.search('length', $scope.length || null);
             $route.reload();
         };
         $scope.confirm = function () {
@@ -3668,6 +3832,7 @@ bosunApp.directive('tsAckGroup', ['$location', '$timeout', function ($location,
             link: function (scope, elem, attrs) {
                 scope.canAckSelected = scope.ack == 'Needs Acknowledgement';
                 scope.panelClass = scope.$parent.panelClass;
+                scope.statusLabel = scope.$parent.statusLabel;
                 scope.btoa = scope.$parent.btoa;
                 scope.encode = scope.$parent.encode;
                 scope.shown = {};
@@ -3714,7 +3879,7 @@ bosunApp.directive('tsAckGroup', ['$location', '$timeout', function ($location,
                         if (g.Status == 'error') {
                             scope.canCloseSelected = false;
                         }
-                        if (g.Status != 'unknown') {
+                        if (g.Status != 'unknown' && g.Status != 'nodata') {
                             scope.canForgetSelected = false;
                         }
                     }
@@ -3,6 +3,7 @@ interface IDashboardScope extends IBosunScope {
 	loading: string;
 	filter: string;
 	keydown: any;
+	problems: any[];
 }
 
 bosunControllers.controller('DashboardCtrl', ['$scope', '$http', '$location', function($scope: IDashboardScope, $http: ng.IHttpService, $location: ng.ILocationService) {
@@ -23,6 +24,13 @@ bosunControllers.controller('DashboardCtrl', ['$scope', '$http', '$location', fu
 				$scope.loading = '';
 				$scope.error = 'Unable to fetch alerts: ' + err;
 			});
+		$http.get('/api/problems')
+			.success((data: any) => {
+				$scope.problems = data;
+			})
+			.error((err: any) => {
+				$scope.error = 'Unable to fetch problems: ' + err;
+			});
 	}
 	$scope.keydown = function($event: any) {
 		if ($event.keyCode == 13) {
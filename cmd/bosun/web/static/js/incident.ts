@@ -3,6 +3,7 @@ interface IIncidentScope extends ng.IScope {
 	incident: IncidentState;
 	events: any;
 	actions: any;
+	deliveries: any;
 	body: any;
 	shown: any;
 	collapse: any;
@@ -17,6 +18,7 @@ interface IIncidentScope extends ng.IScope {
 	silenceId: string;
 	editSilenceLink: string;
 	time: (v: any) => string;
+	describeEdit: (e: any) => string;
 }
 
 bosunControllers.controller('IncidentCtrl', ['$scope', '$http', '$location', '$route', '$sce', 'linkService', function ($scope: IIncidentScope, $http: ng.IHttpService, $location: ng.ILocationService, $route: ng.route.IRouteService, $sce: ng.ISCEService, linkService: ILinkService) {
@@ -34,6 +36,19 @@ bosunControllers.controller('IncidentCtrl', ['$scope', '$http', '$location', '$r
 		var key = encodeURIComponent($scope.state.AlertKey);
 		return '/action?type=' + type + '&key=' + key;
 	};
+	$scope.describeEdit = (e: any) => {
+		var parts = [];
+		if (e.Owner || e.Team) {
+			parts.push('assigned to ' + _.filter([e.Owner, e.Team], (o) => !!o).join(' / '));
+		}
+		if (e.Labels) {
+			parts.push('labels ' + e.Labels.join(' '));
+		}
+		_.each(e.Fields, (v, k) => {
+			parts.push(k + '=' + v);
+		});
+		return parts.length ? parts.join(', ') : 'unassigned';
+	};
 	$scope.getEditSilenceLink = () => {
 		return linkService.GetEditSilenceLink($scope.silence, $scope.silenceId);
 	};
@@ -72,6 +87,10 @@ bosunControllers.controller('IncidentCtrl', ['$scope', '$http', '$location', '$r
 		var m = moment(v).utc();
 		return m.format();
 	};
+	$http.get('/api/incidents/deliveries?id=' + id)
+		.success((data: any) => {
+			$scope.deliveries = data;
+		});
 	$http.get('/api/incidents/events?id=' + id)
 		.success((data: any) => {
 			$scope.incident = data;
@@ -86,7 +105,7 @@ bosunControllers.controller('IncidentCtrl', ['$scope', '$http', '$location', '$r
 			$scope.editSilenceLink = linkService.GetEditSilenceLink($scope.silence, $scope.silenceId);
 			for (var i = 0; i < $scope.events.length; i++) {
 				var e = $scope.events[i];
-				if (e.Status != 'normal' && e.Status != 'unknown' && $scope.body) {
+				if (e.Status != 'normal' && e.Status != 'unknown' && e.Status != 'nodata' && $scope.body) {
 					$scope.lastNonUnknownAbnormalIdx = i;
 					$scope.collapse(i, e); // Expand the panel of the current body
 					break;
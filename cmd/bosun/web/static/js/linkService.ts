@@ -7,11 +7,26 @@ class LinkService implements ILinkService {
 		}
 
 		var forget = silence.Forget ? '&forget': '';
+		var scope = '';
+		if (silence.AlertRegex) {
+			scope += "&alertRegex=" + encodeURIComponent(silence.AlertRegex);
+		}
+		if (silence.Notifications) {
+			scope += "&notifications=" + encodeURIComponent(silence.Notifications.join(','));
+		}
+		var recurrence = '';
+		if (silence.Recurrence) {
+			recurrence = "&recurrence=" + encodeURIComponent(silence.Recurrence) +
+				"&timeZone=" + encodeURIComponent(silence.TimeZone) +
+				"&length=" + (silence.Length / 1e9) + "s";
+		}
 		return "/silence?start=" + this.time(silence.Start) +
 			"&end=" + this.time(silence.End) +
 			"&alert=" + silence.Alert +
 			"&tags=" + encodeURIComponent(silence.TagString) +
 			forget +
+			scope +
+			recurrence +
 			"&edit=" + silenceId;
 	}
 
@@ -27,12 +27,19 @@ class RoleDefs {
     public Roles: Array<BitMeta>;
 }
 
+// See models/incidents.go Severity, the custom severity level of an alert
+interface Severity {
+    Name: string;
+    Rank: number;
+}
+
 // See models/incident.go Event (can't be event here because JS uses that)
 class IncidentEvent {
     // Embedded properties of Result struct
     Value: number;
     Expr: string;
     Status: number;
+    Severity: Severity;
     Time: string; // moment?
     Unevaluated: boolean;
 
@@ -40,6 +47,7 @@ class IncidentEvent {
         this.Value = ie.Value;
         this.Expr = ie.Expr;
         this.Status = ie.Status;
+        this.Severity = ie.Severity;
         this.Time = ie.Time;
         this.Unevaluated = ie.Unevaluated;
     }
@@ -141,6 +149,10 @@ class IncidentState {
     LastAbnormalStatus: string;
     LastAbnormalTime: number; // Epoch
 
+    CurrentSeverity: Severity;
+    WorstSeverity: Severity;
+    LastAbnormalSeverity: Severity;
+
     PreviousIds: number[];
     NextId: number;
 
@@ -174,6 +186,9 @@ class IncidentState {
         this.WorstStatus = is.WorstStatus;
         this.LastAbnormalStatus = is.LastAbnormalStatus;
         this.LastAbnormalTime = is.LastAbnormalTime;
+        this.CurrentSeverity = is.CurrentSeverity;
+        this.WorstSeverity = is.WorstSeverity;
+        this.LastAbnormalSeverity = is.LastAbnormalSeverity;
         this.PreviousIds = new Array<number>();
         if (is.PreviousIds) {
             for (let id of is.PreviousIds) {
@@ -186,7 +201,7 @@ class IncidentState {
 
     IsPendingClose(): boolean {
         for (let action of this.Actions) {
-            if (action.Deadline != undefined && !(action.Fullfilled || action.Cancelled)) {
+            if (action.Type == "DelayedClose" && !(action.Fullfilled || action.Cancelled)) {
                 return true;
             }
         }
@@ -198,6 +213,7 @@ class StateGroup {
     Active: boolean;
     Status: string;
     CurrentStatus: string;
+    Severity: Severity;
     Silenced: boolean;
     IsError: boolean;
     Subject: string;
@@ -211,6 +227,7 @@ class StateGroup {
         this.Active = sg.Active;
         this.Status = sg.Status;
         this.CurrentStatus = sg.CurrentStatus;
+        this.Severity = sg.Severity;
         this.Silenced = sg.Silenced;
         this.IsError = sg.IsError;
         this.Subject = sg.Subject;
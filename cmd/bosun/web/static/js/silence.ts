@@ -7,6 +7,8 @@ interface ISilenceScope extends ng.IScope {
 	end: string;
 	duration: string;
 	alert: string;
+	alertRegex: string;
+	notifications: string;
 	hosts: string;
 	tags: string;
 	edit: string;
@@ -20,6 +22,10 @@ interface ISilenceScope extends ng.IScope {
 	forget: string;
 	user: string;
 	message: string;
+	recurrence: string;
+	timeZone: string;
+	length: string;
+	upcoming: any[];
 	getEditSilenceLink: (silence: any, silenceId: string) => string;
 }
 
@@ -29,11 +35,16 @@ bosunControllers.controller('SilenceCtrl', ['$scope', '$http', '$location', '$ro
 	$scope.end = search.end;
 	$scope.duration = search.duration;
 	$scope.alert = search.alert;
+	$scope.alertRegex = search.alertRegex;
+	$scope.notifications = search.notifications;
 	$scope.hosts = search.hosts;
 	$scope.tags = search.tags;
 	$scope.edit = search.edit;
 	$scope.forget = search.forget;
 	$scope.message = search.message;
+	$scope.recurrence = search.recurrence;
+	$scope.timeZone = search.timeZone;
+	$scope.length = search.length;
 	if (!$scope.end && !$scope.duration) {
 		$scope.duration = '1h';
 	}
@@ -44,6 +55,9 @@ bosunControllers.controller('SilenceCtrl', ['$scope', '$http', '$location', '$ro
 			if (limit && count >= limit){
 				return
 			}
+			if (v.Recurrence) {
+				return;
+			}
 			var s = moment(v.Start).utc();
 			var e = moment(v.End).utc();
 			if (startBefore && s > startBefore) {
@@ -79,6 +93,23 @@ bosunControllers.controller('SilenceCtrl', ['$scope', '$http', '$location', '$ro
 					name: 'Past',
 					silences: filter(data, null, null, null, now, 25)
 				});
+				var recurring = {};
+				_.each(data, (v: any, name: string) => {
+					if (v.Recurrence) {
+						recurring[name] = v;
+					}
+				});
+				$scope.silences.push({
+					name: 'Recurring',
+					silences: recurring
+				});
+			})
+			.error((error) => {
+				$scope.error = error;
+			});
+		$http.get('/api/silence/upcoming')
+			.success((data: any) => {
+				$scope.upcoming = data;
 			})
 			.error((error) => {
 				$scope.error = error;
@@ -96,14 +127,19 @@ bosunControllers.controller('SilenceCtrl', ['$scope', '$http', '$location', '$ro
 			end: $scope.end,
 			duration: $scope.duration,
 			alert: $scope.alert,
+			alertRegex: $scope.alertRegex,
+			notifications: $scope.notifications,
 			tags: tags.join(','),
 			edit: $scope.edit,
 			forget: $scope.forget ? 'true' : null,
 			message: $scope.message,
+			recurrence: $scope.recurrence,
+			timeZone: $scope.timeZone,
+			length: $scope.length,
 		};
 		return data;
 	}
-	var any = search.start || search.end || search.duration || search.alert || search.hosts || search.tags || search.forget;
+	var any = search.start || search.end || search.duration || search.alert || search.alertRegex || search.hosts || search.tags || search.forget || search.recurrence;
 	var state = getData();
 	$scope.change = () => {
 		$scope.disableConfirm = true;
@@ -126,10 +162,15 @@ bosunControllers.controller('SilenceCtrl', ['$scope', '$http', '$location', '$ro
 		$location.search('end', $scope.end || null);
 		$location.search('duration', $scope.duration || null);
 		$location.search('alert', $scope.alert || null);
+		$location.search('alertRegex', $scope.alertRegex || null);
+		$location.search('notifications', $scope.notifications || null);
 		$location.search('hosts', $scope.hosts || null);
 		$location.search('tags', $scope.tags || null);
 		$location.search('forget', $scope.forget || null);
 		$location.search('message', $scope.message || null);
+		$location.search('recurrence', $scope.recurrence || null);
+		$location.search('timeZone', $scope.timeZone || null);
+		$location.search('length', $scope.length || null);
 		$route.reload();
 	};
 	$scope.confirm = () => {
@@ -20,6 +20,7 @@ bosunApp.directive('tsAckGroup', ['$location', '$timeout', ($location: ng.ILocat
 		link: (scope: AckGroupScope, elem: any, attrs: any) => {
 			scope.canAckSelected = scope.ack == 'Needs Acknowledgement';
 			scope.panelClass = scope.$parent.panelClass;
+			scope.statusLabel = scope.$parent.statusLabel;
 
 			scope.btoa = scope.$parent.btoa;
 			scope.encode = scope.$parent.encode;
@@ -68,7 +69,7 @@ bosunApp.directive('tsAckGroup', ['$location', '$timeout', ($location: ng.ILocat
 					if (g.Status == 'error') {
 						scope.canCloseSelected = false;
 					}
-					if (g.Status != 'unknown') {
+					if (g.Status != 'unknown' && g.Status != 'nodata') {
 						scope.canForgetSelected = false;
 					}
 				}
@@ -3,6 +3,7 @@ package web // import "bosun.org/cmd/bosun/web"
 import (
 	"bytes"
 	"compress/gzip"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"html/template"
@@ -132,6 +133,10 @@ func Listen(httpAddr, httpsAddr, certFile, keyFile string, devMode bool, tsdbHos
 	handleFunc("/api/", APIRedirect, fullyOpen).Name("api_redir")
 	handle("/api/action", JSON(Action), canPerformActions).Name("action").Methods(POST)
 	handle("/api/alerts", JSON(Alerts), canViewDash).Name("alerts").Methods(GET)
+	handle("/api/alerts/health", JSON(AlertHealth), canViewDash).Name("alert_health").Methods(GET)
+	handle("/api/alerts/{name}/runs", JSON(AlertRuns), canViewDash).Name("alert_runs").Methods(GET)
+	handle("/api/backends", JSON(Backends), canViewDash).Name("backends").Methods(GET)
+	handle("/api/oncall", JSON(OnCall), canViewDash).Name("oncall").Methods(GET)
 	handle("/api/config", JSON(Config), canViewConfig).Name("get_config").Methods(GET)
 
 	handle("/api/config_test", JSON(ConfigTest), canViewConfig).Name("config_test").Methods(POST)
@@ -148,6 +153,7 @@ func Listen(httpAddr, httpsAddr, certFile, keyFile string, devMode bool, tsdbHos
 		handle("/api/config/running_hash", JSON(ConfigRunningHash), canViewConfig).Name("config_hash").Methods(GET)
 	}
 
+	handle("/api/dependencies", JSON(Dependencies), canViewConfig).Name("dependencies").Methods(GET)
 	handle("/api/egraph/{bs}.{format:svg|png}", JSON(ExprGraph), canRunTests).Name("expr_graph")
 	handle("/api/errors", JSON(ErrorHistory), canViewDash).Name("errors").Methods(GET, POST)
 	handle("/api/expr", JSON(Expr), canRunTests).Name("expr").Methods(POST)
@@ -159,6 +165,10 @@ func Listen(httpAddr, httpsAddr, certFile, keyFile string, devMode bool, tsdbHos
 	handle("/api/quiet", JSON(Quiet), canViewDash).Name("quiet").Methods(GET)
 	handle("/api/incidents/open", JSON(ListOpenIncidents), canViewDash).Name("open_incidents").Methods(GET)
 	handle("/api/incidents/events", JSON(IncidentEvents), canViewDash).Name("incident_events").Methods(GET)
+	handle("/api/incidents/deliveries", JSON(IncidentDeliveries), canViewDash).Name("incident_deliveries").Methods(GET)
+	handle("/api/postmortem", JSON(PostMortem), canViewDash).Name("postmortem").Methods(GET)
+	handle("/api/problems", JSON(ListProblems), canViewDash).Name("problems").Methods(GET)
+	handle("/api/problems/{id}", JSON(GetProblem), canViewDash).Name("problem").Methods(GET)
 	handle("/api/metadata/get", JSON(GetMetadata), canViewDash).Name("meta_get").Methods(GET)
 	handle("/api/metadata/metrics", JSON(MetadataMetrics), canViewDash).Name("meta_metrics").Methods(GET)
 	handle("/api/metadata/put", JSON(PutMetadata), canPutData).Name("meta_put").Methods(POST)
@@ -166,14 +176,18 @@ func Listen(httpAddr, httpsAddr, certFile, keyFile string, devMode bool, tsdbHos
 	handle("/api/metric", JSON(UniqueMetrics), canViewDash).Name("meta_uniqe_metrics").Methods(GET)
 	handle("/api/metric/{tagk}", JSON(MetricsByTagKey), canViewDash).Name("meta_metrics_by_tag").Methods(GET)
 	handle("/api/metric/{tagk}/{tagv}", JSON(MetricsByTagPair), canViewDash).Name("meta_metric_by_tag_pair").Methods(GET)
+	handle("/api/notifications/outbox", JSON(NotificationOutbox), canPerformActions).Name("notification_outbox").Methods(GET)
+	handle("/api/notifications/outbox", JSON(NotificationOutboxAction), canPerformActions).Name("notification_outbox_action").Methods(POST)
 
 	handle("/api/rule", JSON(Rule), canRunTests).Name("rule_test").Methods(POST)
+	handle("/api/rule/replay", JSON(ReplayRule), canRunTests).Name("rule_replay").Methods(POST)
 	handle("/api/rule/notification/test", JSON(TestHTTPNotification), canRunTests).Name("rule__notification_test").Methods(POST)
 	handle("/api/shorten", JSON(Shorten), canViewDash).Name("shorten")
 	handle("/s/{id}", JSON(GetShortLink), canViewDash).Name("shortlink")
 	handle("/api/silence/clear", JSON(SilenceClear), canSilence).Name("silence_clear")
 	handle("/api/silence/get", JSON(SilenceGet), canViewDash).Name("silence_get").Methods(GET)
 	handle("/api/silence/set", JSON(SilenceSet), canSilence).Name("silence_set")
+	handle("/api/silence/upcoming", JSON(SilenceUpcoming), canViewDash).Name("silence_upcoming").Methods(GET)
 	handle("/api/status", JSON(Status), canViewDash).Name("status").Methods(GET)
 	handle("/api/tagk/{metric}", JSON(TagKeysByMetric), canViewDash).Name("search_tkeys_by_metric").Methods(GET)
 	handle("/api/tagv/{tagk}", JSON(TagValuesByTagKey), canViewDash).Name("search_tvals_by_metric").Methods(GET)
@@ -198,6 +212,8 @@ func Listen(httpAddr, httpsAddr, certFile, keyFile string, devMode bool, tsdbHos
 	}
 
 	router.Handle("/api/version", baseChain.ThenFunc(Version)).Name("version").Methods(GET)
+	// Slack signs its callbacks, so they do not go through bosun's authentication.
+	router.Handle("/api/slack/action", baseChain.ThenFunc(SlackAction)).Name("slack_action").Methods(POST)
 	fs := http.FileServer(webFS)
 	router.PathPrefix("/partials/").Handler(baseChain.Then(fs)).Name("partials")
 	router.PathPrefix("/static/").Handler(baseChain.Then(http.StripPrefix("/static/", fs))).Name("static")
@@ -613,7 +629,43 @@ func MetadataMetrics(t miniprofiler.Timer, w http.ResponseWriter, r *http.Reques
 }
 
 func Alerts(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
-	return schedule.MarshalGroups(t, r.FormValue("filter"))
+	return schedule.MarshalGroups(t, r.FormValue("filter"), filterUser(r))
+}
+
+// AlertRuns returns the statistics of the most recent runs of an alert,
+// most recent first. count limits the number of runs.
+func AlertRuns(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	name := mux.Vars(r)["name"]
+	if schedule.RuleConf.GetAlert(name) == nil {
+		return nil, fmt.Errorf("unknown alert %s", name)
+	}
+	count, err := formCount(r)
+	if err != nil {
+		return nil, err
+	}
+	return schedule.DataAccess.Runs().GetAlertRuns(name, count)
+}
+
+// AlertHealth returns a summary of the most recent runs of each alert,
+// slowest first. count limits the number of runs per alert.
+func AlertHealth(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	count, err := formCount(r)
+	if err != nil {
+		return nil, err
+	}
+	return schedule.AlertHealth(count)
+}
+
+func formCount(r *http.Request) (int, error) {
+	v := r.FormValue("count")
+	if v == "" {
+		return 0, nil
+	}
+	count, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, fmt.Errorf("bad count: %v", err)
+	}
+	return count, nil
 }
 
 type ExtStatus struct {
@@ -659,6 +711,71 @@ func IncidentEvents(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request
 	return st, nil
 }
 
+// IncidentDeliveries returns the attempts to send the notifications of an
+// incident, most recent first.
+func IncidentDeliveries(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	id, err := strconv.ParseInt(r.FormValue("id"), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("bad id: %v", err)
+	}
+	return schedule.Deliveries(id)
+}
+
+// PostMortem returns a post-mortem report for the incidents given by id, or
+// the incidents started between from and to, as JSON or, with
+// format=markdown, as Markdown with the graphs embedded as data URIs.
+func PostMortem(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	opts := sched.PostMortemOptions{
+		Alert:  r.FormValue("alert"),
+		Graphs: r.FormValue("graphs") != "false",
+	}
+	if ids := r.FormValue("id"); ids != "" {
+		for _, id := range strings.Split(ids, ",") {
+			num, err := strconv.ParseInt(strings.TrimSpace(id), 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("bad id: %v", err)
+			}
+			opts.Ids = append(opts.Ids, num)
+		}
+	} else {
+		var err error
+		if opts.From, err = time.Parse(tsdbFormatSecs, r.FormValue("from")); err != nil {
+			return nil, fmt.Errorf("bad from: %v", err)
+		}
+		opts.To = time.Now().UTC()
+		if to := r.FormValue("to"); to != "" {
+			if opts.To, err = time.Parse(tsdbFormatSecs, to); err != nil {
+				return nil, fmt.Errorf("bad to: %v", err)
+			}
+		}
+	}
+	var pm *models.PostMortem
+	var err error
+	t.Step("postmortem", func(miniprofiler.Timer) {
+		pm, err = schedule.PostMortem(opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+	switch r.FormValue("format") {
+	case "", "json":
+		return pm, nil
+	case "markdown", "md":
+		buf := new(bytes.Buffer)
+		err := pm.WriteMarkdown(buf, func(i int, g *models.PostMortemGraph) string {
+			return "data:image/png;base64," + base64.StdEncoding.EncodeToString(g.PNG)
+		})
+		if err != nil {
+			return nil, err
+		}
+		w.Header().Set("Content-Type", "text/markdown; charset=utf-8")
+		buf.WriteTo(w)
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("unknown format %q", r.FormValue("format"))
+	}
+}
+
 func Status(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
 	r.ParseForm()
 	m := make(map[string]ExtStatus)
@@ -716,6 +833,22 @@ func getUsername(r *http.Request) string {
 	return "unknown"
 }
 
+// filterUser returns the user that owner:me matches in incident filters: the
+// authenticated user, or without authentication the name last used for an
+// action in the UI.
+func filterUser(r *http.Request) string {
+	if user := easyauth.GetUser(r); user != nil {
+		return user.Username
+	}
+	if c, err := r.Cookie("action-user"); err == nil {
+		if u, err := url.PathUnescape(c.Value); err == nil {
+			return u
+		}
+		return c.Value
+	}
+	return ""
+}
+
 func userCanOverwriteUsername(r *http.Request) bool {
 	user := easyauth.GetUser(r)
 	if user != nil {
@@ -726,18 +859,35 @@ func userCanOverwriteUsername(r *http.Request) bool {
 
 func Action(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
 	var data struct {
-		Type    string
-		Message string
-		Keys    []string
-		Ids     []int64
-		Notify  bool
-		User    string
-		Time    *time.Time
+		Type     string
+		Message  string
+		Keys     []string
+		Ids      []int64
+		Problems []string
+		Notify   bool
+		User     string
+		Time     *time.Time
+		AckFor   string
+		Owner    string
+		Team     string
+		Labels   []string
+		Fields   map[string]string
 	}
 	j := json.NewDecoder(r.Body)
 	if err := j.Decode(&data); err != nil {
 		return nil, err
 	}
+	if data.AckFor != "" {
+		if data.Type != "ack" {
+			return nil, fmt.Errorf("ackFor can only be used with ack")
+		}
+		d, err := opentsdb.ParseDuration(data.AckFor)
+		if err != nil {
+			return nil, err
+		}
+		t := time.Now().UTC().Add(time.Duration(d))
+		data.Time = &t
+	}
 	var at models.ActionType
 	// TODO Make constants in the JS code for these that *match* the names the string Method for ActionType
 	switch data.Type {
@@ -755,6 +905,19 @@ func Action(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (inter
 		at = models.ActionPurge
 	case "note":
 		at = models.ActionNote
+	case "assign":
+		at = models.ActionAssign
+	case "label":
+		at = models.ActionLabel
+	}
+	// Assigning and labeling edit incidents rather than change their state.
+	edit := at == models.ActionAssign || at == models.ActionLabel
+	byIncidentId := func(id int64) (models.AlertKey, error) {
+		if edit {
+			e := models.IncidentEdit{Owner: data.Owner, Team: data.Team, Labels: data.Labels, Fields: data.Fields}
+			return schedule.EditByIncidentId(data.User, data.Message, at, e, id)
+		}
+		return schedule.ActionByIncidentId(data.User, data.Message, at, data.Time, id)
 	}
 	errs := make(MultiError)
 	r.ParseForm()
@@ -772,7 +935,11 @@ func Action(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (inter
 		if err != nil {
 			return nil, err
 		}
-		err = schedule.ActionByAlertKey(data.User, data.Message, at, data.Time, ak)
+		if edit {
+			err = editByAlertKey(ak, byIncidentId)
+		} else {
+			err = schedule.ActionByAlertKey(data.User, data.Message, at, data.Time, ak)
+		}
 		if err != nil {
 			errs[key] = err
 		} else {
@@ -780,13 +947,26 @@ func Action(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (inter
 		}
 	}
 	for _, id := range data.Ids {
-		ak, err := schedule.ActionByIncidentId(data.User, data.Message, at, data.Time, id)
+		ak, err := byIncidentId(id)
 		if err != nil {
 			errs[fmt.Sprintf("%v", id)] = err
 		} else {
 			successful = append(successful, ak)
 		}
 	}
+	for _, id := range data.Problems {
+		var aks []models.AlertKey
+		var err error
+		if edit {
+			aks, err = editByProblemId(id, byIncidentId)
+		} else {
+			aks, err = schedule.ActionByProblemId(data.User, data.Message, at, data.Time, id)
+		}
+		if err != nil {
+			errs[id] = err
+		}
+		successful = append(successful, aks...)
+	}
 	if len(errs) != 0 {
 		return nil, errs
 	}
@@ -796,11 +976,41 @@ func Action(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (inter
 			return nil, err
 		}
 	} else {
-		slog.Infof("action without notification. user: %s, type: %s, keys: %v, ids: %v", data.User, data.Type, data.Keys, data.Ids)
+		slog.Infof("action without notification. user: %s, type: %s, keys: %v, ids: %v, problems: %v", data.User, data.Type, data.Keys, data.Ids, data.Problems)
 	}
 	return nil, nil
 }
 
+// editByAlertKey edits the latest incident of the alert key.
+func editByAlertKey(ak models.AlertKey, edit func(int64) (models.AlertKey, error)) error {
+	st, err := schedule.DataAccess.State().GetLatestIncident(ak)
+	if err != nil {
+		return err
+	}
+	if st == nil {
+		return fmt.Errorf("no such alert key: %v", ak)
+	}
+	_, err = edit(st.Id)
+	return err
+}
+
+// editByProblemId edits every incident of the problem.
+func editByProblemId(id string, edit func(int64) (models.AlertKey, error)) ([]models.AlertKey, error) {
+	p, err := schedule.GetProblem(id)
+	if err != nil {
+		return nil, err
+	}
+	var aks []models.AlertKey
+	for _, is := range p.Incidents {
+		ak, err := edit(is.Id)
+		if err != nil {
+			return aks, fmt.Errorf("incident %d: %v", is.Id, err)
+		}
+		aks = append(aks, ak)
+	}
+	return aks, nil
+}
+
 type MultiError map[string]error
 
 func (m MultiError) Error() string {
@@ -866,6 +1076,14 @@ func SilenceSet(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (i
 		}
 		end = start.Add(time.Duration(d))
 	}
+	var length time.Duration
+	if s := data["length"]; s != "" {
+		d, err := opentsdb.ParseDuration(s)
+		if err != nil {
+			return nil, err
+		}
+		length = time.Duration(d)
+	}
 	username := getUsername(r)
 	if _, ok := data["user"]; ok && !userCanOverwriteUsername(r) {
 		http.Error(w, "Not authorized to set 'user' parameter", 400)
@@ -873,7 +1091,43 @@ func SilenceSet(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (i
 	} else if ok {
 		username = data["user"]
 	}
-	return schedule.AddSilence(start, end, data["alert"], data["tags"], data["forget"] == "true", len(data["confirm"]) > 0, data["edit"], username, data["message"])
+	si := &models.Silence{
+		Start:      start,
+		End:        end,
+		Alert:      data["alert"],
+		AlertRegex: data["alertRegex"],
+		Forget:     data["forget"] == "true",
+		User:       username,
+		Message:    data["message"],
+		Recurrence: data["recurrence"],
+		TimeZone:   data["timeZone"],
+		Length:     length,
+	}
+	for _, n := range strings.Split(data["notifications"], ",") {
+		if n = strings.TrimSpace(n); n != "" {
+			si.Notifications = append(si.Notifications, n)
+		}
+	}
+	return schedule.AddSilence(si, data["tags"], len(data["confirm"]) > 0, data["edit"])
+}
+
+func SilenceUpcoming(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	within := opentsdb.Week
+	if s := r.FormValue("within"); s != "" {
+		d, err := opentsdb.ParseDuration(s)
+		if err != nil {
+			return nil, err
+		}
+		within = d
+	}
+	perSilence := 10
+	if s := r.FormValue("limit"); s != "" {
+		var err error
+		if perSilence, err = strconv.Atoi(s); err != nil {
+			return nil, err
+		}
+	}
+	return schedule.UpcomingSilenceWindows(time.Duration(within), perSilence)
 }
 
 func SilenceClear(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
@@ -941,6 +1195,57 @@ func Version(w http.ResponseWriter, r *http.Request) {
 	io.WriteString(w, version.GetVersionInfo("bosun"))
 }
 
+// Backends returns the circuit breaker state of the query backend hosts
+// that have recently failed.
+func Backends(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	return schedule.SystemConf.GetBackendGuard().Breakers(), nil
+}
+
+// OnCall returns who is on call for each escalation, now or at the time given
+// by at.
+func OnCall(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	at := time.Now().UTC()
+	if v := r.FormValue("at"); v != "" {
+		var err error
+		if at, err = time.Parse(tsdbFormatSecs, v); err != nil {
+			return nil, fmt.Errorf("bad at: %v", err)
+		}
+	}
+	return schedule.OnCall(at), nil
+}
+
+// NotificationOutbox returns the notifications waiting to be sent or retried,
+// and the dead letters.
+func NotificationOutbox(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	return schedule.Outbox()
+}
+
+// NotificationOutboxAction replays or deletes notifications of the outbox.
+func NotificationOutboxAction(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	var data struct {
+		Replay []int64
+		Delete []int64
+	}
+	if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+		return nil, err
+	}
+	for _, id := range data.Replay {
+		if err := schedule.ReplayOutbox(id); err != nil {
+			return nil, err
+		}
+	}
+	for _, id := range data.Delete {
+		if err := schedule.DeleteDeadLetter(id); err != nil {
+			return nil, err
+		}
+	}
+	return nil, nil
+}
+
+func Dependencies(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	return schedule.DependencyGraph()
+}
+
 func ErrorHistory(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
 	if r.Method == "GET" {
 		data, err := schedule.DataAccess.Errors().GetFullErrorHistory()
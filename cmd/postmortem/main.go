@@ -0,0 +1,120 @@
+// Command postmortem fetches a post-mortem report for bosun incidents and
+// writes it as Markdown, with the graphs as PNG files, or as JSON.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"bosun.org/models"
+)
+
+var (
+	flagHost   = flag.String("h", "bosun", "Hostname of your bosun server, or its URL such as http://bosun:8070. A hostname is reached over https. Defaults to bosun.")
+	flagIds    = flag.String("i", "", "Comma separated incident ids to report on.")
+	flagFrom   = flag.String("from", "", "Report on the incidents started after this time, in the format 2006/01/02-15:04:05. Used when -i is not set.")
+	flagTo     = flag.String("to", "", "Report on the incidents started before this time, defaults to now.")
+	flagAlert  = flag.String("a", "", "Only report on the incidents of alerts matching this glob when using -from.")
+	flagFormat = flag.String("f", "md", "Output format: md or json.")
+	flagOut    = flag.String("o", ".", "Directory to write postmortem.md (or postmortem.json) and the graphs to.")
+	flagGraphs = flag.Bool("g", true, "Include graphs of the alert expressions.")
+)
+
+func main() {
+	flag.Parse()
+	if *flagIds == "" && *flagFrom == "" {
+		log.Fatal("either -i or -from is required")
+	}
+	v := url.Values{}
+	if *flagIds != "" {
+		v.Set("id", *flagIds)
+	} else {
+		v.Set("from", *flagFrom)
+		if *flagTo != "" {
+			v.Set("to", *flagTo)
+		}
+		v.Set("alert", *flagAlert)
+	}
+	v.Set("graphs", fmt.Sprint(*flagGraphs))
+	resp, err := http.Get(baseURL(*flagHost) + "/api/postmortem?" + v.Encode())
+	if err != nil {
+		log.Fatal(err)
+	}
+	b, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		log.Fatal(err)
+	}
+	if resp.StatusCode != 200 {
+		log.Fatalf("%s", b)
+	}
+	if err := os.MkdirAll(*flagOut, 0755); err != nil {
+		log.Fatal(err)
+	}
+	switch *flagFormat {
+	case "json":
+		name := filepath.Join(*flagOut, "postmortem.json")
+		if err := ioutil.WriteFile(name, b, 0644); err != nil {
+			log.Fatal(err)
+		}
+		fmt.Println("Wrote", name)
+	case "md":
+		var pm models.PostMortem
+		if err := json.Unmarshal(b, &pm); err != nil {
+			log.Fatal(err)
+		}
+		name, err := writeMarkdown(&pm, *flagOut)
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Println("Wrote", name)
+	default:
+		log.Fatalf("unknown format %q", *flagFormat)
+	}
+}
+
+// baseURL returns the URL of the bosun server host, which is a hostname or a
+// URL with a scheme.
+func baseURL(host string) string {
+	if !strings.Contains(host, "://") {
+		host = "https://" + host
+	}
+	return strings.TrimSuffix(host, "/")
+}
+
+// writeMarkdown writes the report to dir as postmortem.md, with each graph in
+// its own PNG file next to it.
+func writeMarkdown(pm *models.PostMortem, dir string) (string, error) {
+	for i, g := range pm.Graphs {
+		if len(g.PNG) == 0 {
+			continue
+		}
+		if err := ioutil.WriteFile(filepath.Join(dir, graphFile(i)), g.PNG, 0644); err != nil {
+			return "", err
+		}
+	}
+	name := filepath.Join(dir, "postmortem.md")
+	f, err := os.Create(name)
+	if err != nil {
+		return "", err
+	}
+	err = pm.WriteMarkdown(f, func(i int, g *models.PostMortemGraph) string {
+		return graphFile(i)
+	})
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	return name, err
+}
+
+func graphFile(i int) string {
+	return fmt.Sprintf("graph-%02d.png", i+1)
+}
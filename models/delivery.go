@@ -0,0 +1,24 @@
+package models
+
+import (
+	"time"
+)
+
+// DeliveryAttempt is one attempt to send a notification for an incident.
+type DeliveryAttempt struct {
+	Time         time.Time
+	Notification string
+	// Transport is email, http or the name of the transport, and
+	// Destination the addresses, the method and URL, or the destination of
+	// the transport.
+	Transport   string
+	Destination string
+	// StatusCode is the HTTP status of the response, if the send got one.
+	StatusCode  int `json:",omitempty"`
+	Latency     time.Duration
+	Error       string `json:",omitempty"`
+	TemplateKey string `json:",omitempty"`
+	// Suppressed is the reason the send was dropped or merged without an
+	// attempt by the rate limits of the notification.
+	Suppressed string `json:",omitempty"`
+}
@@ -4,7 +4,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"math"
+	"regexp"
+	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"bosun.org/opentsdb"
@@ -40,6 +43,12 @@ type IncidentState struct {
 
 	LastAbnormalStatus Status
 
+	// The severity levels of the statuses above, for alerts that declare
+	// their own severity levels. Nil for warn and crit alerts.
+	CurrentSeverity      *Severity `json:",omitempty"`
+	WorstSeverity        *Severity `json:",omitempty"`
+	LastAbnormalSeverity *Severity `json:",omitempty"`
+
 	LastAbnormalTime Epoch
 
 	PreviousIds []int64 // A list to the previous IncidentIds for the same alert key (alertname+tagset)
@@ -47,6 +56,13 @@ type IncidentState struct {
 
 	// set of notifications we have already sent alerts to during the lifetime of the incident
 	Notifications []string
+
+	// The user and team the incident is assigned to, and the labels and
+	// custom fields users set on it. Changed by ActionAssign and ActionLabel.
+	Owner  string            `json:",omitempty"`
+	Team   string            `json:",omitempty"`
+	Labels []string          `json:",omitempty"`
+	Fields map[string]string `json:",omitempty"`
 }
 
 // SetNotified marks the notification name as "active" for this incident.
@@ -139,9 +155,16 @@ func (s *IncidentState) IsActive() bool {
 	return s.CurrentStatus > StNormal
 }
 
+// Level is the name of the current status of the incident, which is the name
+// of its severity level for alerts that declare their own levels.
+func (s *IncidentState) Level() string {
+	return s.CurrentStatus.Label(s.CurrentSeverity)
+}
+
 type Event struct {
 	Warn, Crit  *Result `json:",omitempty"`
 	Status      Status
+	Severity    *Severity `json:",omitempty"`
 	Time        time.Time
 	Unevaluated bool
 }
@@ -249,6 +272,9 @@ const (
 	StWarning
 	StCritical
 	StUnknown
+	// StNoData is the status of an alert key that stopped returning data, for
+	// alerts with noDataState = nodata.
+	StNoData
 )
 
 func (s Status) String() string {
@@ -261,6 +287,8 @@ func (s Status) String() string {
 		return "critical"
 	case StUnknown:
 		return "unknown"
+	case StNoData:
+		return "nodata"
 	default:
 		return "none"
 	}
@@ -280,6 +308,8 @@ func (s *Status) UnmarshalJSON(b []byte) error {
 		*s = StCritical
 	case `"unknown"`:
 		*s = StUnknown
+	case `"nodata"`:
+		*s = StNoData
 	default:
 		*s = StNone
 	}
@@ -290,6 +320,45 @@ func (s Status) IsNormal() bool   { return s == StNormal }
 func (s Status) IsWarning() bool  { return s == StWarning }
 func (s Status) IsCritical() bool { return s == StCritical }
 func (s Status) IsUnknown() bool  { return s == StUnknown }
+func (s Status) IsNoData() bool   { return s == StNoData }
+
+// Label is the name of the status at severity level sev: the name of the
+// level for warning and critical statuses of alerts that declare their own
+// severity levels, and the name of the status otherwise.
+func (s Status) Label(sev *Severity) string {
+	if sev != nil && (s == StWarning || s == StCritical) {
+		return sev.Name
+	}
+	return s.String()
+}
+
+// Severity is a severity level of an alert that declares its own levels
+// instead of warn and crit. Rank orders the levels of the alert, the lowest
+// level having rank 1. The status of an event at the highest level of the
+// alert is StCritical and at the other levels StWarning, so everything that
+// only knows about statuses sees a custom level as one of the two.
+type Severity struct {
+	Name string
+	Rank int
+}
+
+func (s *Severity) rank() int {
+	if s == nil {
+		return 0
+	}
+	return s.Rank
+}
+
+// CompareLevels compares status a at severity level as with status b at
+// level bs. Statuses are compared first and the ranks of the levels break
+// ties. The result is negative when a is less severe than b, positive when it
+// is more severe and 0 when they are the same.
+func CompareLevels(a Status, as *Severity, b Status, bs *Severity) int {
+	if a != b {
+		return int(a) - int(b)
+	}
+	return as.rank() - bs.rank()
+}
 
 type Action struct {
 	// These are available to users via the template language. Changes here
@@ -301,6 +370,91 @@ type Action struct {
 	Deadline   *time.Time `json:",omitempty"`
 	Fullfilled bool
 	Cancelled  bool
+	// Edit is the change made by an ActionAssign or ActionLabel action.
+	Edit *IncidentEdit `json:",omitempty"`
+}
+
+// Limits on the labels and custom fields of an incident.
+const (
+	MaxIncidentLabels   = 20
+	MaxIncidentFields   = 20
+	MaxFieldValueLength = 256
+)
+
+var labelRE = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_./-]*$`)
+
+// IncidentEdit is a change to the assignment, labels or custom fields of an
+// incident. Owner and Team replace the assignment of an ActionAssign. For an
+// ActionLabel, Labels are added, or removed when prefixed with "-", and
+// Fields are set, or removed when empty.
+type IncidentEdit struct {
+	Owner  string            `json:",omitempty"`
+	Team   string            `json:",omitempty"`
+	Labels []string          `json:",omitempty"`
+	Fields map[string]string `json:",omitempty"`
+}
+
+// Apply makes the change of an action of type t to the incident.
+func (e *IncidentEdit) Apply(t ActionType, i *IncidentState) error {
+	switch t {
+	case ActionAssign:
+		i.Owner, i.Team = e.Owner, e.Team
+		return nil
+	case ActionLabel:
+	default:
+		return fmt.Errorf("action %v does not edit incidents", t)
+	}
+	if len(e.Labels) == 0 && len(e.Fields) == 0 {
+		return fmt.Errorf("no labels or fields to change")
+	}
+	labels := make(map[string]bool)
+	for _, l := range i.Labels {
+		labels[l] = true
+	}
+	for _, l := range e.Labels {
+		remove := strings.HasPrefix(l, "-")
+		l = strings.TrimPrefix(l, "-")
+		if !labelRE.MatchString(l) {
+			return fmt.Errorf("invalid label %q: labels must be letters, numbers, _, ., / or -", l)
+		}
+		labels[l] = !remove
+	}
+	fields := make(map[string]string)
+	for k, v := range i.Fields {
+		fields[k] = v
+	}
+	for k, v := range e.Fields {
+		if !labelRE.MatchString(k) {
+			return fmt.Errorf("invalid field name %q: field names must be letters, numbers, _, ., / or -", k)
+		}
+		if len(v) > MaxFieldValueLength {
+			return fmt.Errorf("value of field %s is longer than %d characters", k, MaxFieldValueLength)
+		}
+		if v == "" {
+			delete(fields, k)
+			continue
+		}
+		fields[k] = v
+	}
+	var set []string
+	for l, ok := range labels {
+		if ok {
+			set = append(set, l)
+		}
+	}
+	sort.Strings(set)
+	if len(set) > MaxIncidentLabels {
+		return fmt.Errorf("an incident can have at most %d labels", MaxIncidentLabels)
+	}
+	if len(fields) > MaxIncidentFields {
+		return fmt.Errorf("an incident can have at most %d fields", MaxIncidentFields)
+	}
+	i.Labels = set
+	i.Fields = fields
+	if len(fields) == 0 {
+		i.Fields = nil
+	}
+	return nil
 }
 
 type ActionType int // Available to users in templates, document changes in Bosun docs
@@ -315,6 +469,9 @@ const (
 	ActionNote
 	ActionDelayedClose
 	ActionCancelClose
+	ActionAckExpired
+	ActionAssign
+	ActionLabel
 )
 
 //ActionShortNames is a map of keys we use in config file (notifications mostly) to reference action types
@@ -327,6 +484,9 @@ var ActionShortNames = map[string]ActionType{
 	"Note":         ActionNote,
 	"DelayedClose": ActionDelayedClose,
 	"CancelClose":  ActionCancelClose,
+	"AckExpired":   ActionAckExpired,
+	"Assign":       ActionAssign,
+	"Label":        ActionLabel,
 }
 
 // HumanString gives a better human readable form than the default stringer, which we can't change due to marshalling compatibility now
@@ -348,6 +508,12 @@ func (a ActionType) HumanString() string {
 		return "Delayed Closed"
 	case ActionCancelClose:
 		return "Canceled Close"
+	case ActionAckExpired:
+		return "Ack Expired"
+	case ActionAssign:
+		return "Assigned"
+	case ActionLabel:
+		return "Labeled"
 	default:
 		return "none"
 	}
@@ -371,6 +537,12 @@ func (a ActionType) String() string {
 		return "DelayedClose"
 	case ActionCancelClose:
 		return "CancelClose"
+	case ActionAckExpired:
+		return "AckExpired"
+	case ActionAssign:
+		return "Assigned"
+	case ActionLabel:
+		return "Labeled"
 	default:
 		return "none"
 	}
@@ -398,6 +570,12 @@ func (a *ActionType) UnmarshalJSON(b []byte) error {
 		*a = ActionDelayedClose
 	case `"CancelClose"`:
 		*a = ActionCancelClose
+	case `"AckExpired"`:
+		*a = ActionAckExpired
+	case `"Assigned"`:
+		*a = ActionAssign
+	case `"Labeled"`:
+		*a = ActionLabel
 	default:
 		*a = ActionNone
 	}
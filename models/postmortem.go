@@ -0,0 +1,179 @@
+package models
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// PostMortem is the skeleton of a post-mortem report for one or more
+// incidents: what happened, who did what and what was sent, to be filled in
+// with the analysis by the people who handled it.
+type PostMortem struct {
+	Title       string
+	From, To    time.Time
+	Generated   time.Time
+	Incidents   []*PostMortemIncident
+	Timeline    []*PostMortemEntry
+	Annotations []*PostMortemAnnotation `json:",omitempty"`
+	Graphs      []*PostMortemGraph      `json:",omitempty"`
+	Warnings    []string                `json:",omitempty"`
+}
+
+// PostMortemIncident summarizes an incident of a post-mortem.
+type PostMortemIncident struct {
+	Id            int64
+	AlertKey      AlertKey
+	Subject       string
+	Start         time.Time
+	End           *time.Time `json:",omitempty"`
+	Open          bool
+	WorstStatus   Status
+	CurrentStatus Status
+}
+
+// PostMortemEntry is an entry of the timeline of a post-mortem. Kind is
+// "status" for status changes, "action" for actions taken on the incident and
+// "notification" for the attempts to send its notifications.
+type PostMortemEntry struct {
+	Time          time.Time
+	Kind          string
+	IncidentId    int64
+	AlertKey      AlertKey
+	Status        Status     `json:",omitempty"`
+	Severity      *Severity  `json:",omitempty"`
+	Action        ActionType `json:",omitempty"`
+	User          string     `json:",omitempty"`
+	Message       string     `json:",omitempty"`
+	Notifications []string   `json:",omitempty"`
+	// Delivery is the attempt to send the notification of a notification
+	// entry.
+	Delivery *DeliveryAttempt `json:",omitempty"`
+}
+
+// PostMortemAnnotation is an annotation from the annotate backend that
+// overlaps the time range of a post-mortem.
+type PostMortemAnnotation struct {
+	Start, End time.Time
+	Host       string `json:",omitempty"`
+	Category   string `json:",omitempty"`
+	Source     string `json:",omitempty"`
+	User       string `json:",omitempty"`
+	Message    string
+	Url        string `json:",omitempty"`
+}
+
+// PostMortemGraph is a graph of a series used by the alert of an incident,
+// as it was when the incident was last abnormal.
+type PostMortemGraph struct {
+	IncidentId int64
+	AlertKey   AlertKey
+	Expr       string
+	Time       time.Time
+	PNG        []byte `json:",omitempty"`
+	Error      string `json:",omitempty"`
+}
+
+// WriteMarkdown writes the post-mortem as Markdown to w. image returns the
+// link used for the image of a graph.
+func (pm *PostMortem) WriteMarkdown(w io.Writer, image func(i int, g *PostMortemGraph) string) error {
+	const tf = "2006-01-02 15:04:05 MST"
+	b := &strings.Builder{}
+	fmt.Fprintf(b, "# Post-mortem: %s\n\n", pm.Title)
+	fmt.Fprintf(b, "Time range: %s to %s  \nGenerated: %s\n\n", pm.From.Format(tf), pm.To.Format(tf), pm.Generated.Format(tf))
+	b.WriteString("## Summary\n\n_What happened, what was the impact, how was it detected?_\n\n")
+
+	b.WriteString("## Incidents\n\n")
+	b.WriteString("| Id | Alert key | Worst status | Start | End | Subject |\n")
+	b.WriteString("|----|-----------|--------------|-------|-----|---------|\n")
+	for _, i := range pm.Incidents {
+		end := "open"
+		if i.End != nil {
+			end = i.End.Format(tf)
+		}
+		fmt.Fprintf(b, "| %d | %s | %s | %s | %s | %s |\n", i.Id, mdEscape(string(i.AlertKey)), i.WorstStatus, i.Start.Format(tf), end, mdEscape(i.Subject))
+	}
+
+	b.WriteString("\n## Timeline\n\n")
+	for _, e := range pm.Timeline {
+		fmt.Fprintf(b, "- **%s** #%d %s: ", e.Time.Format(tf), e.IncidentId, mdEscape(string(e.AlertKey)))
+		switch e.Kind {
+		case "status":
+			fmt.Fprintf(b, "status changed to %s", e.Status)
+		case "action":
+			fmt.Fprintf(b, "%s by %s", e.Action, mdEscape(e.User))
+			if e.Message != "" {
+				fmt.Fprintf(b, ": %s", mdEscape(e.Message))
+			}
+		case "notification":
+			d := e.Delivery
+			if d == nil {
+				fmt.Fprintf(b, "notification sent to %s", mdEscape(strings.Join(e.Notifications, ", ")))
+				break
+			}
+			fmt.Fprintf(b, "notification %s", mdEscape(d.Notification))
+			switch {
+			case d.Suppressed != "":
+				fmt.Fprintf(b, " to %s suppressed: %s", mdEscape(d.Destination), mdEscape(d.Suppressed))
+			case d.Error != "":
+				fmt.Fprintf(b, " to %s failed: %s", mdEscape(d.Destination), mdEscape(d.Error))
+			default:
+				fmt.Fprintf(b, " sent to %s", mdEscape(d.Destination))
+			}
+		}
+		b.WriteString("\n")
+	}
+
+	if len(pm.Annotations) > 0 {
+		b.WriteString("\n## Annotations\n\n")
+		for _, a := range pm.Annotations {
+			fmt.Fprintf(b, "- **%s to %s**", a.Start.Format(tf), a.End.Format(tf))
+			if a.Host != "" {
+				fmt.Fprintf(b, " %s", mdEscape(a.Host))
+			}
+			if a.Category != "" {
+				fmt.Fprintf(b, " [%s]", mdEscape(a.Category))
+			}
+			fmt.Fprintf(b, ": %s", mdEscape(a.Message))
+			if a.User != "" {
+				fmt.Fprintf(b, " (%s)", mdEscape(a.User))
+			}
+			if a.Url != "" {
+				fmt.Fprintf(b, " <%s>", a.Url)
+			}
+			b.WriteString("\n")
+		}
+	}
+
+	if len(pm.Graphs) > 0 {
+		b.WriteString("\n## Graphs\n\n")
+		for i, g := range pm.Graphs {
+			fmt.Fprintf(b, "### #%d %s\n\n`%s` at %s\n\n", g.IncidentId, mdEscape(string(g.AlertKey)), g.Expr, g.Time.Format(tf))
+			if g.Error != "" {
+				fmt.Fprintf(b, "_Error rendering graph: %s_\n\n", mdEscape(g.Error))
+				continue
+			}
+			fmt.Fprintf(b, "![%s](%s)\n\n", mdEscape(g.Expr), image(i, g))
+		}
+	}
+
+	if len(pm.Warnings) > 0 {
+		b.WriteString("\n## Warnings\n\n")
+		for _, w := range pm.Warnings {
+			fmt.Fprintf(b, "- %s\n", mdEscape(w))
+		}
+	}
+
+	b.WriteString("\n## Root cause\n\n_Why did it happen?_\n\n")
+	b.WriteString("## Resolution\n\n_How was it fixed?_\n\n")
+	b.WriteString("## Action items\n\n- [ ] \n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+var mdReplacer = strings.NewReplacer("|", `\|`, "*", `\*`, "_", `\_`, "`", "\\`", "[", `\[`, "]", `\]`, "<", "&lt;", "\n", " ")
+
+func mdEscape(s string) string {
+	return mdReplacer.Replace(s)
+}
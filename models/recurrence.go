@@ -0,0 +1,310 @@
+package models
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Recurrence is a parsed recurrence rule with minute resolution. It can be
+// created from a five field cron expression ("0 22 * * tue") or from a
+// subset of an iCalendar RRULE ("FREQ=WEEKLY;BYDAY=TU;BYHOUR=22").
+type Recurrence struct {
+	minute, hour, dom, month, dow uint64
+	domAny, dowAny                bool
+}
+
+// recurrenceSearchDays bounds how far Next looks for an occurrence.
+const recurrenceSearchDays = 366 * 5
+
+var (
+	monthNames = map[string]int{"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6, "jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12}
+	dowNames   = map[string]int{"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6}
+	rruleDays  = map[string]int{"SU": 0, "MO": 1, "TU": 2, "WE": 3, "TH": 4, "FR": 5, "SA": 6}
+
+	cronShorthands = map[string]string{
+		"@hourly":  "0 * * * *",
+		"@daily":   "0 0 * * *",
+		"@weekly":  "0 0 * * 0",
+		"@monthly": "0 0 1 * *",
+		"@yearly":  "0 0 1 1 *",
+	}
+)
+
+// ParseRecurrence parses a cron expression or an RRULE. Strings starting with
+// "FREQ=" or "RRULE:" are treated as RRULEs.
+func ParseRecurrence(s string) (*Recurrence, error) {
+	s = strings.TrimSpace(s)
+	if strings.HasPrefix(s, "RRULE:") || strings.HasPrefix(s, "FREQ=") {
+		return parseRRule(strings.TrimPrefix(s, "RRULE:"))
+	}
+	return parseCron(s)
+}
+
+func parseCron(s string) (*Recurrence, error) {
+	if v, ok := cronShorthands[s]; ok {
+		s = v
+	}
+	fields := strings.Fields(s)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("recurrence: expected 5 cron fields, got %d in %q", len(fields), s)
+	}
+	r := &Recurrence{}
+	var err error
+	if r.minute, err = parseCronField(fields[0], 0, 59, nil); err != nil {
+		return nil, err
+	}
+	if r.hour, err = parseCronField(fields[1], 0, 23, nil); err != nil {
+		return nil, err
+	}
+	if r.dom, err = parseCronField(fields[2], 1, 31, nil); err != nil {
+		return nil, err
+	}
+	if r.month, err = parseCronField(fields[3], 1, 12, monthNames); err != nil {
+		return nil, err
+	}
+	if r.dow, err = parseCronField(fields[4], 0, 7, dowNames); err != nil {
+		return nil, err
+	}
+	// 7 is also sunday
+	if r.dow&(1<<7) != 0 {
+		r.dow = r.dow&^(1<<7) | 1
+	}
+	r.domAny = fields[2] == "*" || fields[2] == "?"
+	r.dowAny = fields[4] == "*" || fields[4] == "?"
+	return r, nil
+}
+
+func parseCronField(field string, min, max int, names map[string]int) (uint64, error) {
+	var bits uint64
+	value := func(s string) (int, error) {
+		if v, ok := names[strings.ToLower(s)]; ok {
+			return v, nil
+		}
+		v, err := strconv.Atoi(s)
+		if err != nil {
+			return 0, fmt.Errorf("recurrence: bad value %q", s)
+		}
+		if v < min || v > max {
+			return 0, fmt.Errorf("recurrence: value %d out of range %d-%d", v, min, max)
+		}
+		return v, nil
+	}
+	for _, part := range strings.Split(field, ",") {
+		step := 1
+		if i := strings.Index(part, "/"); i >= 0 {
+			var err error
+			step, err = strconv.Atoi(part[i+1:])
+			if err != nil || step < 1 {
+				return 0, fmt.Errorf("recurrence: bad step in %q", part)
+			}
+			part = part[:i]
+		}
+		lo, hi := min, max
+		switch {
+		case part == "*" || part == "?":
+		case strings.Contains(part, "-"):
+			sp := strings.SplitN(part, "-", 2)
+			var err error
+			if lo, err = value(sp[0]); err != nil {
+				return 0, err
+			}
+			if hi, err = value(sp[1]); err != nil {
+				return 0, err
+			}
+			if lo > hi {
+				return 0, fmt.Errorf("recurrence: bad range %q", part)
+			}
+		default:
+			v, err := value(part)
+			if err != nil {
+				return 0, err
+			}
+			lo = v
+			if step == 1 {
+				hi = v
+			}
+		}
+		for v := lo; v <= hi; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+	return bits, nil
+}
+
+func parseRRule(s string) (*Recurrence, error) {
+	params := make(map[string]string)
+	for _, part := range strings.Split(s, ";") {
+		if part == "" {
+			continue
+		}
+		sp := strings.SplitN(part, "=", 2)
+		if len(sp) != 2 {
+			return nil, fmt.Errorf("recurrence: bad rrule part %q", part)
+		}
+		params[strings.ToUpper(sp[0])] = sp[1]
+	}
+	list := func(key string, min, max int) (uint64, bool, error) {
+		v, ok := params[key]
+		if !ok {
+			return 0, false, nil
+		}
+		bits, err := parseCronField(v, min, max, nil)
+		return bits, true, err
+	}
+	r := &Recurrence{minute: 1, hour: 1, domAny: true, dowAny: true}
+	r.dom = allBits(1, 31)
+	r.month = allBits(1, 12)
+	r.dow = allBits(0, 6)
+	for k, v := range params {
+		switch k {
+		case "FREQ", "BYMINUTE", "BYHOUR", "BYDAY", "BYMONTHDAY", "BYMONTH", "WKST":
+		case "INTERVAL":
+			if v != "1" {
+				return nil, fmt.Errorf("recurrence: INTERVAL is not supported")
+			}
+		default:
+			return nil, fmt.Errorf("recurrence: unsupported rrule part %s", k)
+		}
+	}
+	freq := params["FREQ"]
+	if freq == "HOURLY" {
+		r.hour = allBits(0, 23)
+	}
+	if bits, ok, err := list("BYMINUTE", 0, 59); err != nil {
+		return nil, err
+	} else if ok {
+		r.minute = bits
+	}
+	if bits, ok, err := list("BYHOUR", 0, 23); err != nil {
+		return nil, err
+	} else if ok {
+		r.hour = bits
+	}
+	if bits, ok, err := list("BYMONTHDAY", 1, 31); err != nil {
+		return nil, err
+	} else if ok {
+		r.dom, r.domAny = bits, false
+	}
+	if bits, ok, err := list("BYMONTH", 1, 12); err != nil {
+		return nil, err
+	} else if ok {
+		r.month = bits
+	}
+	if v, ok := params["BYDAY"]; ok {
+		r.dow, r.dowAny = 0, false
+		for _, d := range strings.Split(v, ",") {
+			day, ok := rruleDays[strings.ToUpper(d)]
+			if !ok {
+				return nil, fmt.Errorf("recurrence: unsupported BYDAY value %q", d)
+			}
+			r.dow |= 1 << uint(day)
+		}
+	}
+	switch freq {
+	case "HOURLY", "DAILY":
+	case "WEEKLY":
+		if r.dowAny {
+			return nil, fmt.Errorf("recurrence: FREQ=WEEKLY requires BYDAY")
+		}
+	case "MONTHLY":
+		if r.domAny == r.dowAny {
+			return nil, fmt.Errorf("recurrence: FREQ=MONTHLY requires one of BYMONTHDAY or BYDAY")
+		}
+	case "YEARLY":
+		if _, ok := params["BYMONTH"]; !ok || r.domAny {
+			return nil, fmt.Errorf("recurrence: FREQ=YEARLY requires BYMONTH and BYMONTHDAY")
+		}
+	default:
+		return nil, fmt.Errorf("recurrence: unsupported FREQ %q", freq)
+	}
+	if !r.domAny && !r.dowAny {
+		return nil, fmt.Errorf("recurrence: BYMONTHDAY and BYDAY can not be combined")
+	}
+	return r, nil
+}
+
+func allBits(min, max int) uint64 {
+	var bits uint64
+	for v := min; v <= max; v++ {
+		bits |= 1 << uint(v)
+	}
+	return bits
+}
+
+func (r *Recurrence) dayMatches(t time.Time) bool {
+	if r.month&(1<<uint(t.Month())) == 0 {
+		return false
+	}
+	dom := r.dom&(1<<uint(t.Day())) != 0
+	dow := r.dow&(1<<uint(t.Weekday())) != 0
+	// Like cron, when both day fields are restricted either one may match.
+	if !r.domAny && !r.dowAny {
+		return dom || dow
+	}
+	return dom && dow
+}
+
+// Next returns the first occurrence strictly after the given time, in the
+// time's location. The zero time is returned if there is none within five
+// years.
+func (r *Recurrence) Next(after time.Time) time.Time {
+	loc := after.Location()
+	y, m, d := after.Date()
+	for i := 0; i < recurrenceSearchDays; i++ {
+		day := time.Date(y, m, d+i, 0, 0, 0, 0, loc)
+		if !r.dayMatches(day) {
+			continue
+		}
+		for h := 0; h < 24; h++ {
+			if r.hour&(1<<uint(h)) == 0 {
+				continue
+			}
+			for min := 0; min < 60; min++ {
+				if r.minute&(1<<uint(min)) == 0 {
+					continue
+				}
+				t := time.Date(day.Year(), day.Month(), day.Day(), h, min, 0, 0, loc)
+				if t.After(after) {
+					return t
+				}
+			}
+		}
+	}
+	return time.Time{}
+}
+
+// Prev returns the latest occurrence at or before t and after since, in t's
+// location. The zero time is returned if there is none.
+func (r *Recurrence) Prev(t, since time.Time) time.Time {
+	loc := t.Location()
+	y, m, d := t.Date()
+	for i := 0; ; i++ {
+		day := time.Date(y, m, d-i, 0, 0, 0, 0, loc)
+		if day.AddDate(0, 0, 1).Before(since) {
+			return time.Time{}
+		}
+		if !r.dayMatches(day) {
+			continue
+		}
+		for h := 23; h >= 0; h-- {
+			if r.hour&(1<<uint(h)) == 0 {
+				continue
+			}
+			for min := 59; min >= 0; min-- {
+				if r.minute&(1<<uint(min)) == 0 {
+					continue
+				}
+				o := time.Date(day.Year(), day.Month(), day.Day(), h, min, 0, 0, loc)
+				if o.After(t) {
+					continue
+				}
+				if !o.After(since) {
+					return time.Time{}
+				}
+				return o
+			}
+		}
+	}
+}
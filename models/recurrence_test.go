@@ -0,0 +1,93 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseRecurrence(t *testing.T) {
+	valid := []string{
+		"0 22 * * 2",
+		"*/15 1-3 * * mon-fri",
+		"30 4 1,15 jan,jul *",
+		"@weekly",
+		"FREQ=WEEKLY;BYDAY=TU,TH;BYHOUR=22",
+		"RRULE:FREQ=MONTHLY;BYMONTHDAY=1;BYHOUR=3;BYMINUTE=30",
+	}
+	for _, s := range valid {
+		if _, err := ParseRecurrence(s); err != nil {
+			t.Errorf("%s: %v", s, err)
+		}
+	}
+	invalid := []string{
+		"0 22 * *",
+		"60 * * * *",
+		"0 22 * * funday",
+		"FREQ=WEEKLY",
+		"FREQ=DAILY;INTERVAL=2",
+		"FREQ=MONTHLY;BYMONTHDAY=1;BYDAY=MO",
+	}
+	for _, s := range invalid {
+		if _, err := ParseRecurrence(s); err == nil {
+			t.Errorf("%s: expected error", s)
+		}
+	}
+}
+
+func TestSilenceWindows(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip(err)
+	}
+	s := &Silence{
+		Start:      time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC),
+		End:        time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC),
+		Recurrence: "FREQ=WEEKLY;BYDAY=TU;BYHOUR=22",
+		TimeZone:   "America/New_York",
+		Length:     4 * time.Hour,
+	}
+	// Tuesday 2018-03-06 22:00 in New York
+	occurrence := time.Date(2018, 3, 6, 22, 0, 0, 0, ny)
+	tests := []struct {
+		at     time.Time
+		active bool
+	}{
+		{occurrence.Add(-time.Minute), false},
+		{occurrence, true},
+		{occurrence.Add(3 * time.Hour), true},
+		{occurrence.Add(4*time.Hour + time.Minute), false},
+		{occurrence.AddDate(0, 0, 1), false},
+		// after the end of the silence
+		{time.Date(2019, 1, 1, 23, 0, 0, 0, ny), false},
+	}
+	for _, test := range tests {
+		if got := s.ActiveAt(test.at); got != test.active {
+			t.Errorf("%v: expected active %v, got %v", test.at, test.active, got)
+		}
+	}
+	// An occurrence before the start of the silence is not one of its
+	// windows, even when the silence starts during it.
+	late := *s
+	late.Start = occurrence.Add(time.Hour)
+	if _, ok := late.WindowAt(occurrence.Add(2 * time.Hour)); ok {
+		t.Error("expected no window for an occurrence before the start")
+	}
+	if w := late.Windows(occurrence.Add(2*time.Hour), 1); len(w) != 1 || !w[0].Start.Equal(occurrence.AddDate(0, 0, 7)) {
+		t.Errorf("expected the next occurrence as the first window, got %v", w)
+	}
+	late.Start = occurrence
+	if w, ok := late.WindowAt(occurrence.Add(time.Hour)); !ok || !w.Start.Equal(occurrence) {
+		t.Errorf("expected the occurrence at the start to be a window, got %v, %v", w, ok)
+	}
+
+	windows := s.Windows(occurrence.Add(time.Hour), 3)
+	if len(windows) != 3 {
+		t.Fatalf("expected 3 windows, got %d", len(windows))
+	}
+	for i, w := range windows {
+		expected := occurrence.AddDate(0, 0, 7*i)
+		if !w.Start.Equal(expected) || !w.End.Equal(expected.Add(4*time.Hour)) {
+			t.Errorf("window %d: got %v - %v, expected start %v", i, w.Start, w.End, expected)
+		}
+	}
+}
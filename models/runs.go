@@ -0,0 +1,22 @@
+package models
+
+import (
+	"time"
+)
+
+// AlertRun holds the statistics of one check of an alert.
+type AlertRun struct {
+	// Time is the time the check ran for.
+	Time time.Time
+	// Duration is how long the check took to evaluate the expressions.
+	Duration time.Duration
+	// Results is the number of results of the crit and warn expressions.
+	Results int
+	// Queries is the number of queries sent to the backends. Queries served
+	// from the cache of the check are not counted.
+	Queries int64
+	// The number of alert keys of the alert by status after the check.
+	Normal, Warning, Critical, Unknown, NoData int
+	Unevaluated                                int
+	Error                                      string `json:",omitempty"`
+}
@@ -3,6 +3,8 @@ package models
 import (
 	"crypto/sha1"
 	"fmt"
+	"regexp"
+	"strings"
 	"time"
 
 	"bosun.org/opentsdb"
@@ -17,6 +19,139 @@ type Silence struct {
 	Forget     bool
 	User       string
 	Message    string
+
+	// AlertRegex matches alert names by regular expression instead of the
+	// exact name in Alert. TagMatchers hold the negated and regex tag
+	// matches of TagString that can not be expressed as globs in Tags.
+	AlertRegex  string       `json:",omitempty"`
+	TagMatchers []TagMatcher `json:",omitempty"`
+	alertRegexp *regexp.Regexp
+	// Notifications limits the silence to the named notifications. Alerts
+	// silenced this way are still evaluated and notify any other
+	// notifications as usual.
+	Notifications []string `json:",omitempty"`
+
+	// Recurring silences (maintenance windows) are only active for Length
+	// after each occurrence of Recurrence between Start and End. Recurrence
+	// is a cron expression or RRULE evaluated in TimeZone.
+	Recurrence string        `json:",omitempty"`
+	TimeZone   string        `json:",omitempty"`
+	Length     time.Duration `json:",omitempty"`
+}
+
+// TagMatcher matches a tag value. Op is one of != (does not match the glob),
+// =~ (matches the regular expression) or !~ (does not match the regular
+// expression).
+type TagMatcher struct {
+	Key   string
+	Op    string
+	Value string
+	re    *regexp.Regexp
+}
+
+// Matches returns true if the tags satisfy the matcher. A missing tag only
+// satisfies negated matchers.
+func (m TagMatcher) Matches(tags opentsdb.TagSet) bool {
+	tagv, ok := tags[m.Key]
+	var matched bool
+	switch m.Op {
+	case "!=":
+		matched, _ = util.Match(m.Value, tagv)
+	case "=~", "!~":
+		matched = matchRegexp(m.re, m.Value, tagv)
+	}
+	if strings.HasPrefix(m.Op, "!") {
+		return !ok || !matched
+	}
+	return ok && matched
+}
+
+// matchRegexp matches s with re, the compiled pattern, or compiles pattern
+// if the silence was not compiled.
+func matchRegexp(re *regexp.Regexp, pattern, s string) bool {
+	if re == nil {
+		var err error
+		if re, err = regexp.Compile(pattern); err != nil {
+			return false
+		}
+	}
+	return re.MatchString(s)
+}
+
+// CompileRegexps compiles the regular expressions of s once, since silences
+// are matched against every alert key. Silences loaded from the database are
+// compiled.
+func (s *Silence) CompileRegexps() error {
+	if s.AlertRegex != "" {
+		re, err := regexp.Compile(s.AlertRegex)
+		if err != nil {
+			return err
+		}
+		s.alertRegexp = re
+	}
+	for i, m := range s.TagMatchers {
+		if m.Op != "=~" && m.Op != "!~" {
+			continue
+		}
+		re, err := regexp.Compile(m.Value)
+		if err != nil {
+			return err
+		}
+		s.TagMatchers[i].re = re
+	}
+	return nil
+}
+
+func (m TagMatcher) String() string {
+	return m.Key + m.Op + m.Value
+}
+
+// ParseSilenceTags parses a comma separated list of tag matches. Tags in
+// k=v form are globs and are returned as a TagSet. The k!=v, k=~v and k!~v
+// forms are returned as TagMatchers.
+func ParseSilenceTags(s string) (opentsdb.TagSet, []TagMatcher, error) {
+	var globs []string
+	var matchers []TagMatcher
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		op := ""
+		i := -1
+		for _, o := range []string{"!=", "=~", "!~"} {
+			if j := strings.Index(part, o); j > 0 && (i < 0 || j < i) {
+				op, i = o, j
+			}
+		}
+		if i < 0 || strings.Index(part, "=") < i {
+			globs = append(globs, part)
+			continue
+		}
+		m := TagMatcher{Key: part[:i], Op: op, Value: part[i+len(op):]}
+		if m.Op != "!=" {
+			re, err := regexp.Compile(m.Value)
+			if err != nil {
+				return nil, nil, err
+			}
+			m.re = re
+		}
+		matchers = append(matchers, m)
+	}
+	tags := make(opentsdb.TagSet)
+	if len(globs) > 0 {
+		var err error
+		tags, err = opentsdb.ParseTags(strings.Join(globs, ","))
+		if tags == nil && err != nil {
+			return nil, nil, err
+		}
+	}
+	return tags, matchers, nil
+}
+
+// SilenceWindow is a single occurrence of a silence.
+type SilenceWindow struct {
+	Start, End time.Time
 }
 
 func (s *Silence) Silenced(now time.Time, alert string, tags opentsdb.TagSet) bool {
@@ -30,13 +165,111 @@ func (s *Silence) ActiveAt(now time.Time) bool {
 	if now.Before(s.Start) || now.After(s.End) {
 		return false
 	}
-	return true
+	if s.Recurrence == "" {
+		return true
+	}
+	_, ok := s.WindowAt(now)
+	return ok
+}
+
+// IsRecurring returns true if the silence is a recurring maintenance window.
+func (s *Silence) IsRecurring() bool {
+	return s.Recurrence != ""
+}
+
+// ParseRecurrence parses the recurrence and time zone of the silence.
+func (s *Silence) ParseRecurrence() (*Recurrence, *time.Location, error) {
+	r, err := ParseRecurrence(s.Recurrence)
+	if err != nil {
+		return nil, nil, err
+	}
+	loc, err := time.LoadLocation(s.TimeZone)
+	if err != nil {
+		return nil, nil, err
+	}
+	return r, loc, nil
+}
+
+// WindowAt returns the window of the silence that contains now. A non
+// recurring silence has a single window from Start to End. Like Windows, it
+// leaves out occurrences before Start.
+func (s *Silence) WindowAt(now time.Time) (SilenceWindow, bool) {
+	if now.Before(s.Start) || now.After(s.End) {
+		return SilenceWindow{}, false
+	}
+	if s.Recurrence == "" {
+		return SilenceWindow{Start: s.Start, End: s.End}, true
+	}
+	r, loc, err := s.ParseRecurrence()
+	if err != nil {
+		return SilenceWindow{}, false
+	}
+	since := now.Add(-s.Length)
+	if first := s.Start.Add(-time.Nanosecond); since.Before(first) {
+		since = first
+	}
+	start := r.Prev(now.In(loc), since)
+	if start.IsZero() {
+		return SilenceWindow{}, false
+	}
+	return s.window(start), true
+}
+
+// Windows returns up to n windows of the silence that end after the given
+// time, in order: the window that contains it, if any, and the windows that
+// start after it.
+func (s *Silence) Windows(after time.Time, n int) []SilenceWindow {
+	var windows []SilenceWindow
+	if s.Recurrence == "" {
+		if s.End.After(after) && n > 0 {
+			windows = append(windows, SilenceWindow{Start: s.Start, End: s.End})
+		}
+		return windows
+	}
+	r, loc, err := s.ParseRecurrence()
+	if err != nil {
+		return nil
+	}
+	if w, ok := s.WindowAt(after); ok {
+		windows = append(windows, w)
+		after = w.Start
+	}
+	if after.Before(s.Start) {
+		after = s.Start.Add(-time.Nanosecond)
+	}
+	for len(windows) < n {
+		start := r.Next(after.In(loc))
+		if start.IsZero() || start.After(s.End) {
+			break
+		}
+		if !start.Before(s.Start) {
+			windows = append(windows, s.window(start))
+		}
+		after = start
+	}
+	return windows
+}
+
+func (s *Silence) window(start time.Time) SilenceWindow {
+	end := start.Add(s.Length)
+	if end.After(s.End) {
+		end = s.End
+	}
+	return SilenceWindow{Start: start, End: end}
 }
 
 func (s *Silence) Matches(alert string, tags opentsdb.TagSet) bool {
 	if s.Alert != "" && s.Alert != alert {
 		return false
 	}
+	if s.AlertRegex != "" && !matchRegexp(s.alertRegexp, s.AlertRegex, alert) {
+		return false
+	}
+	for _, m := range s.TagMatchers {
+		if !m.Matches(tags) {
+			return false
+		}
+	}
 	for k, pattern := range s.Tags {
 		tagv, ok := tags[k]
 		if !ok {
@@ -50,8 +283,29 @@ func (s *Silence) Matches(alert string, tags opentsdb.TagSet) bool {
 	return true
 }
 
+// SilencesNotification returns true if the silence applies to the named
+// notification. An empty name asks if the silence applies to all
+// notifications.
+func (s *Silence) SilencesNotification(name string) bool {
+	if len(s.Notifications) == 0 {
+		return true
+	}
+	for _, n := range s.Notifications {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
 func (s Silence) ID() string {
 	h := sha1.New()
 	fmt.Fprintf(h, "%s|%s|%s%s", s.Start, s.End, s.Alert, s.Tags)
+	if s.Recurrence != "" {
+		fmt.Fprintf(h, "|%s|%s|%s", s.Recurrence, s.TimeZone, s.Length)
+	}
+	if s.AlertRegex != "" || len(s.TagMatchers) > 0 || len(s.Notifications) > 0 {
+		fmt.Fprintf(h, "|%s|%v|%v", s.AlertRegex, s.TagMatchers, s.Notifications)
+	}
 	return fmt.Sprintf("%x", h.Sum(nil))
 }
@@ -0,0 +1,41 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+
+	"bosun.org/opentsdb"
+)
+
+func TestSilenceCompileRegexps(t *testing.T) {
+	tags, matchers, err := ParseSilenceTags("host!=db*,dc=~^ny")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := json.Marshal(&Silence{AlertRegex: `^os\.`, Tags: tags, TagMatchers: matchers})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var s Silence
+	if err := json.Unmarshal(b, &s); err != nil {
+		t.Fatal(err)
+	}
+	match := opentsdb.TagSet{"dc": "ny1", "host": "web01"}
+	// Silences that are not compiled still match.
+	if !s.Matches("os.cpu", match) {
+		t.Error("expected the uncompiled silence to match")
+	}
+	if err := s.CompileRegexps(); err != nil {
+		t.Fatal(err)
+	}
+	if s.alertRegexp == nil || s.TagMatchers[0].re != nil || s.TagMatchers[1].re == nil {
+		t.Fatalf("expected the regular expressions to be compiled, got %+v", s.TagMatchers)
+	}
+	if !s.Matches("os.cpu", match) || s.Matches("os.cpu", opentsdb.TagSet{"dc": "lon", "host": "web01"}) || s.Matches("net.up", match) {
+		t.Error("unexpected match of the compiled silence")
+	}
+	bad := Silence{AlertRegex: "("}
+	if err := bad.CompileRegexps(); err == nil {
+		t.Error("expected an error for an invalid regular expression")
+	}
+}